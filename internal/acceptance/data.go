@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
 
@@ -80,6 +81,10 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		}
 	}
 
+	if session := recordingSessionForTest(t); session != nil {
+		testData.useRecordingSession(t, session)
+	}
+
 	testData.Subscriptions = Subscriptions{
 		Primary:   os.Getenv("ARM_SUBSCRIPTION_ID"),
 		Secondary: os.Getenv("ARM_SUBSCRIPTION_ID_ALT"),
@@ -88,6 +93,32 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 	return testData
 }
 
+// useRecordingSession stores the random values for this test in the recording, so that the same values
+// are used when the recording is replayed
+func (td *TestData) useRecordingSession(t *testing.T, session *common.RecordingSession) {
+	randomInteger := session.Variable(fmt.Sprintf("%s.random_integer", td.ResourceName), strconv.Itoa(td.RandomInteger))
+	v, err := strconv.Atoi(randomInteger)
+	if err != nil {
+		t.Fatalf("parsing the recorded `random_integer` %q: %+v", randomInteger, err)
+	}
+	td.RandomInteger = v
+	td.RandomString = session.Variable(fmt.Sprintf("%s.random_string", td.ResourceName), td.RandomString)
+
+	locations := map[string]*string{
+		"ARM_TEST_LOCATION":      &td.Locations.Primary,
+		"ARM_TEST_LOCATION_ALT":  &td.Locations.Secondary,
+		"ARM_TEST_LOCATION_ALT2": &td.Locations.Ternary,
+	}
+	for envVar, location := range locations {
+		*location = session.Variable(envVar, *location)
+
+		// the PreCheck requires these are set, which isn't necessary when replaying
+		if session.Mode() == common.RecordingModeReplay && os.Getenv(envVar) == "" {
+			t.Setenv(envVar, *location)
+		}
+	}
+}
+
 // RandomIntOfLength is a random 8 to 18 digit integer which is unique to this test case
 func (td *TestData) RandomIntOfLength(length int) int {
 	// length should not be
@@ -123,6 +154,16 @@ func (td *TestData) RandomStringOfLength(length int) string {
 		panic("Invalid Test: RandomStringOfLength: length argument must be between 1 and 1024 characters")
 	}
 
+	if common.RecordingMode() != "" {
+		// when recording (or replaying) this needs to return the same value each time, so is derived from RandomInteger
+		r := rand.New(rand.NewSource(int64(td.RandomInteger) + int64(length)))
+		result := make([]byte, length)
+		for i := range result {
+			result[i] = charSetAlphaNum[r.Intn(len(charSetAlphaNum))]
+		}
+		return string(result)
+	}
+
 	return randString(length)
 }
 
@@ -0,0 +1,564 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const (
+	provisioningStateCreating  = "Creating"
+	provisioningStateDeleting  = "Deleting"
+	provisioningStateSucceeded = "Succeeded"
+	provisioningStateUpdating  = "Updating"
+)
+
+type resource struct {
+	id   string
+	body map[string]interface{}
+
+	// pending is the Long Running Operation which is in progress for this resource, if any
+	pending *operation
+}
+
+type operation struct {
+	name   string
+	polls  int
+	delete bool
+
+	// resourceKey is the key of the resource this operation is for
+	resourceKey string
+
+	completed bool
+}
+
+// SetResource creates or replaces the resource with the specified ID, with the `provisioningState` `Succeeded`,
+// which allows tests to seed the Server with existing resources
+func (s *Server) SetResource(id string, body map[string]interface{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	r := &resource{
+		id:   id,
+		body: normaliseResource(id, body, provisioningStateSucceeded),
+	}
+	s.resources[resourceKey(id)] = r
+}
+
+// Resource returns a copy of the resource with the specified ID, and whether it exists
+func (s *Server) Resource(id string) (map[string]interface{}, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	r, ok := s.resources[resourceKey(id)]
+	if !ok {
+		return nil, false
+	}
+	return copyBody(r.body), true
+}
+
+func (s *Server) handleResourceManager(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSuffix(r.URL.Path, "/")
+	segments := splitSegments(id)
+
+	if len(segments) >= 2 && strings.EqualFold(segments[0], "providers") && strings.EqualFold(segments[1], "Microsoft.Resources") {
+		// polling for a Long Running Operation
+		s.handleOperation(w, r, segments)
+		return
+	}
+
+	if r.URL.Query().Get("api-version") == "" {
+		writeError(w, http.StatusBadRequest, "MissingApiVersionParameter", "The api-version query parameter (?api-version=) is required for all requests.")
+		return
+	}
+
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") {
+		writeError(w, http.StatusNotFound, "InvalidResourceId", fmt.Sprintf("the path %q is not a supported Resource ID", id))
+		return
+	}
+
+	switch {
+	case len(segments) == 2:
+		s.handleSubscription(w, r, segments[1])
+
+	case isResourceProviderRequest(segments):
+		s.handleResourceProviders(w, r)
+
+	case isCollection(segments):
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("the method %q is not supported for the collection %q", r.Method, id))
+			return
+		}
+		s.handleList(w, segments)
+
+	default:
+		switch r.Method {
+		case http.MethodGet:
+			s.handleGet(w, id)
+		case http.MethodPut:
+			s.handlePut(w, r, id)
+		case http.MethodPatch:
+			s.handlePatch(w, r, id)
+		case http.MethodDelete:
+			s.handleDelete(w, r, id)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("the method %q is not supported for %q", r.Method, id))
+		}
+	}
+}
+
+func (s *Server) handleSubscription(w http.ResponseWriter, r *http.Request, subscriptionId string) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("the method %q is not supported for Subscriptions", r.Method))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"id":             fmt.Sprintf("/subscriptions/%s", subscriptionId),
+		"subscriptionId": subscriptionId,
+		"tenantId":       TenantId,
+		"displayName":    "Fake Subscription",
+		"state":          "Enabled",
+	})
+}
+
+// handleResourceProviders reports that each Resource Provider is registered, since the in-memory store supports any resource type
+func (s *Server) handleResourceProviders(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"value": []interface{}{},
+		})
+	case http.MethodPost:
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"registrationState": "Registered",
+		})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("the method %q is not supported for Resource Providers", r.Method))
+	}
+}
+
+func (s *Server) handleGet(w http.ResponseWriter, id string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := resourceKey(id)
+	existing, ok := s.resources[key]
+	if !ok {
+		writeNotFound(w, id)
+		return
+	}
+
+	// reading the resource counts as polling any pending operation, since some pollers (e.g. for deletions) poll the resource itself
+	if existing.pending != nil {
+		s.pollOperation(existing.pending)
+		if existing, ok = s.resources[key]; !ok {
+			writeNotFound(w, id)
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusOK, existing.body)
+}
+
+func (s *Server) handlePut(w http.ResponseWriter, r *http.Request, id string) {
+	body, err := readBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.parentExists(w, id) {
+		return
+	}
+
+	key := resourceKey(id)
+	existing, exists := s.resources[key]
+	if exists && existing.pending != nil && !existing.pending.completed {
+		writeError(w, http.StatusConflict, "AnotherOperationInProgress", fmt.Sprintf("another operation is in progress on %q", id))
+		return
+	}
+
+	statusCode := http.StatusCreated
+	provisioningState := provisioningStateCreating
+	if exists {
+		statusCode = http.StatusOK
+		provisioningState = provisioningStateUpdating
+		// the casing of the ID is retained from when the resource was created
+		id = existing.id
+	}
+
+	s.writeResource(w, key, id, normaliseResource(id, body, provisioningState), statusCode, isResourceGroup(splitSegments(id)))
+}
+
+func (s *Server) handlePatch(w http.ResponseWriter, r *http.Request, id string) {
+	body, err := readBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := resourceKey(id)
+	existing, ok := s.resources[key]
+	if !ok {
+		writeNotFound(w, id)
+		return
+	}
+	if existing.pending != nil && !existing.pending.completed {
+		writeError(w, http.StatusConflict, "AnotherOperationInProgress", fmt.Sprintf("another operation is in progress on %q", existing.id))
+		return
+	}
+
+	updated := mergeBody(copyBody(existing.body), body)
+	s.writeResource(w, key, existing.id, normaliseResource(existing.id, updated, provisioningStateUpdating), http.StatusOK, isResourceGroup(splitSegments(existing.id)))
+}
+
+// writeResource stores the resource and writes the response - completing the operation synchronously
+// if the resource is a Resource Group (as in Azure) or if PollsUntilComplete is 0
+func (s *Server) writeResource(w http.ResponseWriter, key, id string, body map[string]interface{}, statusCode int, synchronous bool) {
+	r := &resource{
+		id:   id,
+		body: body,
+	}
+	s.resources[key] = r
+
+	if synchronous || s.PollsUntilComplete == 0 {
+		setProvisioningState(r.body, provisioningStateSucceeded)
+		writeJSON(w, statusCode, r.body)
+		return
+	}
+
+	op := s.newOperation(key, false)
+	r.pending = op
+	w.Header().Set("Azure-AsyncOperation", s.operationUrl("operationStatuses", op.name))
+	w.Header().Set("Retry-After", "0")
+	writeJSON(w, statusCode, r.body)
+}
+
+func (s *Server) handleDelete(w http.ResponseWriter, r *http.Request, id string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := resourceKey(id)
+	existing, ok := s.resources[key]
+	if !ok {
+		// deleting a resource which doesn't exist succeeds
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if s.PollsUntilComplete == 0 {
+		s.deleteResource(key)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	setProvisioningState(existing.body, provisioningStateDeleting)
+	op := s.newOperation(key, true)
+	existing.pending = op
+	w.Header().Set("Location", s.operationUrl("operationResults", op.name))
+	w.Header().Set("Retry-After", "0")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) handleList(w http.ResponseWriter, segments []string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	collection := resourceKey("/" + strings.Join(segments, "/"))
+	collectionType := strings.ToLower(resourceType(append(segments, "")))
+	subscriptionWide := len(segments) == 5 && strings.EqualFold(segments[2], "providers")
+
+	keys := make([]string, 0)
+	for key := range s.resources {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	items := make([]interface{}, 0)
+	for _, key := range keys {
+		r := s.resources[key]
+		var matches bool
+		if subscriptionWide {
+			// e.g. `/subscriptions/{id}/providers/Microsoft.Network/virtualNetworks` lists these in all Resource Groups
+			matches = strings.HasPrefix(key, strings.ToLower("/subscriptions/"+segments[1]+"/")) && strings.EqualFold(resourceType(splitSegments(r.id)), collectionType)
+		} else {
+			matches = strings.HasPrefix(key, collection+"/") && !strings.Contains(strings.TrimPrefix(key, collection+"/"), "/")
+		}
+
+		if matches {
+			items = append(items, r.body)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"value": items,
+	})
+}
+
+func (s *Server) handleOperation(w http.ResponseWriter, r *http.Request, segments []string) {
+	if r.Method != http.MethodGet || len(segments) != 4 {
+		writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("the operation %q was not found", r.URL.Path))
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	op, ok := s.operations[segments[3]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("the operation %q was not found", segments[3]))
+		return
+	}
+
+	s.pollOperation(op)
+
+	if strings.EqualFold(segments[2], "operationResults") {
+		// `Location` polling returns a 202 until the operation completes
+		if !op.completed {
+			w.Header().Set("Location", s.operationUrl("operationResults", op.name))
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	status := "InProgress"
+	if op.completed {
+		status = provisioningStateSucceeded
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"name":   op.name,
+		"status": status,
+	})
+}
+
+func (s *Server) newOperation(key string, delete bool) *operation {
+	s.operationNumber++
+	op := &operation{
+		name:        fmt.Sprintf("op-%d", s.operationNumber),
+		delete:      delete,
+		resourceKey: key,
+	}
+	s.operations[op.name] = op
+	return op
+}
+
+// pollOperation records that this operation has been polled, completing it once it's been polled enough times
+func (s *Server) pollOperation(op *operation) {
+	if op.completed {
+		return
+	}
+
+	op.polls++
+	if op.polls < s.PollsUntilComplete {
+		return
+	}
+
+	op.completed = true
+	r, ok := s.resources[op.resourceKey]
+	if !ok || r.pending != op {
+		return
+	}
+
+	r.pending = nil
+	if op.delete {
+		s.deleteResource(op.resourceKey)
+		return
+	}
+	setProvisioningState(r.body, provisioningStateSucceeded)
+}
+
+// deleteResource deletes the resource and any nested resources, e.g. deleting a Resource Group deletes its contents
+func (s *Server) deleteResource(key string) {
+	delete(s.resources, key)
+	for k := range s.resources {
+		if strings.HasPrefix(k, key+"/") {
+			delete(s.resources, k)
+		}
+	}
+}
+
+// parentExists checks that the Resource Group and any parent resources exist, writing the error returned by Azure if not
+func (s *Server) parentExists(w http.ResponseWriter, id string) bool {
+	segments := splitSegments(id)
+
+	if len(segments) > 4 && strings.EqualFold(segments[2], "resourceGroups") {
+		resourceGroupId := "/" + strings.Join(segments[:4], "/")
+		if _, ok := s.resources[resourceKey(resourceGroupId)]; !ok {
+			writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", segments[3]))
+			return false
+		}
+	}
+
+	// nested resources, e.g. `.../virtualNetworks/{name}/subnets/{name}`, require the parent resource exists
+	if providers := lastProvidersIndex(segments); providers >= 0 && len(segments)-providers > 4 {
+		parentId := "/" + strings.Join(segments[:len(segments)-2], "/")
+		if _, ok := s.resources[resourceKey(parentId)]; !ok {
+			writeError(w, http.StatusNotFound, "ParentResourceNotFound", fmt.Sprintf("Can not perform requested operation on nested resource. Parent resource '%s' not found.", parentId))
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *Server) operationUrl(operationType, name string) string {
+	return fmt.Sprintf("%s/providers/Microsoft.Resources/%s/%s?api-version=2020-01-01", s.URL(), operationType, name)
+}
+
+func writeNotFound(w http.ResponseWriter, id string) {
+	segments := splitSegments(id)
+	if isResourceGroup(segments) {
+		writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", segments[3]))
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource '%s' was not found.", strings.TrimPrefix(id, "/")))
+}
+
+func readBody(r *http.Request) (map[string]interface{}, error) {
+	contents, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading request body: %+v", err)
+	}
+
+	body := make(map[string]interface{})
+	if len(contents) == 0 {
+		return body, nil
+	}
+	if err := json.Unmarshal(contents, &body); err != nil {
+		return nil, fmt.Errorf("parsing request body: %+v", err)
+	}
+	return body, nil
+}
+
+// normaliseResource sets the read-only fields which Azure Resource Manager returns for each resource
+func normaliseResource(id string, body map[string]interface{}, provisioningState string) map[string]interface{} {
+	output := copyBody(body)
+	segments := splitSegments(id)
+
+	output["id"] = id
+	output["name"] = segments[len(segments)-1]
+	output["type"] = resourceType(segments)
+	setProvisioningState(output, provisioningState)
+
+	return output
+}
+
+func setProvisioningState(body map[string]interface{}, provisioningState string) {
+	properties, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+		body["properties"] = properties
+	}
+	properties["provisioningState"] = provisioningState
+}
+
+// mergeBody merges the (PATCH) body into the existing body, as a JSON Merge Patch
+func mergeBody(existing, patch map[string]interface{}) map[string]interface{} {
+	for k, v := range patch {
+		if v == nil {
+			delete(existing, k)
+			continue
+		}
+
+		if nested, ok := v.(map[string]interface{}); ok {
+			if current, ok := existing[k].(map[string]interface{}); ok && k != "tags" {
+				existing[k] = mergeBody(current, nested)
+				continue
+			}
+		}
+		existing[k] = v
+	}
+	return existing
+}
+
+func copyBody(input map[string]interface{}) map[string]interface{} {
+	contents, _ := json.Marshal(input)
+	output := make(map[string]interface{})
+	_ = json.Unmarshal(contents, &output)
+	return output
+}
+
+func splitSegments(id string) []string {
+	return strings.Split(strings.Trim(id, "/"), "/")
+}
+
+// resourceKey returns the key used to store the resource, since Resource IDs are case-insensitive
+func resourceKey(id string) string {
+	return strings.ToLower("/" + strings.Trim(id, "/"))
+}
+
+func lastProvidersIndex(segments []string) int {
+	for i := len(segments) - 1; i >= 0; i-- {
+		if strings.EqualFold(segments[i], "providers") {
+			return i
+		}
+	}
+	return -1
+}
+
+func isResourceGroup(segments []string) bool {
+	return len(segments) == 4 && strings.EqualFold(segments[2], "resourceGroups")
+}
+
+// isResourceProviderRequest returns whether the path refers to the Resource Providers, e.g. `/subscriptions/{id}/providers`,
+// `/subscriptions/{id}/providers/Microsoft.Network` or `/subscriptions/{id}/providers/Microsoft.Network/register`
+func isResourceProviderRequest(segments []string) bool {
+	providers := lastProvidersIndex(segments)
+	switch providers {
+	case len(segments) - 1, len(segments) - 2:
+		return true
+	case len(segments) - 3:
+		return strings.EqualFold(segments[len(segments)-1], "register")
+	}
+	return false
+}
+
+// isCollection returns whether the path refers to a collection of resources (e.g. `.../virtualNetworks`)
+// rather than a single resource (e.g. `.../virtualNetworks/example`)
+func isCollection(segments []string) bool {
+	providers := lastProvidersIndex(segments)
+	if providers < 0 {
+		// e.g. `/subscriptions/{id}/resourceGroups`
+		return len(segments)%2 == 1
+	}
+
+	// the segments following the provider namespace are pairs of type and name
+	return (len(segments)-providers-2)%2 == 1
+}
+
+// resourceType returns the type of the resource, e.g. `Microsoft.Network/virtualNetworks/subnets`
+func resourceType(segments []string) string {
+	providers := lastProvidersIndex(segments)
+	if providers < 0 {
+		if len(segments) >= 3 && strings.EqualFold(segments[2], "resourceGroups") {
+			return "Microsoft.Resources/resourceGroups"
+		}
+		return ""
+	}
+
+	if len(segments) <= providers+1 {
+		return ""
+	}
+
+	types := []string{segments[providers+1]}
+	for i := providers + 2; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+	return strings.Join(types, "/")
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fakearm provides an in-process fake of Azure Resource Manager, which allows the client
+// and basic CRUD flows of simple resources to be exercised without access to Azure.
+package fakearm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// ClientId is the Client ID of the (fake) Service Principal the tokens are issued to
+	ClientId = "00000000-0000-0000-0000-000000000001"
+
+	// ObjectId is the Object ID of the (fake) Service Principal the tokens are issued to
+	ObjectId = "00000000-0000-0000-0000-000000000002"
+
+	// SubscriptionId is the ID of the (fake) Subscription, however any Subscription ID can be used
+	SubscriptionId = "00000000-0000-0000-0000-000000000003"
+
+	// TenantId is the ID of the (fake) Tenant
+	TenantId = "00000000-0000-0000-0000-000000000004"
+)
+
+// Server is an in-process fake of Azure Resource Manager.
+//
+// Resources are stored in-memory and can be created (PUT), retrieved (GET), updated (PATCH) and deleted (DELETE)
+// using any Resource ID - with Long Running Operations polled using the `Azure-AsyncOperation` and `Location`
+// headers. The Server also serves the Metadata and Token endpoints, so that it can be used as the Metadata Host
+// for a `clients.ClientBuilder`.
+type Server struct {
+	// PollsUntilComplete is the number of times a Long Running Operation has to be polled before it completes,
+	// a value of 0 means that operations complete synchronously
+	PollsUntilComplete int
+
+	server *httptest.Server
+
+	lock            sync.Mutex
+	resources       map[string]*resource
+	operations      map[string]*operation
+	operationNumber int
+}
+
+// NewServer starts a new Server, which must be closed using Close once it's no longer needed
+func NewServer() *Server {
+	s := &Server{
+		PollsUntilComplete: 1,
+		resources:          map[string]*resource{},
+		operations:         map[string]*operation{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metadata/endpoints", s.handleMetadata)
+	mux.HandleFunc("/", s.handleRequest)
+	s.server = httptest.NewServer(mux)
+
+	return s
+}
+
+// Close stops the Server
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// URL returns the base URL for the Server, which is used as the Resource Manager (and Login) endpoint
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// MetadataHost returns the Metadata Host for the Server, which can be used to configure the Azure Environment
+func (s *Server) MetadataHost() string {
+	return s.server.URL
+}
+
+// Client returns an HTTP Client which can be used to make requests to the Server
+func (s *Server) Client() *http.Client {
+	return s.server.Client()
+}
+
+func (s *Server) handleRequest(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/oauth2/v2.0/token") {
+		s.handleToken(w, r)
+		return
+	}
+
+	s.handleResourceManager(w, r)
+}
+
+func (s *Server) handleMetadata(w http.ResponseWriter, _ *http.Request) {
+	metadata := map[string]interface{}{
+		"name":                     "FakeAzureResourceManager",
+		"portal":                   s.URL(),
+		"resourceManager":          s.URL(),
+		"microsoftGraphResourceId": s.URL(),
+		"authentication": map[string]interface{}{
+			"loginEndpoint":    s.URL(),
+			"audiences":        []string{s.URL()},
+			"tenant":           "common",
+			"identityProvider": "AAD",
+		},
+		"suffixes": map[string]interface{}{
+			"acrLoginServer":            "azurecr.io",
+			"keyVaultDns":               "vault.azure.net",
+			"mhsmDns":                   "managedhsm.azure.net",
+			"mariadbServerEndpoint":     "mariadb.database.azure.com",
+			"mysqlServerEndpoint":       "mysql.database.azure.com",
+			"postgresqlServerEndpoint":  "postgres.database.azure.com",
+			"sqlServerHostname":         "database.windows.net",
+			"storage":                   "core.windows.net",
+			"storageSyncEndpointSuffix": "afs.azure.net",
+			"synapseAnalytics":          "dev.azuresynapse.net",
+		},
+		"batch":                      "https://batch.core.windows.net/",
+		"logAnalyticsResourceId":     "https://api.loganalytics.io",
+		"ossrDbmsResourceId":         "https://ossrdbms-aad.database.windows.net",
+		"synapseAnalyticsResourceId": "https://dev.azuresynapse.net",
+	}
+
+	writeJSON(w, http.StatusOK, metadata)
+}
+
+// handleToken issues an (unsigned) access token for any client credentials
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", fmt.Sprintf("parsing form: %+v", err))
+		return
+	}
+
+	clientId := r.PostForm.Get("client_id")
+	if clientId == "" {
+		clientId = ClientId
+	}
+
+	header, _ := json.Marshal(map[string]string{
+		"alg": "none",
+		"typ": "JWT",
+	})
+	claims, _ := json.Marshal(map[string]interface{}{
+		"appid": clientId,
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"oid":   ObjectId,
+		"tid":   TenantId,
+	})
+	token := fmt.Sprintf("%s.%s.fake", base64.RawURLEncoding.EncodeToString(header), base64.RawURLEncoding.EncodeToString(claims))
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": token,
+		"expires_in":   3600,
+		"token_type":   "Bearer",
+	})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	if body != nil {
+		json.NewEncoder(w).Encode(body)
+	}
+}
+
+// writeError writes an error in the format returned by Azure Resource Manager
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	writeJSON(w, statusCode, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
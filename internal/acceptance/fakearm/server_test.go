@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearm_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/virtualnetworks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/fakearm"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestServer_ResourceLifecycle(t *testing.T) {
+	server := fakearm.NewServer()
+	defer server.Close()
+	server.PollsUntilComplete = 2
+
+	resourceGroupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/example", fakearm.SubscriptionId)
+	virtualNetworkId := resourceGroupId + "/providers/Microsoft.Network/virtualNetworks/example"
+	subnetId := virtualNetworkId + "/subnets/internal"
+
+	// nested resources require both the Resource Group and parent resource exist
+	expectError(t, sendRequest(t, server, http.MethodPut, virtualNetworkId, `{"location":"westeurope"}`), http.StatusNotFound, "ResourceGroupNotFound")
+	expectError(t, sendRequest(t, server, http.MethodGet, resourceGroupId, ""), http.StatusNotFound, "ResourceGroupNotFound")
+
+	// Resource Groups are created synchronously
+	resp := sendRequest(t, server, http.MethodPut, resourceGroupId, `{"location":"westeurope"}`)
+	expectProvisioningState(t, resp, http.StatusCreated, "Succeeded")
+
+	expectError(t, sendRequest(t, server, http.MethodPut, subnetId, `{}`), http.StatusNotFound, "ParentResourceNotFound")
+
+	// other resources are created asynchronously, completing once the operation has been polled
+	resp = sendRequest(t, server, http.MethodPut, virtualNetworkId, `{"location":"westeurope","properties":{"addressSpace":{"addressPrefixes":["10.0.0.0/16"]}}}`)
+	expectProvisioningState(t, resp, http.StatusCreated, "Creating")
+	operationUrl := resp.header.Get("Azure-AsyncOperation")
+	if operationUrl == "" {
+		t.Fatalf("expected an `Azure-AsyncOperation` header")
+	}
+	expectError(t, sendRequest(t, server, http.MethodPut, virtualNetworkId, `{}`), http.StatusConflict, "AnotherOperationInProgress")
+
+	expectOperationStatus(t, sendRequest(t, server, http.MethodGet, operationUrl, ""), "InProgress")
+	expectOperationStatus(t, sendRequest(t, server, http.MethodGet, operationUrl, ""), "Succeeded")
+	expectProvisioningState(t, sendRequest(t, server, http.MethodGet, strings.ToUpper(virtualNetworkId), ""), http.StatusOK, "Succeeded")
+
+	// updates are merged into the existing resource
+	server.PollsUntilComplete = 0
+	resp = sendRequest(t, server, http.MethodPatch, virtualNetworkId, `{"tags":{"environment":"test"}}`)
+	expectProvisioningState(t, resp, http.StatusOK, "Succeeded")
+	if resp.body["type"] != "Microsoft.Network/virtualNetworks" || resp.body["id"] != virtualNetworkId {
+		t.Fatalf("expected the `id` and `type` to be set but got %+v", resp.body)
+	}
+	if tags, _ := resp.body["tags"].(map[string]interface{}); tags["environment"] != "test" {
+		t.Fatalf("expected the tags to be updated but got %+v", resp.body)
+	}
+	if properties, _ := resp.body["properties"].(map[string]interface{}); properties["addressSpace"] == nil {
+		t.Fatalf("expected the existing properties to be retained but got %+v", resp.body)
+	}
+
+	expectProvisioningState(t, sendRequest(t, server, http.MethodPut, subnetId, `{"properties":{"addressPrefix":"10.0.1.0/24"}}`), http.StatusCreated, "Succeeded")
+
+	// collections can be listed within the parent, or across the Subscription
+	for _, collection := range []string{
+		resourceGroupId + "/providers/Microsoft.Network/virtualNetworks",
+		fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Network/virtualNetworks", fakearm.SubscriptionId),
+	} {
+		resp = sendRequest(t, server, http.MethodGet, collection, "")
+		if items, _ := resp.body["value"].([]interface{}); len(items) != 1 {
+			t.Fatalf("expected 1 item when listing %q but got %+v", collection, resp.body)
+		}
+	}
+
+	// deletions are polled using the `Location` header
+	server.PollsUntilComplete = 1
+	resp = sendRequest(t, server, http.MethodDelete, resourceGroupId, "")
+	if resp.statusCode != http.StatusAccepted || resp.header.Get("Location") == "" {
+		t.Fatalf("expected a 202 with a `Location` header but got %d", resp.statusCode)
+	}
+	if resp = sendRequest(t, server, http.MethodGet, resp.header.Get("Location"), ""); resp.statusCode != http.StatusNoContent {
+		t.Fatalf("expected a 204 once the deletion completed but got %d", resp.statusCode)
+	}
+
+	// deleting the Resource Group deletes its contents
+	expectError(t, sendRequest(t, server, http.MethodGet, virtualNetworkId, ""), http.StatusNotFound, "ResourceNotFound")
+	expectError(t, sendRequest(t, server, http.MethodGet, subnetId, ""), http.StatusNotFound, "ResourceNotFound")
+	if resp = sendRequest(t, server, http.MethodDelete, resourceGroupId, ""); resp.statusCode != http.StatusNoContent {
+		t.Fatalf("expected a 204 when deleting a Resource Group which doesn't exist but got %d", resp.statusCode)
+	}
+}
+
+func TestServer_Client(t *testing.T) {
+	t.Setenv("ARM_PROVIDER_ENHANCED_VALIDATION", "false")
+
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	client, err := testclient.BuildForFakeResourceManager(ctx, server)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	if client.Account.ObjectId != fakearm.ObjectId || client.Account.TenantId != fakearm.TenantId {
+		t.Fatalf("expected the account details to be parsed from the token but got %+v", client.Account)
+	}
+
+	resourceGroupId := commonids.NewResourceGroupID(fakearm.SubscriptionId, "example")
+	state := &terraform.State{
+		Modules: []*terraform.ModuleState{
+			{
+				Path: []string{"root"},
+				Resources: map[string]*terraform.ResourceState{
+					"azurerm_resource_group.test": {
+						Type: "azurerm_resource_group",
+						Primary: &terraform.InstanceState{
+							ID: resourceGroupId.ID(),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if err := helpers.DoesNotExistInAzure(client, fakeResourceGroupResource{}, "azurerm_resource_group.test")(state); err != nil {
+		t.Fatalf("expected the Resource Group not to exist: %+v", err)
+	}
+
+	if _, err := client.Resource.ResourceGroupsClient.CreateOrUpdate(ctx, resourceGroupId, resourcegroups.ResourceGroup{
+		Location: "westeurope",
+	}); err != nil {
+		t.Fatalf("creating %s: %+v", resourceGroupId, err)
+	}
+
+	if err := helpers.ExistsInAzure(client, fakeResourceGroupResource{}, "azurerm_resource_group.test")(state); err != nil {
+		t.Fatalf("expected the Resource Group to exist: %+v", err)
+	}
+
+	// a long-running operation
+	virtualNetworkId := commonids.NewVirtualNetworkID(fakearm.SubscriptionId, "example", "example")
+	if err := client.Network.VirtualNetworks.CreateOrUpdateThenPoll(ctx, virtualNetworkId, virtualnetworks.VirtualNetwork{
+		Location: pointer.To("westeurope"),
+		Properties: &virtualnetworks.VirtualNetworkPropertiesFormat{
+			AddressSpace: &virtualnetworks.AddressSpace{
+				AddressPrefixes: pointer.To([]string{"10.0.0.0/16"}),
+			},
+		},
+	}); err != nil {
+		t.Fatalf("creating %s: %+v", virtualNetworkId, err)
+	}
+
+	resp, err := client.Network.VirtualNetworks.Get(ctx, virtualNetworkId, virtualnetworks.DefaultGetOperationOptions())
+	if err != nil {
+		t.Fatalf("retrieving %s: %+v", virtualNetworkId, err)
+	}
+	if resp.Model == nil || resp.Model.Properties == nil || pointer.From(resp.Model.Properties.ProvisioningState) != virtualnetworks.ProvisioningStateSucceeded {
+		t.Fatalf("expected %s to have been provisioned but got %+v", virtualNetworkId, resp.Model)
+	}
+
+	server.PollsUntilComplete = 0
+	if resp := sendRequest(t, server, http.MethodDelete, resourceGroupId.ID(), ""); resp.statusCode != http.StatusOK {
+		t.Fatalf("expected a 200 when deleting synchronously but got %d", resp.statusCode)
+	}
+
+	if err := helpers.CheckDestroyedFunc(client, fakeResourceGroupResource{}, "azurerm_resource_group", "azurerm_resource_group.test")(state); err != nil {
+		t.Fatalf("expected the Resource Group to have been destroyed: %+v", err)
+	}
+}
+
+type fakeResourceGroupResource struct{}
+
+func (fakeResourceGroupResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseResourceGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Resource.ResourceGroupsClient.Get(ctx, *id)
+	if err != nil {
+		if resp.HttpResponse != nil && resp.HttpResponse.StatusCode == http.StatusNotFound {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+type response struct {
+	statusCode int
+	header     http.Header
+	body       map[string]interface{}
+}
+
+func sendRequest(t *testing.T, server *fakearm.Server, method, uri, body string) response {
+	if !strings.HasPrefix(uri, "http") {
+		uri = fmt.Sprintf("%s%s?api-version=2024-05-01", server.URL(), uri)
+	}
+
+	var reqBody io.Reader
+	if body != "" {
+		reqBody = strings.NewReader(body)
+	}
+	req, err := http.NewRequest(method, uri, reqBody)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatalf("sending %s %s: %+v", method, uri, err)
+	}
+	defer resp.Body.Close()
+
+	output := response{
+		statusCode: resp.StatusCode,
+		header:     resp.Header,
+		body:       map[string]interface{}{},
+	}
+	contents, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading response: %+v", err)
+	}
+	if len(contents) > 0 {
+		if err := json.Unmarshal(contents, &output.body); err != nil {
+			t.Fatalf("parsing response %s: %+v", contents, err)
+		}
+	}
+	return output
+}
+
+func expectError(t *testing.T, resp response, statusCode int, code string) {
+	t.Helper()
+
+	errorBody, _ := resp.body["error"].(map[string]interface{})
+	if resp.statusCode != statusCode || errorBody["code"] != code {
+		t.Fatalf("expected a %d with the error code %q but got %d: %+v", statusCode, code, resp.statusCode, resp.body)
+	}
+}
+
+func expectProvisioningState(t *testing.T, resp response, statusCode int, provisioningState string) {
+	t.Helper()
+
+	properties, _ := resp.body["properties"].(map[string]interface{})
+	if resp.statusCode != statusCode || properties["provisioningState"] != provisioningState {
+		t.Fatalf("expected a %d with the provisioningState %q but got %d: %+v", statusCode, provisioningState, resp.statusCode, resp.body)
+	}
+}
+
+func expectOperationStatus(t *testing.T, resp response, status string) {
+	t.Helper()
+
+	if resp.statusCode != http.StatusOK || resp.body["status"] != status {
+		t.Fatalf("expected the operation status to be %q but got %d: %+v", status, resp.statusCode, resp.body)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+const (
+	// recordingPathEnvVar can be used to override the directory where recordings are stored, which
+	// defaults to `testdata/recordings` within the package being tested
+	recordingPathEnvVar = "ARM_TEST_RECORDING_PATH"
+
+	// recordingPlaceholderAlternateId is the value the alternate Subscription and Tenant IDs are replaced with,
+	// which needs to differ from the primary IDs so that requests to each can be told apart during replay
+	recordingPlaceholderAlternateId = "00000000-0000-0000-0000-000000000001"
+)
+
+var (
+	recordingSessions    = map[string]*common.RecordingSession{}
+	recordingSessionLock = &sync.Mutex{}
+)
+
+// recordingSessionForTest returns the RecordingSession for this test when `ARM_TEST_RECORDING_MODE` is set,
+// starting one if required - or nil if requests should be sent to Azure as normal
+func recordingSessionForTest(t *testing.T) *common.RecordingSession {
+	mode := common.RecordingMode()
+	if mode == "" {
+		return nil
+	}
+
+	recordingSessionLock.Lock()
+	defer recordingSessionLock.Unlock()
+
+	if session, ok := recordingSessions[t.Name()]; ok {
+		return session
+	}
+
+	// the Supported Locations and Resource Providers are cached by the Provider, rather than requested per test
+	t.Setenv("ARM_PROVIDER_ENHANCED_VALIDATION", "false")
+
+	if mode == common.RecordingModeReplay {
+		// the recordings contain placeholder values, which the Provider needs to be configured with for the URLs to match
+		placeholders := map[string]string{
+			"ARM_CLIENT_ID":           common.RecordingPlaceholderId,
+			"ARM_CLIENT_SECRET":       common.RecordingPlaceholderSecret,
+			"ARM_SUBSCRIPTION_ID":     common.RecordingPlaceholderId,
+			"ARM_SUBSCRIPTION_ID_ALT": recordingPlaceholderAlternateId,
+			"ARM_TENANT_ID":           common.RecordingPlaceholderId,
+			"ARM_TENANT_ID_ALT":       recordingPlaceholderAlternateId,
+		}
+		for k, v := range placeholders {
+			t.Setenv(k, v)
+		}
+	}
+
+	replacements := map[string]string{
+		os.Getenv("ARM_CLIENT_ID"):           common.RecordingPlaceholderId,
+		os.Getenv("ARM_SUBSCRIPTION_ID"):     common.RecordingPlaceholderId,
+		os.Getenv("ARM_SUBSCRIPTION_ID_ALT"): recordingPlaceholderAlternateId,
+		os.Getenv("ARM_TENANT_ID"):           common.RecordingPlaceholderId,
+		os.Getenv("ARM_TENANT_ID_ALT"):       recordingPlaceholderAlternateId,
+	}
+	delete(replacements, "")
+
+	session, err := common.NewRecordingSession(mode, recordingPath(t), t.Name(), replacements)
+	if err != nil {
+		t.Fatalf("starting recording session: %+v", err)
+	}
+
+	common.SetRecordingSession(session)
+	recordingSessions[t.Name()] = session
+
+	t.Cleanup(func() {
+		recordingSessionLock.Lock()
+		defer recordingSessionLock.Unlock()
+
+		delete(recordingSessions, t.Name())
+		if err := session.Stop(); err != nil {
+			t.Errorf("stopping recording session: %+v", err)
+		}
+	})
+
+	return session
+}
+
+// recordingPath returns the path to the recording for this test
+func recordingPath(t *testing.T) string {
+	directory := os.Getenv(recordingPathEnvVar)
+	if directory == "" {
+		directory = filepath.Join("testdata", "recordings")
+	}
+
+	return filepath.Join(directory, filepath.FromSlash(t.Name())+".json")
+}
@@ -52,7 +52,7 @@ func TestAccEnsureRequiredResourceProvidersAreRegistered(t *testing.T) {
 		t.Fatalf("re-caching Resource Providers: %+v", err)
 	}
 
-	stillRequiringRegistration, err := resourceproviders.DetermineWhichRequiredResourceProvidersRequireRegistration(requiredResourceProviders)
+	stillRequiringRegistration, err := resourceproviders.DetermineWhichRequiredResourceProvidersRequireRegistration(subscriptionId, requiredResourceProviders)
 	if err != nil {
 		t.Fatalf("determining which Resource Providers still require Registration: %+v", err)
 	}
@@ -7,10 +7,12 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"sync"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/fakearm"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
@@ -40,7 +42,11 @@ func Build() (*clients.Client, error) {
 		}
 
 		if metadataHost != "" {
-			if env, err = environments.FromEndpoint(ctx, fmt.Sprintf("https://%s", metadataHost)); err != nil {
+			// the Metadata Host can optionally include the scheme, e.g. when using a fake Resource Manager
+			if !strings.Contains(metadataHost, "://") {
+				metadataHost = fmt.Sprintf("https://%s", metadataHost)
+			}
+			if env, err = environments.FromEndpoint(ctx, metadataHost); err != nil {
 				return nil, fmt.Errorf("building test client: %+v", err)
 			}
 		} else if env, err = environments.FromName(envName); err != nil {
@@ -82,3 +88,40 @@ func Build() (*clients.Client, error) {
 
 	return _client, nil
 }
+
+// BuildForFakeResourceManager returns a client which uses the fake Azure Resource Manager, allowing the Exists and
+// CheckDestroyed functions (and basic CRUD flows) for simple resources to be exercised without access to Azure.
+//
+// NOTE: Enhanced Validation should be disabled (via `ARM_PROVIDER_ENHANCED_VALIDATION`), since the Supported
+// Locations are retrieved from the Azure Metadata Service rather than the Metadata Host.
+func BuildForFakeResourceManager(ctx context.Context, server *fakearm.Server) (*clients.Client, error) {
+	env, err := environments.FromEndpoint(ctx, server.MetadataHost())
+	if err != nil {
+		return nil, fmt.Errorf("building fake environment: %+v", err)
+	}
+
+	authConfig := auth.Credentials{
+		Environment:  *env,
+		ClientID:     fakearm.ClientId,
+		ClientSecret: "fake",
+		TenantID:     fakearm.TenantId,
+
+		EnableAuthenticatingUsingClientSecret: true,
+	}
+
+	clientBuilder := clients.ClientBuilder{
+		AuthConfig:        &authConfig,
+		TerraformVersion:  os.Getenv("TERRAFORM_CORE_VERSION"),
+		Features:          features.Default(),
+		MetadataHost:      server.MetadataHost(),
+		StorageUseAzureAD: false,
+		SubscriptionID:    fakearm.SubscriptionId,
+	}
+
+	client, err := clients.Build(ctx, clientBuilder)
+	if err != nil {
+		return nil, fmt.Errorf("building test client for fake Resource Manager: %+v", err)
+	}
+
+	return client, nil
+}
@@ -24,15 +24,17 @@ type ClientBuilder struct {
 	AuthConfig *auth.Credentials
 	Features   features.UserFeatures
 
-	CustomCorrelationRequestID  string
-	DisableCorrelationRequestID bool
-	DisableTerraformPartnerID   bool
-	MetadataHost                string
-	PartnerID                   string
-	RegisteredResourceProviders resourceproviders.ResourceProviders
-	StorageUseAzureAD           bool
-	SubscriptionID              string
-	TerraformVersion            string
+	CustomCorrelationRequestID    string
+	DisableCorrelationRequestID   bool
+	DisableTerraformPartnerID     bool
+	LockBackend                   *LockBackend
+	MetadataHost                  string
+	PartnerID                     string
+	RegisteredResourceProviders   resourceproviders.ResourceProviders
+	StorageUseAzureAD             bool
+	SubscriptionID                string
+	SubscriptionRequestsPerSecond int
+	TerraformVersion              string
 }
 
 const azureStackEnvironmentError = `
@@ -54,23 +56,23 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	var resourceManagerAuth, storageAuth, synapseAuth, batchManagementAuth, keyVaultAuth auth.Authorizer
 
-	resourceManagerAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ResourceManager)
+	resourceManagerAuth, err = common.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Resource Manager API: %+v", err)
 	}
 
-	storageAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Storage)
+	storageAuth, err = common.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Storage)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Storage API: %+v", err)
 	}
 
-	keyVaultAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.KeyVault)
+	keyVaultAuth, err = common.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.KeyVault)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Key Vault API: %+v", err)
 	}
 
 	if builder.AuthConfig.Environment.Synapse.Available() {
-		synapseAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Synapse)
+		synapseAuth, err = common.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Synapse)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Synapse API: %+v", err)
 		}
@@ -79,7 +81,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	if builder.AuthConfig.Environment.Batch.Available() {
-		batchManagementAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Batch)
+		batchManagementAuth, err = common.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Batch)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Batch Management API: %+v", err)
 		}
@@ -89,7 +91,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	// Helper for obtaining endpoint-specific tokens
 	authorizerFunc := common.ApiAuthorizerFunc(func(api environments.Api) (auth.Authorizer, error) {
-		authorizer, err := auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, api)
+		authorizer, err := common.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, api)
 		if err != nil {
 			return nil, fmt.Errorf("building custom authorizer for API %q: %+v", api.Name(), err)
 		}
@@ -102,9 +104,12 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, fmt.Errorf("building account: %+v", err)
 	}
 
+	// the Object ID of the authenticated principal is only known once authenticated, so must be scrubbed from any recordings
+	common.AddRecordingReplacement(account.ObjectId, common.RecordingPlaceholderId)
+
 	var managedHSMAuth auth.Authorizer
 	if builder.AuthConfig.Environment.ManagedHSM.Available() {
-		managedHSMAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ManagedHSM)
+		managedHSMAuth, err = common.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ManagedHSM)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Managed HSM API: %+v", err)
 		}
@@ -153,9 +158,15 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		SkipProviderReg:             len(builder.RegisteredResourceProviders) == 0,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
 
+		RateLimiter: common.NewRateLimiter(builder.SubscriptionRequestsPerSecond),
+
 		ResourceManagerEndpoint: *resourceManagerEndpoint,
 	}
 
+	if err := configureLockBackend(o, builder.LockBackend); err != nil {
+		return nil, fmt.Errorf("configuring lock backend: %+v", err)
+	}
+
 	if err := client.Build(ctx, o); err != nil {
 		return nil, fmt.Errorf("building Client: %+v", err)
 	}
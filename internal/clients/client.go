@@ -149,6 +149,7 @@ import (
 	voiceServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/voiceservices/client"
 	web "github.com/hashicorp/terraform-provider-azurerm/internal/services/web/client"
 	workloads "github.com/hashicorp/terraform-provider-azurerm/internal/services/workloads/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 )
 
 type Client struct {
@@ -157,6 +158,16 @@ type Client struct {
 	// StopContext is used for propagating control from Terraform Core (e.g. Ctrl/Cmd+C)
 	StopContext context.Context
 
+	// RegisterResourceProvidersOnDemand specifies whether the Resource Providers used by a Service should be registered
+	// the first time a Resource within that Service is used, rather than when the Provider is configured
+	RegisterResourceProvidersOnDemand bool
+
+	// DefaultTags are the tags specified in the provider block which should be assigned to all resources supporting tags
+	DefaultTags map[string]string
+
+	// IgnoreTags specifies the tags which are managed outside of Terraform and should be ignored on all resources
+	IgnoreTags tags.IgnoreConfig
+
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+)
+
+const (
+	LockBackendTypeAzureBlob = "azure_blob"
+	LockBackendTypeFile      = "file"
+)
+
+// LockBackend configures the backend used to share locks between instances of the Provider
+type LockBackend struct {
+	// Type is the type of backend, either `azure_blob` or `file`
+	Type string
+
+	// Path is the directory containing the lock files, when using the `file` backend
+	Path string
+
+	// StorageAccountName and ContainerName specify the Storage Container containing the Blobs used as locks,
+	// when using the `azure_blob` backend
+	StorageAccountName string
+	ContainerName      string
+}
+
+// configureLockBackend configures the backend used for locks within this process
+func configureLockBackend(o *common.ClientOptions, config *LockBackend) error {
+	if config == nil {
+		return nil
+	}
+
+	var backend locks.Backend
+	switch config.Type {
+	case LockBackendTypeFile:
+		fileBackend, err := locks.NewFileBackend(config.Path)
+		if err != nil {
+			return err
+		}
+		backend = fileBackend
+
+	case LockBackendTypeAzureBlob:
+		if config.StorageAccountName == "" {
+			return fmt.Errorf("`storage_account_name` must be specified when using the %q lock backend", LockBackendTypeAzureBlob)
+		}
+
+		storageSuffix, ok := o.Environment.Storage.DomainSuffix()
+		if !ok {
+			return fmt.Errorf("determining the Storage domain suffix for the current environment")
+		}
+
+		client, err := blobs.NewWithBaseUri(fmt.Sprintf("https://%s.blob.%s", config.StorageAccountName, *storageSuffix))
+		if err != nil {
+			return fmt.Errorf("building Blobs client for the lock backend: %+v", err)
+		}
+		o.Configure(client.Client, o.Authorizers.Storage)
+
+		blobBackend, err := locks.NewBlobLeaseBackend(client, config.ContainerName)
+		if err != nil {
+			return err
+		}
+		backend = blobBackend
+
+	default:
+		return fmt.Errorf("unsupported lock backend %q", config.Type)
+	}
+
+	locks.SetBackend(backend)
+	return nil
+}
@@ -50,6 +50,9 @@ type ClientOptions struct {
 
 	ResourceManagerEndpoint string
 
+	// RateLimiter limits the rate of requests sent to Azure Resource Manager, and is shared between all clients
+	RateLimiter *RateLimiter
+
 	// Legacy authorizers for go-autorest
 	BatchManagementAuthorizer autorest.Authorizer
 	KeyVaultAuthorizer        autorest.Authorizer
@@ -75,15 +78,47 @@ func (o ClientOptions) Configure(c client.BaseClient, authorizer auth.Authorizer
 	}
 
 	c.AppendRequestMiddleware(requestLoggerMiddleware("AzureRM"))
+	if o.RateLimiter != nil {
+		c.AppendRequestMiddleware(rateLimitRequestMiddleware(o.RateLimiter))
+	}
+	c.AppendRequestMiddleware(metricsRequestMiddleware())
+
+	// when recording or replaying (for acceptance tests), the request is redirected after it's been logged
+	// and the original URL is restored before the response is logged
+	ConfigureRecording(c)
+
+	c.AppendResponseMiddleware(metricsResponseMiddleware())
+	if o.RateLimiter != nil {
+		c.AppendResponseMiddleware(rateLimitResponseMiddleware(o.RateLimiter))
+	}
 	c.AppendResponseMiddleware(responseLoggerMiddleware("AzureRM"))
 }
 
+// ConfigureRecording sets up a resourcemanager.Client to record or replay requests when `ARM_TEST_RECORDING_MODE`
+// is set, this is called by Configure but needs calling separately for (data plane) clients which aren't
+// configured using Configure
+func ConfigureRecording(c client.BaseClient) {
+	if RecordingMode() == "" {
+		return
+	}
+
+	c.AppendRequestMiddleware(recordingRequestMiddleware())
+	c.AppendResponseMiddleware(recordingResponseMiddleware())
+}
+
 // ConfigureClient sets up an autorest.Client using an autorest.Authorizer
 func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.Authorizer) {
 	c.UserAgent = userAgent(c.UserAgent, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
 	c.Sender = sender.BuildSender("AzureRM")
+	if RecordingMode() != "" {
+		c.Sender = recordingSender(c.Sender)
+	}
+	c.Sender = metricsSender(c.Sender)
+	if o.RateLimiter != nil {
+		c.Sender = rateLimitSender(o.RateLimiter, c.Sender)
+	}
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
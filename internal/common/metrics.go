@@ -0,0 +1,409 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// MetricsPathEnvVar is the Environment Variable which, when set, specifies the path to the file that
+// the summary of the API calls made by the Provider should be written to (as JSON) when the Provider shuts down
+const MetricsPathEnvVar = "ARM_PROVIDER_METRICS_PATH"
+
+// ApiCallMetrics is a summary of the API calls made for a given Resource Type and ARM Operation
+type ApiCallMetrics struct {
+	// CorrelationRequestId is the value of the `x-ms-correlation-request-id` header sent with these API calls,
+	// which can be used to match these calls to those in a support ticket
+	CorrelationRequestId string `json:"correlation_request_id"`
+
+	// ResourceType is the Resource Type being called, e.g. `Microsoft.Network/virtualNetworks/subnets`
+	ResourceType string `json:"resource_type"`
+
+	// Operation is the ARM Operation being performed, e.g. `CreateOrUpdate`, `List` or `listKeys`
+	Operation string `json:"operation"`
+
+	// Calls is the number of API calls made, excluding any polling of Long Running Operations
+	Calls int `json:"calls"`
+
+	// Retries is the number of additional attempts made by the SDK, e.g. for throttled requests or server errors
+	Retries int `json:"retries"`
+
+	// Throttled is the number of API calls returning a 429 once any retries were exhausted - attempts which are
+	// throttled and then retried by the SDK are counted in Retries
+	Throttled int `json:"throttled"`
+
+	// LongRunningOperationPolls is the number of times a Long Running Operation started by these API calls was polled
+	LongRunningOperationPolls int `json:"long_running_operation_polls"`
+
+	// TotalLatency is the total time spent making these API calls (including any retries), excluding polling
+	TotalLatency time.Duration `json:"total_latency_ns"`
+
+	// MaxLatency is the longest time spent making a single API call (including any retries)
+	MaxLatency time.Duration `json:"max_latency_ns"`
+}
+
+type apiCallMetricsKey struct {
+	correlationRequestId string
+	resourceType         string
+	operation            string
+}
+
+// pendingOperation is an operation which is (or may be) polled until it completes
+type pendingOperation struct {
+	key apiCallMetricsKey
+
+	// delete specifies that the Resource itself is polled until it returns a 404
+	delete bool
+}
+
+type apiCallMetricsCollector struct {
+	lock    sync.Mutex
+	metrics map[apiCallMetricsKey]*ApiCallMetrics
+
+	// pending is a map of the URIs used to poll Long Running Operations (and Resources being created,
+	// updated or deleted) to the operation they're polling
+	pending map[string]pendingOperation
+}
+
+var apiCallMetrics = newApiCallMetricsCollector()
+
+func newApiCallMetricsCollector() *apiCallMetricsCollector {
+	return &apiCallMetricsCollector{
+		metrics: map[apiCallMetricsKey]*ApiCallMetrics{},
+		pending: map[string]pendingOperation{},
+	}
+}
+
+type apiCallMetricsContextKey struct{}
+
+// apiCall tracks a single API call as it's sent by the SDK
+type apiCall struct {
+	started time.Time
+
+	// attempts is incremented for each attempt made by the SDK, including any retries
+	attempts atomic.Int32
+}
+
+// record records the response for an API call, which was sent using the specified number of attempts
+func (c *apiCallMetricsCollector) record(request *http.Request, response *http.Response, latency time.Duration, attempts int) {
+	resourceType, operation := apiCallResourceTypeAndOperation(request.Method, request.URL)
+	key := apiCallMetricsKey{
+		correlationRequestId: request.Header.Get(HeaderCorrelationRequestID),
+		resourceType:         resourceType,
+		operation:            operation,
+	}
+	pollingKey := apiCallPollingKey(request.URL)
+
+	// determining whether an operation is still in progress requires parsing the response, which is done outside the lock
+	c.lock.Lock()
+	pending, isPoll := c.pending[pollingKey]
+	c.lock.Unlock()
+	isPoll = isPoll && strings.EqualFold(request.Method, http.MethodGet)
+
+	inProgress := false
+	if isPoll {
+		key = pending.key
+		if pending.delete {
+			inProgress = response.StatusCode != http.StatusNotFound
+		} else {
+			inProgress = apiCallInProgress(response)
+		}
+	}
+
+	pollingUri := ""
+	if !isPoll {
+		pollingUri = apiCallPollingUri(request, response)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	metrics, ok := c.metrics[key]
+	if !ok {
+		metrics = &ApiCallMetrics{
+			CorrelationRequestId: key.correlationRequestId,
+			ResourceType:         key.resourceType,
+			Operation:            key.operation,
+		}
+		c.metrics[key] = metrics
+	}
+
+	if attempts > 1 {
+		metrics.Retries += attempts - 1
+	}
+	if response.StatusCode == http.StatusTooManyRequests {
+		metrics.Throttled++
+	}
+
+	if isPoll {
+		metrics.LongRunningOperationPolls++
+		if !inProgress {
+			delete(c.pending, pollingKey)
+		}
+		return
+	}
+
+	// any operation previously being polled using this URI has been superseded, e.g. a Resource being
+	// recreated after it's been deleted
+	delete(c.pending, pollingKey)
+
+	metrics.Calls++
+	metrics.TotalLatency += latency
+	if latency > metrics.MaxLatency {
+		metrics.MaxLatency = latency
+	}
+
+	if pollingUri != "" {
+		if uri, err := url.Parse(pollingUri); err == nil {
+			c.pending[apiCallPollingKey(uri)] = pendingOperation{
+				key:    key,
+				delete: strings.EqualFold(request.Method, http.MethodDelete) && pollingUri == request.URL.String(),
+			}
+		}
+	}
+}
+
+// summary returns the metrics recorded so far, sorted by the time spent making the API calls
+func (c *apiCallMetricsCollector) summary() []ApiCallMetrics {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	output := make([]ApiCallMetrics, 0, len(c.metrics))
+	for _, v := range c.metrics {
+		output = append(output, *v)
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		if output[i].TotalLatency != output[j].TotalLatency {
+			return output[i].TotalLatency > output[j].TotalLatency
+		}
+		if output[i].ResourceType != output[j].ResourceType {
+			return output[i].ResourceType < output[j].ResourceType
+		}
+		return output[i].Operation < output[j].Operation
+	})
+
+	return output
+}
+
+// ApiCallMetricsSummary returns a summary of the API calls made by the Provider so far, grouped by the
+// Resource Type and ARM Operation - sorted by the time spent making these calls (longest first)
+func ApiCallMetricsSummary() []ApiCallMetrics {
+	return apiCallMetrics.summary()
+}
+
+// WriteApiCallMetricsSummary logs a summary of the API calls made by the Provider, and writes this summary
+// (as JSON) to the file specified in `ARM_PROVIDER_METRICS_PATH` when set. This is intended to be called
+// when the Provider shuts down.
+func WriteApiCallMetricsSummary(ctx context.Context) error {
+	summary := ApiCallMetricsSummary()
+	if len(summary) == 0 {
+		return nil
+	}
+
+	for _, v := range summary {
+		tflog.Debug(ctx, "AzureRM API Call Metrics", map[string]interface{}{
+			"correlation_request_id":       v.CorrelationRequestId,
+			"resource_type":                v.ResourceType,
+			"operation":                    v.Operation,
+			"calls":                        v.Calls,
+			"retries":                      v.Retries,
+			"throttled":                    v.Throttled,
+			"long_running_operation_polls": v.LongRunningOperationPolls,
+			"total_latency":                v.TotalLatency.String(),
+			"max_latency":                  v.MaxLatency.String(),
+		})
+	}
+
+	path := os.Getenv(MetricsPathEnvVar)
+	if path == "" {
+		return nil
+	}
+
+	contents, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling API call metrics: %+v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating directory for %q: %+v", path, err)
+	}
+
+	if err := os.WriteFile(path, contents, 0o644); err != nil {
+		return fmt.Errorf("writing API call metrics to %q: %+v", path, err)
+	}
+
+	return nil
+}
+
+// apiCallResourceTypeAndOperation returns the Resource Type and ARM Operation for the specified request
+func apiCallResourceTypeAndOperation(method string, uri *url.URL) (resourceType string, operation string) {
+	segments := make([]string, 0)
+	for _, v := range strings.Split(uri.Path, "/") {
+		if v != "" {
+			segments = append(segments, v)
+		}
+	}
+
+	namespace := ""
+	if len(segments) > 0 && strings.EqualFold(segments[0], "subscriptions") {
+		namespace = "Microsoft.Resources"
+	}
+	for i := len(segments) - 2; i >= 0; i-- {
+		if strings.EqualFold(segments[i], "providers") {
+			namespace = segments[i+1]
+			segments = segments[i+2:]
+			break
+		}
+	}
+
+	// Data Plane APIs are grouped by their endpoint, e.g. `vault.azure.net`
+	if namespace == "" {
+		host := uri.Hostname()
+		if _, suffix, ok := strings.Cut(host, "."); ok {
+			host = suffix
+		}
+		return host, strings.ToUpper(method)
+	}
+
+	// the segments are now pairs of types and names, with an odd number meaning this is either a collection
+	// or (when POST'ing) an action being performed on the resource
+	action := ""
+	if len(segments)%2 == 1 && strings.EqualFold(method, http.MethodPost) {
+		action = segments[len(segments)-1]
+		segments = segments[:len(segments)-1]
+	}
+	isCollection := len(segments)%2 == 1
+
+	types := make([]string, 0)
+	for i := 0; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+	if namespace == "Microsoft.Resources" && len(types) > 0 {
+		// e.g. `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}`
+		types = types[len(types)-1:]
+	}
+
+	resourceType = namespace
+	if len(types) > 0 {
+		resourceType = fmt.Sprintf("%s/%s", namespace, strings.Join(types, "/"))
+	}
+
+	switch {
+	case action != "":
+		operation = action
+	case strings.EqualFold(method, http.MethodGet) && isCollection:
+		operation = "List"
+	case strings.EqualFold(method, http.MethodGet):
+		operation = "Get"
+	case strings.EqualFold(method, http.MethodHead):
+		operation = "CheckExistence"
+	case strings.EqualFold(method, http.MethodPut):
+		operation = "CreateOrUpdate"
+	case strings.EqualFold(method, http.MethodPatch):
+		operation = "Update"
+	case strings.EqualFold(method, http.MethodDelete):
+		operation = "Delete"
+	default:
+		operation = strings.ToUpper(method)
+	}
+
+	return resourceType, operation
+}
+
+// apiCallPollingUri returns the URI which the SDK will poll until the operation started by this request
+// completes, if any - which is either a Long Running Operation, or the Resource itself
+func apiCallPollingUri(request *http.Request, response *http.Response) string {
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent:
+	default:
+		return ""
+	}
+
+	pollingUri := response.Header.Get("Azure-AsyncOperation")
+	if pollingUri == "" {
+		pollingUri = response.Header.Get("Location")
+	}
+
+	// Resources being deleted asynchronously are polled until they no longer exist - whereas a Delete which
+	// completes synchronously (a 200 or 204 without a polling URI) isn't polled at all
+	if strings.EqualFold(request.Method, http.MethodDelete) {
+		if pollingUri == "" && response.StatusCode == http.StatusAccepted {
+			return request.URL.String()
+		}
+		return pollingUri
+	}
+
+	if pollingUri != "" {
+		return pollingUri
+	}
+
+	// otherwise Resources which are still being provisioned are polled until they've been provisioned
+	isWrite := strings.EqualFold(request.Method, http.MethodPut) || strings.EqualFold(request.Method, http.MethodPatch)
+	if isWrite && response.StatusCode != http.StatusAccepted && apiCallInProgress(response) {
+		return request.URL.String()
+	}
+
+	return ""
+}
+
+// apiCallInProgress returns whether the response indicates that an operation is still in progress
+func apiCallInProgress(response *http.Response) bool {
+	if response.StatusCode == http.StatusAccepted {
+		return true
+	}
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+		return false
+	}
+	if response.Body == nil || !strings.Contains(strings.ToLower(response.Header.Get("Content-Type")), "application/json") {
+		return false
+	}
+
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	response.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	var model struct {
+		Status     string `json:"status"`
+		Properties struct {
+			ProvisioningState string `json:"provisioningState"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(body, &model); err != nil {
+		return false
+	}
+
+	status := model.Status
+	if status == "" {
+		status = model.Properties.ProvisioningState
+	}
+	switch strings.ToLower(status) {
+	case "", "succeeded", "failed", "canceled", "cancelled":
+		return false
+	}
+	return true
+}
+
+// apiCallPollingKey returns the key used to match a polling request to the operation it's polling
+func apiCallPollingKey(uri *url.URL) string {
+	return strings.ToLower(fmt.Sprintf("%s%s", uri.Host, strings.TrimSuffix(uri.Path, "/")))
+}
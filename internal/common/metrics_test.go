@@ -0,0 +1,369 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+func TestApiCallResourceTypeAndOperation(t *testing.T) {
+	testData := []struct {
+		method       string
+		uri          string
+		resourceType string
+		operation    string
+	}{
+		{
+			method:       http.MethodGet,
+			uri:          "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012",
+			resourceType: "Microsoft.Resources/subscriptions",
+			operation:    "Get",
+		},
+		{
+			method:       http.MethodPut,
+			uri:          "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+			resourceType: "Microsoft.Resources/resourceGroups",
+			operation:    "CreateOrUpdate",
+		},
+		{
+			method:       http.MethodGet,
+			uri:          "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups",
+			resourceType: "Microsoft.Resources/resourceGroups",
+			operation:    "List",
+		},
+		{
+			method:       http.MethodDelete,
+			uri:          "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example/subnets/internal",
+			resourceType: "Microsoft.Network/virtualNetworks/subnets",
+			operation:    "Delete",
+		},
+		{
+			method:       http.MethodGet,
+			uri:          "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example/subnets",
+			resourceType: "Microsoft.Network/virtualNetworks/subnets",
+			operation:    "List",
+		},
+		{
+			method:       http.MethodPatch,
+			uri:          "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example",
+			resourceType: "Microsoft.Storage/storageAccounts",
+			operation:    "Update",
+		},
+		{
+			method:       http.MethodPost,
+			uri:          "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/listKeys",
+			resourceType: "Microsoft.Storage/storageAccounts",
+			operation:    "listKeys",
+		},
+		{
+			method:       http.MethodPost,
+			uri:          "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Web/checkNameAvailability",
+			resourceType: "Microsoft.Web",
+			operation:    "checkNameAvailability",
+		},
+		{
+			// extension resources are grouped by the extension resource type
+			method:       http.MethodPut,
+			uri:          "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/providers/Microsoft.Authorization/roleAssignments/example",
+			resourceType: "Microsoft.Authorization/roleAssignments",
+			operation:    "CreateOrUpdate",
+		},
+		{
+			method:       http.MethodGet,
+			uri:          "https://example.vault.azure.net/secrets/example",
+			resourceType: "vault.azure.net",
+			operation:    "GET",
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(fmt.Sprintf("%s %s", v.method, v.uri), func(t *testing.T) {
+			uri, err := url.Parse(v.uri)
+			if err != nil {
+				t.Fatalf("parsing %q: %+v", v.uri, err)
+			}
+
+			resourceType, operation := apiCallResourceTypeAndOperation(v.method, uri)
+			if resourceType != v.resourceType || operation != v.operation {
+				t.Fatalf("expected %q / %q but got %q / %q", v.resourceType, v.operation, resourceType, operation)
+			}
+		})
+	}
+}
+
+func TestApiCallMetrics(t *testing.T) {
+	apiCallMetrics = newApiCallMetricsCollector()
+	defer func() {
+		apiCallMetrics = newApiCallMetricsCollector()
+	}()
+
+	resourcePath := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example"
+
+	// the first request is throttled (which the SDK retries, so is counted as a retry), and the
+	// long-running operation completes on the second poll
+	requests := 0
+	polls := 0
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPut:
+			requests++
+			if requests == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.Header().Set("Azure-AsyncOperation", fmt.Sprintf("%s/providers/Microsoft.Network/locations/westeurope/operations/1?api-version=2024-05-01", server.URL))
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"properties":{"provisioningState":"Updating"}}`))
+
+		case r.URL.Path == "/providers/Microsoft.Network/locations/westeurope/operations/1":
+			polls++
+			status := "InProgress"
+			if polls > 1 {
+				status = "Succeeded"
+			}
+			w.Write([]byte(fmt.Sprintf(`{"status":%q}`, status)))
+
+		default:
+			w.Write([]byte(`{"properties":{"provisioningState":"Succeeded"}}`))
+		}
+	}))
+	defer server.Close()
+
+	c, err := resourcemanager.NewClient(environments.ResourceManagerAPI(server.URL), "network", "2024-05-01")
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	ClientOptions{CustomCorrelationRequestID: "11111111-2222-3333-4444-555555555555"}.Configure(c.Client, nil)
+	c.AuthorizeRequest = nil
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	send := func(method, path string, expectedStatusCodes ...int) {
+		req, err := c.NewRequest(ctx, client.RequestOptions{
+			ContentType:         "application/json; charset=utf-8",
+			ExpectedStatusCodes: expectedStatusCodes,
+			HttpMethod:          method,
+			Path:                path,
+		})
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+		if method == http.MethodPut {
+			if err := req.Marshal(map[string]string{"location": "westeurope"}); err != nil {
+				t.Fatalf("marshalling request: %+v", err)
+			}
+		}
+		if _, err := req.Execute(ctx); err != nil {
+			t.Fatalf("sending %s %s: %+v", method, path, err)
+		}
+	}
+
+	send(http.MethodPut, resourcePath, http.StatusOK, http.StatusCreated)
+	send(http.MethodGet, "/providers/Microsoft.Network/locations/westeurope/operations/1", http.StatusOK)
+	send(http.MethodGet, "/providers/Microsoft.Network/locations/westeurope/operations/1", http.StatusOK)
+	send(http.MethodGet, resourcePath, http.StatusOK)
+
+	// once the operation has completed, subsequent requests aren't counted as polls
+	send(http.MethodGet, "/providers/Microsoft.Network/locations/westeurope/operations/1", http.StatusOK)
+
+	path := filepath.Join(t.TempDir(), "metrics", "summary.json")
+	t.Setenv(MetricsPathEnvVar, path)
+	if err := WriteApiCallMetricsSummary(context.Background()); err != nil {
+		t.Fatalf("writing summary: %+v", err)
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading summary: %+v", err)
+	}
+	var summary []ApiCallMetrics
+	if err := json.Unmarshal(contents, &summary); err != nil {
+		t.Fatalf("parsing summary: %+v", err)
+	}
+
+	expected := map[string]ApiCallMetrics{
+		"Microsoft.Network/virtualNetworks CreateOrUpdate": {
+			Calls:                     1,
+			Retries:                   1,
+			LongRunningOperationPolls: 2,
+		},
+		"Microsoft.Network/virtualNetworks Get": {
+			Calls: 1,
+		},
+		"Microsoft.Network/locations/operations Get": {
+			Calls: 1,
+		},
+	}
+	if len(summary) != len(expected) {
+		t.Fatalf("expected %d entries but got %d: %s", len(expected), len(summary), contents)
+	}
+	for _, v := range summary {
+		key := fmt.Sprintf("%s %s", v.ResourceType, v.Operation)
+		e, ok := expected[key]
+		if !ok {
+			t.Fatalf("unexpected entry %q: %s", key, contents)
+		}
+		if v.CorrelationRequestId != "11111111-2222-3333-4444-555555555555" {
+			t.Fatalf("expected %q to be tagged with the correlation request id but got %q", key, v.CorrelationRequestId)
+		}
+		if v.Calls != e.Calls || v.Retries != e.Retries || v.Throttled != e.Throttled || v.LongRunningOperationPolls != e.LongRunningOperationPolls {
+			t.Fatalf("expected %q to have %d calls, %d retries, %d throttled and %d polls but got %+v", key, e.Calls, e.Retries, e.Throttled, e.LongRunningOperationPolls, v)
+		}
+		if v.Calls > 0 && (v.TotalLatency <= 0 || v.MaxLatency <= 0) {
+			t.Fatalf("expected the latency to be recorded for %q but got %+v", key, v)
+		}
+	}
+}
+
+func TestApiCallMetricsDelete(t *testing.T) {
+	resourceUri := "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example?api-version=2024-05-01"
+
+	testData := []struct {
+		name                string
+		deleteStatusCode    int
+		deleteInProgress    bool
+		expectedDeletePolls int
+		expectedGetCalls    int
+	}{
+		{
+			// a Delete which completes synchronously isn't polled, so the Create and Read of the replacement
+			// Resource (e.g. when a ForceNew field changes) are counted as such
+			name:             "synchronous delete",
+			deleteStatusCode: http.StatusOK,
+			expectedGetCalls: 1,
+		},
+		{
+			name:             "synchronous delete without content",
+			deleteStatusCode: http.StatusNoContent,
+			expectedGetCalls: 1,
+		},
+		{
+			// the Resource is polled until it no longer exists, once recreated subsequent requests aren't polls
+			name:                "asynchronous delete",
+			deleteStatusCode:    http.StatusAccepted,
+			deleteInProgress:    true,
+			expectedDeletePolls: 2,
+			expectedGetCalls:    1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			c := newApiCallMetricsCollector()
+			record := func(method string, statusCode int, body string) {
+				request := httptest.NewRequest(method, resourceUri, nil)
+				response := &http.Response{
+					StatusCode: statusCode,
+					Header:     http.Header{"Content-Type": []string{"application/json"}},
+					Body:       io.NopCloser(strings.NewReader(body)),
+				}
+				c.record(request, response, time.Second, 1)
+			}
+
+			record(http.MethodDelete, v.deleteStatusCode, "")
+			if v.deleteInProgress {
+				record(http.MethodGet, http.StatusOK, `{"properties":{"provisioningState":"Deleting"}}`)
+				record(http.MethodGet, http.StatusNotFound, "")
+			}
+			record(http.MethodPut, http.StatusOK, `{"properties":{"provisioningState":"Succeeded"}}`)
+			record(http.MethodGet, http.StatusOK, `{"properties":{"provisioningState":"Succeeded"}}`)
+
+			if len(c.pending) != 0 {
+				t.Fatalf("expected no pending operations but got %+v", c.pending)
+			}
+
+			actual := make(map[string]ApiCallMetrics)
+			for _, m := range c.summary() {
+				actual[m.Operation] = m
+			}
+			if m := actual["Delete"]; m.Calls != 1 || m.LongRunningOperationPolls != v.expectedDeletePolls {
+				t.Fatalf("expected the Delete to have 1 call and %d polls but got %+v", v.expectedDeletePolls, m)
+			}
+			if m := actual["CreateOrUpdate"]; m.Calls != 1 || m.LongRunningOperationPolls != 0 {
+				t.Fatalf("expected the CreateOrUpdate to have 1 call and no polls but got %+v", m)
+			}
+			if m := actual["Get"]; m.Calls != v.expectedGetCalls || m.LongRunningOperationPolls != 0 {
+				t.Fatalf("expected the Get to have %d calls and no polls but got %+v", v.expectedGetCalls, m)
+			}
+		})
+	}
+}
+
+func TestApiCallMetricsThrottled(t *testing.T) {
+	c := newApiCallMetricsCollector()
+	request := httptest.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example?api-version=2020-01-01", nil)
+	response := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+
+	// the SDK gave up after 3 attempts, all of which were throttled
+	c.record(request, response, time.Second, 3)
+
+	summary := c.summary()
+	if len(summary) != 1 {
+		t.Fatalf("expected 1 entry but got %+v", summary)
+	}
+	if m := summary[0]; m.Calls != 1 || m.Retries != 2 || m.Throttled != 1 {
+		t.Fatalf("expected 1 call, 2 retries and 1 throttled but got %+v", m)
+	}
+}
+
+func TestApiCallMetricsSender(t *testing.T) {
+	apiCallMetrics = newApiCallMetricsCollector()
+	defer func() {
+		apiCallMetrics = newApiCallMetricsCollector()
+	}()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"properties":{"provisioningState":"Succeeded"}}`))
+	}))
+	defer server.Close()
+
+	c := autorest.NewClientWithUserAgent("")
+	ClientOptions{}.ConfigureClient(&c, nil)
+
+	uri := fmt.Sprintf("%s/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Batch/batchAccounts/example", server.URL)
+	for i := 0; i < 2; i++ {
+		request, err := http.NewRequest(http.MethodGet, uri, nil)
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+		response, err := c.Send(request)
+		if err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+		response.Body.Close()
+	}
+
+	summary := ApiCallMetricsSummary()
+	if len(summary) != 1 {
+		t.Fatalf("expected 1 entry in the summary but got %d: %+v", len(summary), summary)
+	}
+	if summary[0].ResourceType != "Microsoft.Batch/batchAccounts" || summary[0].Operation != "Get" || summary[0].Calls != 2 {
+		t.Fatalf("unexpected summary: %+v", summary[0])
+	}
+}
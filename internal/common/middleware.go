@@ -4,10 +4,15 @@
 package common
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"net/http"
+	"net/http/httptrace"
 	"net/http/httputil"
+	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 )
 
@@ -59,3 +64,160 @@ func responseLoggerMiddleware(providerName string) client.ResponseMiddleware {
 		return response, nil
 	}
 }
+
+func recordingRequestMiddleware() client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		session := recordingSession()
+		if session == nil {
+			return request, nil
+		}
+
+		if session.Mode() == RecordingModeReplay {
+			return session.redirectRequest(request)
+		}
+
+		// the request body has to be captured prior to sending the request, so that it can be recorded
+		if err := session.captureRequestBody(request); err != nil {
+			return nil, err
+		}
+		return request, nil
+	}
+}
+
+func recordingResponseMiddleware() client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		session := recordingSession()
+		if session == nil {
+			return response, nil
+		}
+
+		if session.Mode() == RecordingModeReplay {
+			session.restoreRequest(request, response)
+			return response, nil
+		}
+
+		if err := session.record(request, response); err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+}
+
+// recordingSender returns an autorest.Sender which records or replays requests made using go-autorest
+func recordingSender(s autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		session := recordingSession()
+		if session == nil {
+			return s.Do(request)
+		}
+
+		if session.Mode() == RecordingModeReplay {
+			return session.replayResponse(request)
+		}
+
+		if err := session.captureRequestBody(request); err != nil {
+			return nil, err
+		}
+		response, err := s.Do(request)
+		if err != nil || response == nil {
+			return response, err
+		}
+		if err := session.record(request, response); err != nil {
+			return nil, err
+		}
+		return response, nil
+	})
+}
+
+// metricsRequestMiddleware tracks the API call being sent by go-azure-sdk - since the SDK retries requests internally,
+// each attempt (including any retries) is counted as a connection is requested for it
+func metricsRequestMiddleware() client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		call := &apiCall{
+			started: time.Now(),
+		}
+
+		ctx := context.WithValue(request.Context(), apiCallMetricsContextKey{}, call)
+		trace := &httptrace.ClientTrace{
+			GetConn: func(string) {
+				call.attempts.Add(1)
+			},
+		}
+		return request.WithContext(httptrace.WithClientTrace(ctx, trace)), nil
+	}
+}
+
+func metricsResponseMiddleware() client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		call, ok := request.Context().Value(apiCallMetricsContextKey{}).(*apiCall)
+		if !ok {
+			return response, nil
+		}
+
+		apiCallMetrics.record(request, response, time.Since(call.started), int(call.attempts.Load()))
+		return response, nil
+	}
+}
+
+// metricsSender returns an autorest.Sender which records the API calls made using go-autorest - since go-autorest
+// retries requests outside of the Sender, each attempt is recorded as a separate API call
+func metricsSender(s autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		started := time.Now()
+		response, err := s.Do(request)
+		if err == nil && response != nil {
+			apiCallMetrics.record(request, response, time.Since(started), 1)
+		}
+		return response, err
+	})
+}
+
+// rateLimitRequestMiddleware limits the rate of requests sent by go-azure-sdk - the middlewares are only run once for
+// each request whereas the SDK retries requests internally (e.g. when throttled), so whilst the first attempt waits
+// for the RateLimiter here, any retries wait for it as a connection is requested for them
+func rateLimitRequestMiddleware(limiter *RateLimiter) client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		ctx := request.Context()
+		if err := limiter.Wait(ctx, request); err != nil {
+			return nil, fmt.Errorf("waiting for the rate limit: %+v", err)
+		}
+
+		attempts := 0
+		trace := &httptrace.ClientTrace{
+			GetConn: func(string) {
+				attempts++
+				if attempts == 1 {
+					// the first attempt has already waited above
+					return
+				}
+
+				// the error can't be returned from here, but if the context has been cancelled whilst waiting
+				// then the attempt is failed by the transport
+				if err := limiter.Wait(ctx, request); err != nil {
+					log.Printf("[DEBUG] Waiting for the rate limit before retrying %s request to %s: %+v", request.Method, request.URL.Path, err)
+				}
+			},
+		}
+		return request.WithContext(httptrace.WithClientTrace(ctx, trace)), nil
+	}
+}
+
+func rateLimitResponseMiddleware(limiter *RateLimiter) client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		limiter.Update(request, response)
+		return response, nil
+	}
+}
+
+// rateLimitSender returns an autorest.Sender which limits the rate of requests made using go-autorest
+func rateLimitSender(limiter *RateLimiter, s autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		if err := limiter.Wait(request.Context(), request); err != nil {
+			return nil, err
+		}
+
+		response, err := s.Do(request)
+		limiter.Update(request, response)
+		return response, err
+	})
+}
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// rateLimitLowQuota is the remaining quota (as returned by Azure Resource Manager) below which
+	// requests are slowed down, to avoid exhausting the quota and receiving a 429
+	rateLimitLowQuota = 50
+
+	// rateLimitDelayPerRequest is how long requests are delayed for each request below rateLimitLowQuota,
+	// meaning that requests are delayed by up to 5s when the quota is exhausted
+	rateLimitDelayPerRequest = 100 * time.Millisecond
+)
+
+type rateLimitQuota string
+
+const (
+	rateLimitQuotaDeletes rateLimitQuota = "deletes"
+	rateLimitQuotaReads   rateLimitQuota = "reads"
+	rateLimitQuotaWrites  rateLimitQuota = "writes"
+)
+
+// RateLimiter limits the rate at which requests are sent to Azure Resource Manager for each Subscription,
+// and is shared between all of the API Clients configured for the Provider.
+//
+// Requests are limited using a token bucket when a budget is specified, and are also slowed down when
+// the remaining quota returned by Azure Resource Manager (in the `x-ms-ratelimit-remaining-subscription-*`
+// headers) is running low. When a request is throttled (returning a 429), all requests for that Subscription
+// wait until the time specified in the `Retry-After` header has passed.
+type RateLimiter struct {
+	// requestsPerSecond is the maximum number of requests per second for each Subscription, or 0 if unlimited
+	requestsPerSecond int
+
+	lock          sync.Mutex
+	subscriptions map[string]*subscriptionRateLimit
+
+	// now and sleep are overridden in tests
+	now   func() time.Time
+	sleep func(ctx context.Context, duration time.Duration) error
+}
+
+type subscriptionRateLimit struct {
+	// tokens is the number of requests which can be sent without waiting, which can be negative when
+	// callers are waiting for tokens to become available
+	tokens  float64
+	updated time.Time
+
+	// throttledUntil is the time until which requests shouldn't be sent, following a throttled request
+	throttledUntil time.Time
+
+	// remaining is the remaining quota for each type of request, as returned by Azure Resource Manager
+	remaining map[rateLimitQuota]int
+}
+
+// NewRateLimiter returns a RateLimiter which limits requests to `requestsPerSecond` for each Subscription,
+// where a value of 0 means that requests are only slowed down when the remaining quota is running low
+func NewRateLimiter(requestsPerSecond int) *RateLimiter {
+	return &RateLimiter{
+		requestsPerSecond: requestsPerSecond,
+		subscriptions:     map[string]*subscriptionRateLimit{},
+		now:               time.Now,
+		sleep:             sleepWithContext,
+	}
+}
+
+// Wait blocks until the request can be sent, or the context is cancelled
+func (r *RateLimiter) Wait(ctx context.Context, request *http.Request) error {
+	subscriptionId := rateLimitSubscriptionId(request)
+	if subscriptionId == "" {
+		return nil
+	}
+
+	delay := r.reserve(subscriptionId, rateLimitQuotaForMethod(request.Method))
+	if delay <= 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Delaying %s request to %s by %s to avoid the rate limit for Subscription %q", request.Method, request.URL.Path, delay, subscriptionId)
+	return r.sleep(ctx, delay)
+}
+
+// reserve reserves a request for the specified Subscription, returning how long the caller needs to wait
+// before sending the request
+func (r *RateLimiter) reserve(subscriptionId string, quota rateLimitQuota) time.Duration {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := r.now()
+	limit := r.subscription(subscriptionId, now)
+
+	var delay time.Duration
+	if limit.throttledUntil.After(now) {
+		delay = limit.throttledUntil.Sub(now)
+	}
+
+	if r.requestsPerSecond > 0 {
+		elapsed := now.Sub(limit.updated).Seconds()
+		limit.tokens += elapsed * float64(r.requestsPerSecond)
+		if limit.tokens > float64(r.requestsPerSecond) {
+			limit.tokens = float64(r.requestsPerSecond)
+		}
+		limit.updated = now
+
+		limit.tokens--
+		if limit.tokens < 0 {
+			if wait := time.Duration(-limit.tokens / float64(r.requestsPerSecond) * float64(time.Second)); wait > delay {
+				delay = wait
+			}
+		}
+	}
+
+	if remaining, ok := limit.remaining[quota]; ok {
+		if remaining < rateLimitLowQuota {
+			if wait := time.Duration(rateLimitLowQuota-remaining) * rateLimitDelayPerRequest; wait > delay {
+				delay = wait
+			}
+		}
+
+		// the quota is consumed by this request, which is accounted for until the next response is received,
+		// so that concurrent callers are slowed down before the quota is exhausted
+		if remaining > 0 {
+			limit.remaining[quota] = remaining - 1
+		}
+	}
+
+	return delay
+}
+
+// Update updates the rate limit for the Subscription using the headers returned in the response
+func (r *RateLimiter) Update(request *http.Request, response *http.Response) {
+	if response == nil {
+		return
+	}
+
+	subscriptionId := rateLimitSubscriptionId(request)
+	if subscriptionId == "" {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := r.now()
+	limit := r.subscription(subscriptionId, now)
+
+	for _, quota := range []rateLimitQuota{rateLimitQuotaDeletes, rateLimitQuotaReads, rateLimitQuotaWrites} {
+		if v := response.Header.Get("x-ms-ratelimit-remaining-subscription-" + string(quota)); v != "" {
+			if remaining, err := strconv.Atoi(v); err == nil {
+				limit.remaining[quota] = remaining
+			}
+		}
+	}
+
+	if response.StatusCode == http.StatusTooManyRequests {
+		retryAfter, ok := parseRetryAfter(response.Header.Get("Retry-After"), now)
+		if !ok {
+			// when Retry-After isn't specified, back off for as long as requests are delayed when the quota is exhausted
+			retryAfter = rateLimitLowQuota * rateLimitDelayPerRequest
+		}
+		if until := now.Add(retryAfter); until.After(limit.throttledUntil) {
+			log.Printf("[DEBUG] Requests for Subscription %q were throttled, delaying further requests by %s", subscriptionId, retryAfter)
+			limit.throttledUntil = until
+		}
+	}
+}
+
+// subscription returns the rate limit for the specified Subscription, which must be called with the lock held
+func (r *RateLimiter) subscription(subscriptionId string, now time.Time) *subscriptionRateLimit {
+	key := strings.ToLower(subscriptionId)
+	limit, ok := r.subscriptions[key]
+	if !ok {
+		limit = &subscriptionRateLimit{
+			tokens:    float64(r.requestsPerSecond),
+			updated:   now,
+			remaining: map[rateLimitQuota]int{},
+		}
+		r.subscriptions[key] = limit
+	}
+	return limit
+}
+
+// rateLimitSubscriptionId returns the Subscription ID which the request is being sent to, if any
+func rateLimitSubscriptionId(request *http.Request) string {
+	if request == nil || request.URL == nil {
+		return ""
+	}
+
+	segments := strings.Split(strings.TrimPrefix(request.URL.Path, "/"), "/")
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") {
+		return ""
+	}
+
+	return segments[1]
+}
+
+// rateLimitQuotaForMethod returns the quota which Azure Resource Manager counts requests using this HTTP Method against
+func rateLimitQuotaForMethod(method string) rateLimitQuota {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead:
+		return rateLimitQuotaReads
+	case http.MethodDelete:
+		return rateLimitQuotaDeletes
+	}
+	return rateLimitQuotaWrites
+}
+
+// parseRetryAfter parses the value of a `Retry-After` header, which is either a number of seconds or an HTTP Date
+func parseRetryAfter(input string, now time.Time) (time.Duration, bool) {
+	if input == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(input); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(input); err == nil {
+		if date.Before(now) {
+			return 0, true
+		}
+		return date.Sub(now), true
+	}
+
+	return 0, false
+}
+
+func sleepWithContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+const (
+	rateLimitTestSubscriptionId      = "11111111-2222-3333-4444-555555555555"
+	rateLimitTestOtherSubscriptionId = "66666666-7777-8888-9999-000000000000"
+)
+
+// fakeTransport is an http.RoundTripper which returns the headers and status code from the responder
+type fakeTransport struct {
+	responder func(request *http.Request) (int, http.Header)
+	requests  int
+}
+
+func (f *fakeTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	// as with http.Transport, a connection is requested for each attempt
+	if trace := httptrace.ContextClientTrace(request.Context()); trace != nil && trace.GetConn != nil {
+		trace.GetConn(request.URL.Host)
+	}
+
+	f.requests++
+	statusCode, headers := f.responder(request)
+	if headers == nil {
+		headers = http.Header{}
+	}
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     headers,
+		Body:       io.NopCloser(strings.NewReader("{}")),
+		Request:    request,
+	}, nil
+}
+
+// fakeRateLimiter returns a RateLimiter using a fake clock, where sleeping advances the clock
+func fakeRateLimiter(requestsPerSecond int) (*RateLimiter, *[]time.Duration) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	delays := make([]time.Duration, 0)
+
+	limiter := NewRateLimiter(requestsPerSecond)
+	limiter.now = func() time.Time {
+		return now
+	}
+	limiter.sleep = func(_ context.Context, duration time.Duration) error {
+		delays = append(delays, duration)
+		now = now.Add(duration)
+		return nil
+	}
+	return limiter, &delays
+}
+
+// sendRateLimitTestRequest sends a request through the rate limit middlewares, as used by go-azure-sdk clients
+func sendRateLimitTestRequest(t *testing.T, limiter *RateLimiter, transport http.RoundTripper, method, subscriptionId string) *http.Response {
+	request, err := http.NewRequest(method, "https://management.azure.com/subscriptions/"+subscriptionId+"/resourceGroups/example?api-version=2020-01-01", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	if request, err = rateLimitRequestMiddleware(limiter)(request); err != nil {
+		t.Fatalf("running request middleware: %+v", err)
+	}
+
+	response, err := (&http.Client{Transport: transport}).Do(request)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+
+	if response, err = rateLimitResponseMiddleware(limiter)(request, response); err != nil {
+		t.Fatalf("running response middleware: %+v", err)
+	}
+	return response
+}
+
+func expectDelays(t *testing.T, actual *[]time.Duration, expected ...time.Duration) {
+	t.Helper()
+
+	if len(*actual) != len(expected) {
+		t.Fatalf("expected the delays %v but got %v", expected, *actual)
+	}
+	for i := range expected {
+		if (*actual)[i] != expected[i] {
+			t.Fatalf("expected the delays %v but got %v", expected, *actual)
+		}
+	}
+}
+
+func TestRateLimiter_Budget(t *testing.T) {
+	limiter, delays := fakeRateLimiter(2)
+	transport := &fakeTransport{
+		responder: func(_ *http.Request) (int, http.Header) {
+			return http.StatusOK, nil
+		},
+	}
+
+	// the budget allows a burst of 2 requests, after which requests are spread out
+	for i := 0; i < 4; i++ {
+		sendRateLimitTestRequest(t, limiter, transport, http.MethodGet, rateLimitTestSubscriptionId)
+	}
+	expectDelays(t, delays, 500*time.Millisecond, 500*time.Millisecond)
+
+	// each Subscription has its own budget
+	sendRateLimitTestRequest(t, limiter, transport, http.MethodGet, rateLimitTestOtherSubscriptionId)
+	expectDelays(t, delays, 500*time.Millisecond, 500*time.Millisecond)
+}
+
+func TestRateLimiter_RemainingQuota(t *testing.T) {
+	limiter, delays := fakeRateLimiter(0)
+	remainingReads := "100"
+	transport := &fakeTransport{
+		responder: func(_ *http.Request) (int, http.Header) {
+			headers := http.Header{}
+			headers.Set("x-ms-ratelimit-remaining-subscription-reads", remainingReads)
+			headers.Set("x-ms-ratelimit-remaining-subscription-writes", "1000")
+			return http.StatusOK, headers
+		},
+	}
+
+	// requests aren't delayed whilst there's plenty of quota remaining
+	sendRateLimitTestRequest(t, limiter, transport, http.MethodGet, rateLimitTestSubscriptionId)
+	sendRateLimitTestRequest(t, limiter, transport, http.MethodGet, rateLimitTestSubscriptionId)
+	expectDelays(t, delays)
+
+	// once the quota is running low requests are delayed, increasingly so as the quota is consumed
+	remainingReads = "45"
+	sendRateLimitTestRequest(t, limiter, transport, http.MethodGet, rateLimitTestSubscriptionId)
+	expectDelays(t, delays)
+	if delay := limiter.reserve(rateLimitTestSubscriptionId, rateLimitQuotaReads); delay != 500*time.Millisecond {
+		t.Fatalf("expected a delay of 500ms but got %s", delay)
+	}
+	if delay := limiter.reserve(rateLimitTestSubscriptionId, rateLimitQuotaReads); delay != 600*time.Millisecond {
+		t.Fatalf("expected a delay of 600ms for a concurrent request but got %s", delay)
+	}
+
+	// the quota for writes is tracked separately, with the quota for reads updated from the response
+	sendRateLimitTestRequest(t, limiter, transport, http.MethodPut, rateLimitTestSubscriptionId)
+	expectDelays(t, delays)
+
+	remainingReads = "0"
+	sendRateLimitTestRequest(t, limiter, transport, http.MethodGet, rateLimitTestSubscriptionId)
+	sendRateLimitTestRequest(t, limiter, transport, http.MethodGet, rateLimitTestSubscriptionId)
+	expectDelays(t, delays, 500*time.Millisecond, 5*time.Second)
+}
+
+func TestRateLimiter_RetryAfter(t *testing.T) {
+	limiter, delays := fakeRateLimiter(0)
+	transport := &fakeTransport{
+		responder: func(request *http.Request) (int, http.Header) {
+			if request.Method == http.MethodPut {
+				headers := http.Header{}
+				headers.Set("Retry-After", "7")
+				return http.StatusTooManyRequests, headers
+			}
+			return http.StatusOK, nil
+		},
+	}
+
+	if response := sendRateLimitTestRequest(t, limiter, transport, http.MethodPut, rateLimitTestSubscriptionId); response.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected a 429 but got %d", response.StatusCode)
+	}
+
+	// requests for other Subscriptions aren't delayed
+	sendRateLimitTestRequest(t, limiter, transport, http.MethodGet, rateLimitTestOtherSubscriptionId)
+	expectDelays(t, delays)
+
+	// but all requests for the throttled Subscription are, including those sent by go-autorest clients
+	client := autorest.Client{
+		Sender: rateLimitSender(limiter, &http.Client{Transport: transport}),
+	}
+	request, err := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/"+rateLimitTestSubscriptionId+"/providers/Microsoft.Storage/storageAccounts?api-version=2020-01-01", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	if _, err := client.Do(request); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	expectDelays(t, delays, 7*time.Second)
+
+	// once the Retry-After has passed requests are no longer delayed
+	sendRateLimitTestRequest(t, limiter, transport, http.MethodGet, rateLimitTestSubscriptionId)
+	expectDelays(t, delays, 7*time.Second)
+
+	if transport.requests != 4 {
+		t.Fatalf("expected 4 requests to be sent but got %d", transport.requests)
+	}
+}
+
+func TestRateLimiter_NotSubscriptionScoped(t *testing.T) {
+	limiter, delays := fakeRateLimiter(1)
+	transport := &fakeTransport{
+		responder: func(_ *http.Request) (int, http.Header) {
+			headers := http.Header{}
+			headers.Set("Retry-After", "10")
+			return http.StatusTooManyRequests, headers
+		},
+	}
+
+	for i := 0; i < 3; i++ {
+		request, err := http.NewRequest(http.MethodGet, "https://example.vault.azure.net/secrets/example", nil)
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+		if err := limiter.Wait(context.Background(), request); err != nil {
+			t.Fatalf("waiting: %+v", err)
+		}
+		response, err := transport.RoundTrip(request)
+		if err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+		limiter.Update(request, response)
+	}
+
+	expectDelays(t, delays)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	testData := []struct {
+		input    string
+		expected time.Duration
+		ok       bool
+	}{
+		{input: "", ok: false},
+		{input: "30", expected: 30 * time.Second, ok: true},
+		{input: "-1", ok: false},
+		{input: "Mon, 01 Jan 2024 00:01:00 GMT", expected: time.Minute, ok: true},
+		{input: "Sun, 31 Dec 2023 23:59:00 GMT", expected: 0, ok: true},
+		{input: "soon", ok: false},
+	}
+
+	for _, v := range testData {
+		actual, ok := parseRetryAfter(v.input, now)
+		if ok != v.ok || actual != v.expected {
+			t.Fatalf("expected %q to parse as %s (%t) but got %s (%t)", v.input, v.expected, v.ok, actual, ok)
+		}
+	}
+}
+
+func TestRateLimiter_Client(t *testing.T) {
+	limiter, delays := fakeRateLimiter(1)
+
+	// the first attempt is throttled, which the SDK retries internally
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("x-ms-ratelimit-remaining-subscription-reads", "0")
+		w.Write([]byte(`{"properties":{"provisioningState":"Succeeded"}}`))
+	}))
+	defer server.Close()
+
+	c, err := resourcemanager.NewClient(environments.ResourceManagerAPI(server.URL), "resources", "2020-01-01")
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	ClientOptions{RateLimiter: limiter}.Configure(c.Client, nil)
+	c.AuthorizeRequest = nil
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	send := func() {
+		req, err := c.NewRequest(ctx, client.RequestOptions{
+			ContentType:         "application/json; charset=utf-8",
+			ExpectedStatusCodes: []int{http.StatusOK},
+			HttpMethod:          http.MethodGet,
+			Path:                "/subscriptions/" + rateLimitTestSubscriptionId + "/resourceGroups/example",
+		})
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+		if _, err := req.Execute(ctx); err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+	}
+
+	send()
+	if requests != 2 {
+		t.Fatalf("expected the throttled request to be retried but got %d requests", requests)
+	}
+
+	// the retry counts against the budget, since each attempt made by the SDK is rate limited
+	expectDelays(t, delays, time.Second)
+
+	// the remaining quota is updated from the response, slowing down subsequent requests
+	send()
+	expectDelays(t, delays, time.Second, 5*time.Second)
+}
+
+func TestRateLimiter_CancelledWhilstThrottled(t *testing.T) {
+	// use the real clock, so that the Retry-After would block the test if the wait wasn't cancelled
+	limiter := NewRateLimiter(0)
+	transport := &fakeTransport{
+		responder: func(_ *http.Request) (int, http.Header) {
+			headers := http.Header{}
+			headers.Set("Retry-After", "3600")
+			return http.StatusTooManyRequests, headers
+		},
+	}
+	sendRateLimitTestRequest(t, limiter, transport, http.MethodGet, rateLimitTestSubscriptionId)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://management.azure.com/subscriptions/"+rateLimitTestSubscriptionId+"/resourceGroups/example?api-version=2020-01-01", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	started := time.Now()
+	if _, err := rateLimitRequestMiddleware(limiter)(request); err == nil {
+		t.Fatalf("expected an error when the context is cancelled whilst waiting but got none")
+	}
+	if elapsed := time.Since(started); elapsed > 10*time.Second {
+		t.Fatalf("expected the wait to be aborted when the context was cancelled but it took %s", elapsed)
+	}
+
+	if transport.requests != 1 {
+		t.Fatalf("expected the throttled request not to be sent but got %d requests", transport.requests)
+	}
+}
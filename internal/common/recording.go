@@ -0,0 +1,471 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	// RecordingModeEnvVar is the Environment Variable used to record or replay the HTTP requests made by the Provider
+	RecordingModeEnvVar = "ARM_TEST_RECORDING_MODE"
+
+	// RecordingModeRecord records the (sanitized) requests and responses into a Cassette
+	RecordingModeRecord = "record"
+
+	// RecordingModeReplay serves the responses from a previously recorded Cassette, without making any network requests
+	RecordingModeReplay = "replay"
+
+	// RecordingPlaceholderId is the value that Subscription and Tenant IDs are replaced with in a Cassette
+	RecordingPlaceholderId = "00000000-0000-0000-0000-000000000000"
+
+	// RecordingPlaceholderSecret is the value that secrets are replaced with in a Cassette, this is
+	// `REDACTED` base64 encoded so that it remains usable as a (Shared) Key during replay
+	RecordingPlaceholderSecret = "UkVEQUNURUQ="
+
+	// recordingOriginalUrlHeader contains the original URL for a request which is being replayed
+	recordingOriginalUrlHeader = "X-Ms-Recording-Original-Url"
+)
+
+// RecordingMode returns the Recording Mode which has been configured using the `ARM_TEST_RECORDING_MODE`
+// Environment Variable - or an empty string when requests should be sent to Azure as normal
+func RecordingMode() string {
+	value := strings.ToLower(os.Getenv(RecordingModeEnvVar))
+	switch value {
+	case "":
+		return ""
+	case RecordingModeRecord, RecordingModeReplay:
+		return value
+	}
+
+	log.Printf("[WARN] Ignoring unsupported value %q for %q - supported values are %q and %q", value, RecordingModeEnvVar, RecordingModeRecord, RecordingModeReplay)
+	return ""
+}
+
+// Cassette contains the sanitized HTTP interactions which were recorded for a single test
+type Cassette struct {
+	// Name is the name of the test which this Cassette was recorded for
+	Name string `json:"name"`
+
+	// Variables contains the (randomly generated) values used by the test, which are reused during replay
+	Variables map[string]string `json:"variables,omitempty"`
+
+	// Interactions contains the requests and responses, in the order they were made
+	Interactions []RecordedInteraction `json:"interactions"`
+}
+
+type RecordedInteraction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type RecordedResponse struct {
+	StatusCode int               `json:"statusCode"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body,omitempty"`
+}
+
+// RecordingSession records or replays the HTTP interactions for a single test
+type RecordingSession struct {
+	mode      string
+	path      string
+	cassette  *Cassette
+	sanitizer recordingSanitizer
+
+	lock sync.Mutex
+
+	// offsets contains the index of the next interaction to replay for each request
+	offsets map[string]int
+
+	// server is the local server used to replay the interactions for clients from hashicorp/go-azure-sdk
+	server   *http.Server
+	listener net.Listener
+}
+
+var (
+	currentRecordingSession *RecordingSession
+	recordingSessionLock    = &sync.RWMutex{}
+)
+
+// NewRecordingSession returns a RecordingSession for the Cassette stored at `path`.
+//
+// In Record mode the values within `replacements` (such as Subscription and Tenant IDs) are replaced by
+// their corresponding value in the Cassette - in Replay mode the Cassette is loaded from disk.
+func NewRecordingSession(mode, path, name string, replacements map[string]string) (*RecordingSession, error) {
+	session := &RecordingSession{
+		mode: mode,
+		path: path,
+		cassette: &Cassette{
+			Name:         name,
+			Variables:    map[string]string{},
+			Interactions: []RecordedInteraction{},
+		},
+		sanitizer: recordingSanitizer{
+			replacements: replacements,
+		},
+		offsets: map[string]int{},
+	}
+
+	switch mode {
+	case RecordingModeRecord:
+		return session, nil
+
+	case RecordingModeReplay:
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil, fmt.Errorf("no recording was found at %q - run this test with `%s=%s` to create one", path, RecordingModeEnvVar, RecordingModeRecord)
+			}
+			return nil, fmt.Errorf("reading recording %q: %+v", path, err)
+		}
+
+		if err := json.Unmarshal(contents, session.cassette); err != nil {
+			return nil, fmt.Errorf("parsing recording %q: %+v", path, err)
+		}
+		if session.cassette.Variables == nil {
+			session.cassette.Variables = map[string]string{}
+		}
+		return session, nil
+	}
+
+	return nil, fmt.Errorf("unsupported recording mode %q", mode)
+}
+
+// SetRecordingSession sets the RecordingSession used by all clients configured via ClientOptions,
+// since the Provider is shared between tests this requires that tests are run sequentially
+func SetRecordingSession(session *RecordingSession) {
+	recordingSessionLock.Lock()
+	defer recordingSessionLock.Unlock()
+
+	currentRecordingSession = session
+}
+
+func recordingSession() *RecordingSession {
+	recordingSessionLock.RLock()
+	defer recordingSessionLock.RUnlock()
+
+	return currentRecordingSession
+}
+
+// Mode returns the Recording Mode for this session
+func (s *RecordingSession) Mode() string {
+	return s.mode
+}
+
+// Variable returns the value for the variable `name` - in Record mode `value` is stored in the Cassette and
+// returned, in Replay mode the recorded value is returned (falling back to `value` if it wasn't recorded)
+func (s *RecordingSession) Variable(name, value string) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.mode == RecordingModeReplay {
+		if v, ok := s.cassette.Variables[name]; ok {
+			return v
+		}
+		return value
+	}
+
+	s.cassette.Variables[name] = value
+	return value
+}
+
+// Stop stops this RecordingSession - writing the Cassette to disk in Record mode
+func (s *RecordingSession) Stop() error {
+	recordingSessionLock.Lock()
+	if currentRecordingSession == s {
+		currentRecordingSession = nil
+	}
+	recordingSessionLock.Unlock()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.server != nil {
+		if err := s.server.Close(); err != nil {
+			log.Printf("[DEBUG] stopping replay server: %+v", err)
+		}
+		s.server = nil
+	}
+
+	if s.mode != RecordingModeRecord {
+		return nil
+	}
+
+	contents, err := json.MarshalIndent(s.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing recording %q: %+v", s.path, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return fmt.Errorf("creating directory for recording %q: %+v", s.path, err)
+	}
+
+	if err := os.WriteFile(s.path, append(contents, '\n'), 0o644); err != nil {
+		return fmt.Errorf("writing recording %q: %+v", s.path, err)
+	}
+
+	return nil
+}
+
+// record appends the sanitized request and response to the Cassette
+func (s *RecordingSession) record(request *http.Request, response *http.Response) error {
+	s.lock.Lock()
+	sanitizer := s.sanitizer
+	s.lock.Unlock()
+
+	var requestBody []byte
+	if request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return fmt.Errorf("recording request body for %s %s: %+v", request.Method, request.URL, err)
+		}
+		if requestBody, err = io.ReadAll(body); err != nil {
+			return fmt.Errorf("recording request body for %s %s: %+v", request.Method, request.URL, err)
+		}
+	}
+
+	var responseBody []byte
+	if response.Body != nil {
+		var err error
+		responseBody, err = io.ReadAll(response.Body)
+		if err != nil {
+			return fmt.Errorf("recording response body for %s %s: %+v", request.Method, request.URL, err)
+		}
+		response.Body.Close()
+		response.Body = io.NopCloser(bytes.NewReader(responseBody))
+	}
+
+	interaction := RecordedInteraction{
+		Request: RecordedRequest{
+			Method: request.Method,
+			URL:    sanitizer.sanitizeURL(request.URL),
+			Body:   sanitizer.sanitizeBody(request.URL, requestBody),
+		},
+		Response: RecordedResponse{
+			StatusCode: response.StatusCode,
+			Headers:    sanitizer.sanitizeHeaders(response.Header),
+			Body:       sanitizer.sanitizeBody(request.URL, responseBody),
+		},
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.cassette.Interactions = append(s.cassette.Interactions, interaction)
+	return nil
+}
+
+// captureRequestBody ensures the body for this request can be read again once it's been sent
+func (s *RecordingSession) captureRequestBody(request *http.Request) error {
+	if request.Body == nil || request.GetBody != nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		return fmt.Errorf("reading request body for %s %s: %+v", request.Method, request.URL, err)
+	}
+	request.Body.Close()
+
+	request.Body = io.NopCloser(bytes.NewReader(body))
+	request.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return nil
+}
+
+// replay returns the next recorded response for this request.
+//
+// Interactions are replayed in the order they were recorded for each Method and URL, once these have been
+// exhausted the last response is returned again - which allows for long-running operations to be polled
+func (s *RecordingSession) replay(method string, uri *url.URL) (*RecordedResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := fmt.Sprintf("%s %s", method, s.sanitizer.sanitizeURL(uri))
+
+	matches := make([]int, 0)
+	for i, v := range s.cassette.Interactions {
+		if fmt.Sprintf("%s %s", v.Request.Method, v.Request.URL) == key {
+			matches = append(matches, i)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no interaction was recorded in %q for %s", s.path, key)
+	}
+
+	offset := s.offsets[key]
+	if offset >= len(matches) {
+		offset = len(matches) - 1
+	}
+	s.offsets[key] = offset + 1
+
+	response := s.cassette.Interactions[matches[offset]].Response
+	return &response, nil
+}
+
+// replayResponse returns the next recorded response for this request as an *http.Response
+func (s *RecordingSession) replayResponse(request *http.Request) (*http.Response, error) {
+	recorded, err := s.replay(request.Method, request.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        recorded.header(),
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       request,
+	}
+	return response, nil
+}
+
+// redirectRequest sends this request to the local replay server, retaining the original URL in a header
+func (s *RecordingSession) redirectRequest(request *http.Request) (*http.Request, error) {
+	address, err := s.replayServerAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Header.Get(recordingOriginalUrlHeader) == "" {
+		request.Header.Set(recordingOriginalUrlHeader, request.URL.String())
+	}
+
+	uri := *request.URL
+	uri.Scheme = "http"
+	uri.Host = address
+	request.URL = &uri
+	request.Host = ""
+	return request, nil
+}
+
+// restoreRequest restores the original URL for a request which was sent to the local replay server,
+// since this is used to determine the URL to poll for some long-running operations
+func (s *RecordingSession) restoreRequest(request *http.Request, response *http.Response) {
+	original := request.Header.Get(recordingOriginalUrlHeader)
+	if original == "" {
+		return
+	}
+
+	uri, err := url.Parse(original)
+	if err != nil {
+		return
+	}
+
+	request.Header.Del(recordingOriginalUrlHeader)
+	request.URL = uri
+	if response != nil && response.Request != nil {
+		response.Request.Header.Del(recordingOriginalUrlHeader)
+		response.Request.URL = uri
+	}
+}
+
+func (s *RecordingSession) replayServerAddress() (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.server != nil {
+		return s.listener.Addr().String(), nil
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", fmt.Errorf("starting replay server: %+v", err)
+	}
+
+	s.listener = listener
+	s.server = &http.Server{
+		Handler: http.HandlerFunc(s.serveReplay),
+	}
+	go func() {
+		if err := s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("[DEBUG] replay server stopped: %+v", err)
+		}
+	}()
+
+	return listener.Addr().String(), nil
+}
+
+func (s *RecordingSession) serveReplay(w http.ResponseWriter, r *http.Request) {
+	uri, err := url.Parse(r.Header.Get(recordingOriginalUrlHeader))
+	if err != nil || !uri.IsAbs() {
+		http.Error(w, fmt.Sprintf("the %q header must contain the original URL", recordingOriginalUrlHeader), http.StatusBadRequest)
+		return
+	}
+
+	recorded, err := s.replay(r.Method, uri)
+	if err != nil {
+		// 501 isn't retried by the SDK, so this surfaces immediately as a test failure
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotImplemented)
+		body, _ := json.Marshal(map[string]interface{}{
+			"error": map[string]string{
+				"code":    "RecordingNotFound",
+				"message": err.Error(),
+			},
+		})
+		w.Write(body)
+		return
+	}
+
+	for k, v := range recorded.header() {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(recorded.StatusCode)
+	w.Write([]byte(recorded.Body))
+}
+
+// header returns the recorded headers for this response, with the `Retry-After` header set to 0 so that
+// long-running operations are polled without waiting
+func (r RecordedResponse) header() http.Header {
+	header := http.Header{}
+	for k, v := range r.Headers {
+		header.Set(k, v)
+	}
+	header.Set("Retry-After", "0")
+	return header
+}
+
+// AddRecordingReplacement replaces `value` with `replacement` in any interactions subsequently recorded
+// by the current RecordingSession, for values which are only known once the Provider has authenticated
+// (such as the Object ID of the authenticated principal)
+func AddRecordingReplacement(value, replacement string) {
+	session := recordingSession()
+	if session == nil || session.mode != RecordingModeRecord || value == "" {
+		return
+	}
+
+	session.lock.Lock()
+	defer session.lock.Unlock()
+
+	replacements := make(map[string]string, len(session.sanitizer.replacements)+1)
+	for k, v := range session.sanitizer.replacements {
+		replacements[k] = v
+	}
+	replacements[value] = replacement
+	session.sanitizer.replacements = replacements
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+)
+
+// recordingHeaders are the response headers which are retained in a Cassette, all other headers
+// (including any authorization or correlation headers) are discarded
+var recordingHeaders = []string{
+	"Azure-AsyncOperation",
+	"Content-Type",
+	"Location",
+	"Operation-Location",
+	"Retry-After",
+}
+
+// recordingSecretQueryParameters are the query string parameters which contain a secret, such as a SAS Token signature
+var recordingSecretQueryParameters = []string{
+	"sig",
+	"code",
+}
+
+type recordingSanitizer struct {
+	// replacements is a map of value to replacement, used to replace Subscription and Tenant IDs
+	replacements map[string]string
+}
+
+func (s recordingSanitizer) sanitizeString(input string) string {
+	// replace the longest values first, so that overlapping values are replaced consistently
+	keys := make([]string, 0, len(s.replacements))
+	for k := range s.replacements {
+		if k != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return len(keys[i]) > len(keys[j])
+	})
+
+	for _, k := range keys {
+		replacement := s.replacements[k]
+		input = strings.ReplaceAll(input, k, replacement)
+		input = strings.ReplaceAll(input, strings.ToLower(k), replacement)
+		input = strings.ReplaceAll(input, strings.ToUpper(k), replacement)
+	}
+	return input
+}
+
+func (s recordingSanitizer) sanitizeURL(input *url.URL) string {
+	if input == nil {
+		return ""
+	}
+
+	uri := *input
+	query := uri.Query()
+	modified := false
+	for _, k := range recordingSecretQueryParameters {
+		if query.Has(k) {
+			query.Set(k, RecordingPlaceholderSecret)
+			modified = true
+		}
+	}
+	if modified {
+		uri.RawQuery = query.Encode()
+	}
+
+	return s.sanitizeString(uri.String())
+}
+
+func (s recordingSanitizer) sanitizeHeaders(input http.Header) map[string]string {
+	output := make(map[string]string)
+	for _, k := range recordingHeaders {
+		if v := input.Get(k); v != "" {
+			output[k] = s.sanitizeString(v)
+		}
+	}
+	return output
+}
+
+// sanitizeBody replaces any secrets within the body for the request/response made to `uri`
+func (s recordingSanitizer) sanitizeBody(uri *url.URL, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	// retain the precision of any numbers
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		// not JSON, so there's no structure to redact
+		return s.sanitizeString(string(body))
+	}
+
+	listsSecrets := uri != nil && isListSecretsOperation(uri.Path)
+	value = redactSecrets(value, listsSecrets)
+
+	output, err := json.Marshal(value)
+	if err != nil {
+		return s.sanitizeString(string(body))
+	}
+	return s.sanitizeString(string(output))
+}
+
+// isListSecretsOperation returns whether this is an operation which returns secrets, such as `listKeys`
+func isListSecretsOperation(uriPath string) bool {
+	operation := strings.ToLower(path.Base(uriPath))
+	if !strings.HasPrefix(operation, "list") && !strings.HasPrefix(operation, "regenerate") {
+		return false
+	}
+
+	for _, v := range []string{"key", "secret", "connectionstring", "credential", "sas", "password"} {
+		if strings.Contains(operation, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSecretField returns whether the JSON field `name` contains a secret
+func isSecretField(name string, listsSecrets bool) bool {
+	name = strings.ToLower(name)
+
+	// operations such as `listKeys` and `listCredentials` return the secret in the `value` field
+	if listsSecrets && name == "value" {
+		return true
+	}
+
+	// fields such as `keyVaultSecretId` and `passwordEnabled` reference (rather than contain) a secret
+	for _, v := range []string{"id", "uri", "url", "name", "type", "version", "path", "enabled"} {
+		if strings.HasSuffix(name, v) {
+			return false
+		}
+	}
+
+	for _, v := range []string{"password", "secret", "connectionstring", "token"} {
+		if strings.Contains(name, v) {
+			return true
+		}
+	}
+
+	// e.g. `primaryKey`, `accessKey` and `primaryMasterKey`
+	return strings.HasSuffix(name, "key") && name != "key"
+}
+
+func redactSecrets(input interface{}, listsSecrets bool) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if s, ok := value.(string); ok && s != "" && isSecretField(key, listsSecrets) {
+				v[key] = RecordingPlaceholderSecret
+				continue
+			}
+			v[key] = redactSecrets(value, listsSecrets)
+		}
+		return v
+
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactSecrets(value, listsSecrets)
+		}
+		return v
+	}
+
+	return input
+}
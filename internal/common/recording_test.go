@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const recordingTestSubscriptionId = "11111111-2222-3333-4444-555555555555"
+
+func TestRecordingSanitizer(t *testing.T) {
+	sanitizer := recordingSanitizer{
+		replacements: map[string]string{
+			recordingTestSubscriptionId: RecordingPlaceholderId,
+		},
+	}
+
+	testData := []struct {
+		name     string
+		uri      string
+		body     string
+		expected string
+	}{
+		{
+			name:     "subscription id",
+			uri:      "https://management.azure.com/subscriptions/11111111-2222-3333-4444-555555555555/resourceGroups/example",
+			body:     `{"id":"/SUBSCRIPTIONS/11111111-2222-3333-4444-555555555555/resourceGroups/example","properties":{"count":12345678901234567}}`,
+			expected: `{"id":"/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/resourceGroups/example","properties":{"count":12345678901234567}}`,
+		},
+		{
+			name:     "secret fields",
+			uri:      "https://management.azure.com/example",
+			body:     `{"properties":{"adminPassword":"P@ssw0rd","adminPasswordEnabled":true,"keyVaultSecretId":"https://example.vault.azure.net/secrets/example","primaryKey":"abc","tags":{"key":"value"}}}`,
+			expected: `{"properties":{"adminPassword":"UkVEQUNURUQ=","adminPasswordEnabled":true,"keyVaultSecretId":"https://example.vault.azure.net/secrets/example","primaryKey":"UkVEQUNURUQ=","tags":{"key":"value"}}}`,
+		},
+		{
+			name:     "list keys",
+			uri:      "https://management.azure.com/example/listKeys",
+			body:     `{"keys":[{"keyName":"key1","permissions":"FULL","value":"abc"}]}`,
+			expected: `{"keys":[{"keyName":"key1","permissions":"FULL","value":"UkVEQUNURUQ="}]}`,
+		},
+		{
+			name:     "not json",
+			uri:      "https://example.blob.core.windows.net/container",
+			body:     "subscription 11111111-2222-3333-4444-555555555555",
+			expected: "subscription 00000000-0000-0000-0000-000000000000",
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			uri, err := url.Parse(v.uri)
+			if err != nil {
+				t.Fatalf("parsing %q: %+v", v.uri, err)
+			}
+
+			if actual := sanitizer.sanitizeBody(uri, []byte(v.body)); actual != v.expected {
+				t.Fatalf("expected %s but got %s", v.expected, actual)
+			}
+		})
+	}
+
+	uri, _ := url.Parse("https://example.blob.core.windows.net/container?sig=abc123&sv=2023-11-03")
+	if actual, expected := sanitizer.sanitizeURL(uri), "https://example.blob.core.windows.net/container?sig=UkVEQUNURUQ%3D&sv=2023-11-03"; actual != expected {
+		t.Fatalf("expected %s but got %s", expected, actual)
+	}
+}
+
+func TestRecordingRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recordings", "TestExample.json")
+
+	// a long-running operation which completes on the second poll
+	polls := 0
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut:
+			w.Header().Set("Azure-AsyncOperation", fmt.Sprintf("%s/subscriptions/%s/operations/1", server.URL, recordingTestSubscriptionId))
+			w.Header().Set("Retry-After", "10")
+			w.Header().Set("X-Ms-Request-Id", "example")
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"name":"example"}`))
+
+		case strings.HasSuffix(r.URL.Path, "/operations/1"):
+			polls++
+			status := "InProgress"
+			if polls > 1 {
+				status = "Succeeded"
+			}
+			w.Write([]byte(fmt.Sprintf(`{"status":%q}`, status)))
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	resourceUrl := fmt.Sprintf("%s/subscriptions/%s/resourceGroups/example", server.URL, recordingTestSubscriptionId)
+	operationUrl := fmt.Sprintf("%s/subscriptions/%s/operations/1", server.URL, recordingTestSubscriptionId)
+
+	recorder, err := NewRecordingSession(RecordingModeRecord, path, "TestExample", map[string]string{
+		recordingTestSubscriptionId: RecordingPlaceholderId,
+	})
+	if err != nil {
+		t.Fatalf("starting recording: %+v", err)
+	}
+	SetRecordingSession(recorder)
+	recorder.Variable("random_integer", "12345")
+
+	// the requests are made to the placeholder URLs when replaying, since the Provider is configured using these
+	replayResourceUrl := strings.ReplaceAll(resourceUrl, recordingTestSubscriptionId, RecordingPlaceholderId)
+	replayOperationUrl := strings.ReplaceAll(operationUrl, recordingTestSubscriptionId, RecordingPlaceholderId)
+
+	recorded := []string{
+		sendRecordingTestRequest(t, http.MethodPut, resourceUrl, `{"properties":{"password":"secret"}}`),
+		sendRecordingTestRequest(t, http.MethodGet, operationUrl, ""),
+		sendRecordingTestRequest(t, http.MethodGet, operationUrl, ""),
+	}
+	if err := recorder.Stop(); err != nil {
+		t.Fatalf("stopping recording: %+v", err)
+	}
+	server.Close()
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading recording: %+v", err)
+	}
+	for _, v := range []string{recordingTestSubscriptionId, "X-Ms-Request-Id", "secret"} {
+		if strings.Contains(string(contents), v) {
+			t.Fatalf("expected the recording not to contain %q but got:\n%s", v, contents)
+		}
+	}
+
+	player, err := NewRecordingSession(RecordingModeReplay, path, "TestExample", nil)
+	if err != nil {
+		t.Fatalf("loading recording: %+v", err)
+	}
+	SetRecordingSession(player)
+	defer player.Stop()
+
+	if v := player.Variable("random_integer", "67890"); v != "12345" {
+		t.Fatalf("expected the recorded variable `12345` but got %q", v)
+	}
+
+	replayed := []string{
+		sendRecordingTestRequest(t, http.MethodPut, replayResourceUrl, `{"properties":{"password":"secret"}}`),
+		sendRecordingTestRequest(t, http.MethodGet, replayOperationUrl, ""),
+		sendRecordingTestRequest(t, http.MethodGet, replayOperationUrl, ""),
+	}
+	for i := range recorded {
+		expected := strings.ReplaceAll(recorded[i], recordingTestSubscriptionId, RecordingPlaceholderId)
+		// the replayed responses are polled without waiting
+		expected = strings.ReplaceAll(expected, "Retry-After: 10 ", "Retry-After: 0 ")
+		expected = strings.ReplaceAll(expected, "Retry-After:  ", "Retry-After: 0 ")
+		if replayed[i] != expected {
+			t.Fatalf("expected request %d to replay %q but got %q", i, expected, replayed[i])
+		}
+	}
+
+	// once the recorded interactions have been exhausted the last one is repeated, to allow for further polling
+	if actual := sendRecordingTestRequest(t, http.MethodGet, replayOperationUrl, ""); actual != replayed[2] {
+		t.Fatalf("expected the last interaction to be repeated %q but got %q", replayed[2], actual)
+	}
+
+	if actual := sendRecordingTestRequest(t, http.MethodDelete, replayResourceUrl, ""); !strings.HasPrefix(actual, "501") {
+		t.Fatalf("expected a request which wasn't recorded to return a 501 but got %q", actual)
+	}
+}
+
+// sendRecordingTestRequest sends a request through the recording middlewares, returning a summary of the response
+func sendRecordingTestRequest(t *testing.T, method, uri, body string) string {
+	var reqBody io.Reader
+	if body != "" {
+		reqBody = io.NopCloser(strings.NewReader(body))
+	}
+	request, err := http.NewRequest(method, uri, reqBody)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	request.Header.Set("Authorization", "Bearer example")
+
+	if request, err = recordingRequestMiddleware()(request); err != nil {
+		t.Fatalf("running request middleware: %+v", err)
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+
+	if response, err = recordingResponseMiddleware()(request, response); err != nil {
+		t.Fatalf("running response middleware: %+v", err)
+	}
+	defer response.Body.Close()
+
+	if request.URL.String() != uri {
+		t.Fatalf("expected the request URL to be %q but got %q", uri, request.URL.String())
+	}
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("reading response body: %+v", err)
+	}
+
+	return fmt.Sprintf("%d Azure-AsyncOperation: %s Retry-After: %s %s", response.StatusCode, response.Header.Get("Azure-AsyncOperation"), response.Header.Get("Retry-After"), responseBody)
+}
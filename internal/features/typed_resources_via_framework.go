@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package features
+
+import (
+	"os"
+	"strings"
+)
+
+// TypedResourcesViaFramework returns whether Typed Resources should be exposed via the
+// Plugin Framework (using the `sdk.FrameworkResourceWrapper`) rather than Plugin SDKv2.
+//
+// This exists to allow the Typed Resources to be tested against both back-ends during the
+// migration to the Plugin Framework, and can be enabled by setting the Environment Variable
+// `ARM_TYPED_RESOURCES_VIA_FRAMEWORK` to `true`. This is ** NOT READY FOR PUBLIC USE **.
+func TypedResourcesViaFramework() bool {
+	return strings.EqualFold(os.Getenv("ARM_TYPED_RESOURCES_VIA_FRAMEWORK"), "true")
+}
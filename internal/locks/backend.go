@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package locks
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// Backend is a lock which is shared between processes, allowing operations on the same resources to be
+// serialized across multiple instances of the Provider (for example separate pipelines managing resources
+// within the same Virtual Network).
+//
+// Locks are always acquired in-process first, meaning that a Backend only needs to handle contention
+// between processes.
+type Backend interface {
+	// Lock acquires the lock for the specified key, blocking until the lock is acquired or the context is done
+	Lock(ctx context.Context, key string) error
+
+	// Unlock releases the lock for the specified key, which must have been acquired by this Backend
+	Unlock(ctx context.Context, key string) error
+}
+
+// LockInfo describes the current holder of a lock, for diagnostic purposes
+type LockInfo struct {
+	Key      string    `json:"key"`
+	Holder   string    `json:"holder"`
+	Acquired time.Time `json:"acquired"`
+}
+
+func (i LockInfo) String() string {
+	if i.Acquired.IsZero() {
+		return fmt.Sprintf("%q (held by %s)", i.Key, i.Holder)
+	}
+	return fmt.Sprintf("%q (held by %s since %s)", i.Key, i.Holder, i.Acquired.Format(time.RFC3339))
+}
+
+const (
+	// backendPollInterval is how often a Backend checks whether a lock held by another process is available
+	backendPollInterval = 2 * time.Second
+
+	// backendDiagnosticsInterval is how often the holder of a lock is logged whilst waiting for it
+	backendDiagnosticsInterval = time.Minute
+)
+
+var (
+	backend     Backend
+	backendLock sync.RWMutex
+
+	// holderName identifies this process as the holder of any locks it acquires
+	holderName = defaultHolderName()
+)
+
+// SetBackend configures the Backend used to lock resources between processes, or nil to only lock in-process.
+//
+// Since locks are process-wide this applies to all instances of the Provider within this process, and should
+// be called prior to any locks being acquired.
+func SetBackend(b Backend) {
+	backendLock.Lock()
+	defer backendLock.Unlock()
+
+	backend = b
+}
+
+func currentBackend() Backend {
+	backendLock.RLock()
+	defer backendLock.RUnlock()
+
+	return backend
+}
+
+func defaultHolderName() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s (pid %d)", hostname, os.Getpid())
+}
+
+// backendKey returns a name for the key which is safe to use as a File or Blob name
+func backendKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+// waitForLock calls tryLock until the lock is acquired or the context is done, periodically logging the
+// current holder of the lock so that any deadlocks between processes can be diagnosed
+func waitForLock(ctx context.Context, key string, pollInterval time.Duration, tryLock func() (bool, *LockInfo, error)) error {
+	started := time.Now()
+	lastLogged := started
+
+	for {
+		acquired, holder, err := tryLock()
+		if err != nil {
+			return fmt.Errorf("acquiring lock %q: %+v", key, err)
+		}
+		if acquired {
+			if waited := time.Since(started); waited >= backendDiagnosticsInterval {
+				log.Printf("[DEBUG] Acquired lock %q after waiting %s", key, waited.Round(time.Second))
+			}
+			return nil
+		}
+
+		if time.Since(lastLogged) >= backendDiagnosticsInterval {
+			lastLogged = time.Now()
+			if holder != nil {
+				log.Printf("[WARN] Waited %s to acquire lock %s - if this lock isn't released this may indicate a deadlock between processes", time.Since(started).Round(time.Second), holder)
+			} else {
+				log.Printf("[WARN] Waited %s to acquire lock %q - if this lock isn't released this may indicate a deadlock between processes", time.Since(started).Round(time.Second), key)
+			}
+		}
+
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if holder != nil {
+				return fmt.Errorf("timed out after %s waiting for lock %s: %+v", time.Since(started).Round(time.Second), holder, ctx.Err())
+			}
+			return fmt.Errorf("timed out after %s waiting for lock %q: %+v", time.Since(started).Round(time.Second), key, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// errLeaseLost is returned (wrapped) when renewing a lease fails because the lock is now held by another process
+var errLeaseLost = errors.New("the lock is no longer held by this process")
+
+// leaseRenewer periodically renews a lock until it's stopped
+type leaseRenewer struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	// err is set when the lease has been lost, after which it's no longer renewed
+	err error
+}
+
+// startRenewing calls renew every interval until the returned leaseRenewer is stopped. Failing to renew the lease
+// is retried until the lease expires, at which point (or when renew returns errLeaseLost) the lease is lost - the
+// operation which acquired the lock using lockCtx is then cancelled, and the error is returned from stop.
+func startRenewing(lockCtx context.Context, key string, interval, leaseDuration time.Duration, renew func(ctx context.Context) error) *leaseRenewer {
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &leaseRenewer{
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		renewed := time.Now()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				err := renew(ctx)
+				if err == nil {
+					renewed = time.Now()
+					continue
+				}
+				if ctx.Err() != nil {
+					return
+				}
+
+				if errors.Is(err, errLeaseLost) || time.Since(renewed) >= leaseDuration {
+					log.Printf("[ERROR] Lost the lease for lock %q: %+v", key, err)
+					r.err = fmt.Errorf("renewing the lease: %+v", err)
+					cancelOnLostLock(lockCtx, fmt.Errorf("%w: lock %q: %+v", ErrLockLost, key, r.err))
+					return
+				}
+				log.Printf("[ERROR] Renewing the lease for lock %q: %+v - retrying", key, err)
+			}
+		}
+	}()
+
+	return r
+}
+
+// stop stops renewing the lease, returning an error if the lease was lost whilst it was held
+func (r *leaseRenewer) stop() error {
+	r.cancel()
+	<-r.done
+	return r.err
+}
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package locks
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+)
+
+const (
+	// blobLeaseDuration is the duration of the lease on each Blob, which must be between 15 and 60 seconds
+	blobLeaseDuration = 30 * time.Second
+
+	// blobLockPrefix is the prefix for the name of each Blob used as a lock
+	blobLockPrefix = "terraform-provider-azurerm/locks/"
+)
+
+// BlobLeaseClient is the subset of the Blob Storage API used by the BlobLeaseBackend
+type BlobLeaseClient interface {
+	AcquireLease(ctx context.Context, containerName string, blobName string, input blobs.AcquireLeaseInput) (blobs.AcquireLeaseResponse, error)
+	GetProperties(ctx context.Context, containerName string, blobName string, input blobs.GetPropertiesInput) (blobs.GetPropertiesResponse, error)
+	PutBlockBlob(ctx context.Context, containerName string, blobName string, input blobs.PutBlockBlobInput) (blobs.PutBlockBlobResponse, error)
+	ReleaseLease(ctx context.Context, containerName string, blobName string, input blobs.ReleaseLeaseInput) (blobs.ReleaseLeaseResponse, error)
+	RenewLease(ctx context.Context, containerName string, blobName string, input blobs.RenewLeaseInput) (blobs.RenewLeaseResponse, error)
+	SetMetaData(ctx context.Context, containerName string, blobName string, input blobs.SetMetaDataInput) (blobs.SetMetaDataResponse, error)
+}
+
+var _ BlobLeaseClient = blobs.Client{}
+
+var _ Backend = &BlobLeaseBackend{}
+
+// BlobLeaseBackend is a Backend which uses Leases on Blobs within a Storage Container, which allows locks to be
+// shared between machines (for example multiple pipelines managing resources in the same Virtual Network).
+//
+// A Blob is created for each lock, and the lock is held by acquiring a Lease on this Blob - which is renewed
+// until the lock is released. Should the process holding the lock be killed the Lease expires, allowing the
+// lock to be acquired by another process.
+type BlobLeaseBackend struct {
+	client        BlobLeaseClient
+	containerName string
+	pollInterval  time.Duration
+	renewInterval time.Duration
+
+	lock sync.Mutex
+	held map[string]*heldBlobLease
+}
+
+type heldBlobLease struct {
+	leaseId string
+	renewer *leaseRenewer
+}
+
+// NewBlobLeaseBackend returns a Backend which uses Leases on Blobs within the specified Storage Container
+func NewBlobLeaseBackend(client BlobLeaseClient, containerName string) (*BlobLeaseBackend, error) {
+	if client == nil {
+		return nil, fmt.Errorf("a client must be specified for the blob lock backend")
+	}
+	if containerName == "" {
+		return nil, fmt.Errorf("a container name must be specified for the blob lock backend")
+	}
+
+	return &BlobLeaseBackend{
+		client:        client,
+		containerName: containerName,
+		pollInterval:  backendPollInterval,
+		renewInterval: blobLeaseDuration / 3,
+		held:          map[string]*heldBlobLease{},
+	}, nil
+}
+
+func (b *BlobLeaseBackend) Lock(ctx context.Context, key string) error {
+	blobName := blobLockPrefix + backendKey(key)
+
+	var leaseId string
+	err := waitForLock(ctx, key, b.pollInterval, func() (bool, *LockInfo, error) {
+		id, holder, err := b.tryLock(ctx, blobName, key)
+		if err != nil {
+			return false, nil, err
+		}
+		leaseId = id
+		return id != "", holder, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// record the holder of the lock, so that this can be surfaced to other processes waiting for it
+	metadata := map[string]string{
+		"key":      key,
+		"holder":   holderName,
+		"acquired": time.Now().UTC().Format(time.RFC3339),
+	}
+	if _, err := b.client.SetMetaData(ctx, b.containerName, blobName, blobs.SetMetaDataInput{
+		LeaseID:  pointer.To(leaseId),
+		MetaData: metadata,
+	}); err != nil {
+		// this is only used for diagnostics, so isn't fatal
+		log.Printf("[DEBUG] Setting the holder of lock %q: %+v", key, err)
+	}
+
+	renewer := startRenewing(ctx, key, b.renewInterval, blobLeaseDuration, func(ctx context.Context) error {
+		resp, err := b.client.RenewLease(ctx, b.containerName, blobName, blobs.RenewLeaseInput{
+			LeaseID: leaseId,
+		})
+		if err != nil {
+			switch blobErrorCode(resp.HttpResponse) {
+			case "LeaseIdMismatchWithLeaseOperation", "LeaseLost", "LeaseNotPresentWithLeaseOperation":
+				return fmt.Errorf("%w: %+v", errLeaseLost, err)
+			}
+		}
+		return err
+	})
+
+	b.lock.Lock()
+	b.held[key] = &heldBlobLease{
+		leaseId: leaseId,
+		renewer: renewer,
+	}
+	b.lock.Unlock()
+
+	return nil
+}
+
+func (b *BlobLeaseBackend) Unlock(ctx context.Context, key string) error {
+	b.lock.Lock()
+	held, ok := b.held[key]
+	delete(b.held, key)
+	b.lock.Unlock()
+
+	if !ok {
+		return fmt.Errorf("lock %q is not held by this process", key)
+	}
+	if err := held.renewer.stop(); err != nil {
+		return fmt.Errorf("lock %q was lost whilst it was held: %+v", key, err)
+	}
+
+	blobName := blobLockPrefix + backendKey(key)
+	if _, err := b.client.ReleaseLease(ctx, b.containerName, blobName, blobs.ReleaseLeaseInput{
+		LeaseID: held.leaseId,
+	}); err != nil {
+		return fmt.Errorf("releasing the lease for lock %q: %+v", key, err)
+	}
+	return nil
+}
+
+// tryLock attempts to acquire a Lease on the Blob, creating the Blob if necessary - returning the Lease ID if
+// successful, or the current holder of the lock if it's held by another process
+func (b *BlobLeaseBackend) tryLock(ctx context.Context, blobName, key string) (string, *LockInfo, error) {
+	resp, err := b.client.AcquireLease(ctx, b.containerName, blobName, blobs.AcquireLeaseInput{
+		LeaseDuration: int(blobLeaseDuration.Seconds()),
+	})
+	if err == nil {
+		return resp.LeaseID, nil, nil
+	}
+
+	switch blobErrorCode(resp.HttpResponse) {
+	case "BlobNotFound":
+		// the Blob is created on first use, and since it's empty it doesn't matter if this races with another
+		// process - unless that process has since acquired a lease on it, in which case it'll be retried
+		putResp, err := b.client.PutBlockBlob(ctx, b.containerName, blobName, blobs.PutBlockBlobInput{
+			MetaData: map[string]string{
+				"key": key,
+			},
+		})
+		if err != nil && blobErrorCode(putResp.HttpResponse) != "LeaseIdMissing" {
+			return "", nil, fmt.Errorf("creating blob %q for lock: %+v", blobName, err)
+		}
+		return "", nil, nil
+
+	case "LeaseAlreadyPresent":
+		return "", b.holder(ctx, blobName, key), nil
+	}
+
+	return "", nil, fmt.Errorf("acquiring a lease on blob %q: %+v", blobName, err)
+}
+
+// holder returns the current holder of the lock, as recorded in the Blob's metadata
+func (b *BlobLeaseBackend) holder(ctx context.Context, blobName, key string) *LockInfo {
+	info := &LockInfo{
+		Key:    key,
+		Holder: "another process",
+	}
+
+	props, err := b.client.GetProperties(ctx, b.containerName, blobName, blobs.GetPropertiesInput{})
+	if err != nil {
+		return info
+	}
+	if v := props.MetaData["holder"]; v != "" {
+		info.Holder = v
+	}
+	if v, err := time.Parse(time.RFC3339, props.MetaData["acquired"]); err == nil {
+		info.Acquired = v
+	}
+	return info
+}
+
+// blobErrorCode returns the Storage error code (e.g. `LeaseAlreadyPresent`) from the response, if any
+func blobErrorCode(resp *http.Response) string {
+	if resp == nil {
+		return ""
+	}
+	if code := resp.Header.Get("x-ms-error-code"); code != "" {
+		return code
+	}
+
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return "BlobNotFound"
+	case http.StatusConflict:
+		return "LeaseAlreadyPresent"
+	}
+	return ""
+}
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package locks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+)
+
+var _ BlobLeaseClient = &fakeBlobLeaseClient{}
+
+// fakeBlobLeaseClient is an in-memory implementation of the Blob Lease API
+type fakeBlobLeaseClient struct {
+	lock    sync.Mutex
+	blobs   map[string]*fakeBlob
+	leaseId int
+}
+
+type fakeBlob struct {
+	leaseId  string
+	metadata map[string]string
+}
+
+func newFakeBlobLeaseClient() *fakeBlobLeaseClient {
+	return &fakeBlobLeaseClient{
+		blobs: map[string]*fakeBlob{},
+	}
+}
+
+func fakeBlobError(statusCode int, code string) (*http.Response, error) {
+	resp := &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{},
+	}
+	resp.Header.Set("x-ms-error-code", code)
+	return resp, fmt.Errorf("unexpected status %d (%s)", statusCode, code)
+}
+
+func (c *fakeBlobLeaseClient) AcquireLease(_ context.Context, _ string, blobName string, input blobs.AcquireLeaseInput) (result blobs.AcquireLeaseResponse, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if input.LeaseDuration != -1 && (input.LeaseDuration < 15 || input.LeaseDuration > 60) {
+		result.HttpResponse, err = fakeBlobError(http.StatusBadRequest, "InvalidHeaderValue")
+		return
+	}
+
+	blob, ok := c.blobs[blobName]
+	if !ok {
+		result.HttpResponse, err = fakeBlobError(http.StatusNotFound, "BlobNotFound")
+		return
+	}
+	if blob.leaseId != "" {
+		result.HttpResponse, err = fakeBlobError(http.StatusConflict, "LeaseAlreadyPresent")
+		return
+	}
+
+	c.leaseId++
+	blob.leaseId = fmt.Sprintf("lease-%d", c.leaseId)
+	result.LeaseID = blob.leaseId
+	return
+}
+
+func (c *fakeBlobLeaseClient) GetProperties(_ context.Context, _ string, blobName string, _ blobs.GetPropertiesInput) (result blobs.GetPropertiesResponse, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	blob, ok := c.blobs[blobName]
+	if !ok {
+		result.HttpResponse, err = fakeBlobError(http.StatusNotFound, "BlobNotFound")
+		return
+	}
+	result.MetaData = blob.metadata
+	return
+}
+
+func (c *fakeBlobLeaseClient) PutBlockBlob(_ context.Context, _ string, blobName string, input blobs.PutBlockBlobInput) (result blobs.PutBlockBlobResponse, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if blob, ok := c.blobs[blobName]; ok && blob.leaseId != "" {
+		result.HttpResponse, err = fakeBlobError(http.StatusPreconditionFailed, "LeaseIdMissing")
+		return
+	}
+	c.blobs[blobName] = &fakeBlob{
+		metadata: input.MetaData,
+	}
+	return
+}
+
+func (c *fakeBlobLeaseClient) ReleaseLease(_ context.Context, _ string, blobName string, input blobs.ReleaseLeaseInput) (result blobs.ReleaseLeaseResponse, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	blob, ok := c.blobs[blobName]
+	if !ok || blob.leaseId != input.LeaseID {
+		result.HttpResponse, err = fakeBlobError(http.StatusConflict, "LeaseIdMismatchWithLeaseOperation")
+		return
+	}
+	blob.leaseId = ""
+	return
+}
+
+func (c *fakeBlobLeaseClient) RenewLease(_ context.Context, _ string, blobName string, input blobs.RenewLeaseInput) (result blobs.RenewLeaseResponse, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	blob, ok := c.blobs[blobName]
+	if !ok || blob.leaseId != input.LeaseID {
+		result.HttpResponse, err = fakeBlobError(http.StatusConflict, "LeaseIdMismatchWithLeaseOperation")
+	}
+	return
+}
+
+func (c *fakeBlobLeaseClient) SetMetaData(_ context.Context, _ string, blobName string, input blobs.SetMetaDataInput) (result blobs.SetMetaDataResponse, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	blob, ok := c.blobs[blobName]
+	if !ok || input.LeaseID == nil || blob.leaseId != *input.LeaseID {
+		result.HttpResponse, err = fakeBlobError(http.StatusPreconditionFailed, "LeaseIdMissing")
+		return
+	}
+	blob.metadata = input.MetaData
+	return
+}
+
+func TestBlobLeaseBackend(t *testing.T) {
+	client := newFakeBlobLeaseClient()
+	first, err := NewBlobLeaseBackend(client, "locks")
+	if err != nil {
+		t.Fatalf("building blob backend: %+v", err)
+	}
+	second, err := NewBlobLeaseBackend(client, "locks")
+	if err != nil {
+		t.Fatalf("building blob backend: %+v", err)
+	}
+	first.pollInterval = 10 * time.Millisecond
+	second.pollInterval = 10 * time.Millisecond
+
+	// the blob is created on first use
+	if err := first.Lock(context.Background(), "example"); err != nil {
+		t.Fatalf("acquiring lock: %+v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err = second.Lock(ctx, "example")
+	if err == nil {
+		t.Fatalf("expected an error acquiring a lock held by another backend")
+	}
+	if !strings.Contains(err.Error(), holderName) {
+		t.Fatalf("expected the error to name the holder %q but got: %+v", holderName, err)
+	}
+
+	if err := first.Unlock(context.Background(), "example"); err != nil {
+		t.Fatalf("releasing lock: %+v", err)
+	}
+
+	if err := second.Lock(context.Background(), "example"); err != nil {
+		t.Fatalf("acquiring lock: %+v", err)
+	}
+	if err := second.Unlock(context.Background(), "example"); err != nil {
+		t.Fatalf("releasing lock: %+v", err)
+	}
+
+	if len(client.blobs) != 1 {
+		t.Fatalf("expected a single blob to be used for the lock but got %d", len(client.blobs))
+	}
+}
+
+func TestBlobLeaseBackend_LeaseLost(t *testing.T) {
+	client := newFakeBlobLeaseClient()
+	b, err := NewBlobLeaseBackend(client, "locks")
+	if err != nil {
+		t.Fatalf("building blob backend: %+v", err)
+	}
+	b.pollInterval = 10 * time.Millisecond
+	b.renewInterval = 10 * time.Millisecond
+
+	if err := b.Lock(context.Background(), "example"); err != nil {
+		t.Fatalf("acquiring lock: %+v", err)
+	}
+
+	// breaking the lease simulates it expiring, for example when the process was unable to renew it in time
+	client.lock.Lock()
+	for _, blob := range client.blobs {
+		blob.leaseId = ""
+	}
+	client.lock.Unlock()
+	time.Sleep(50 * time.Millisecond)
+
+	if err := b.Unlock(context.Background(), "example"); err == nil {
+		t.Fatalf("expected an error releasing a lock whose lease was lost")
+	}
+}
+
+func TestBlobLeaseBackend_LeaseLostCancelsOperation(t *testing.T) {
+	client := newFakeBlobLeaseClient()
+	b, err := NewBlobLeaseBackend(client, "locks")
+	if err != nil {
+		t.Fatalf("building blob backend: %+v", err)
+	}
+	b.pollInterval = 10 * time.Millisecond
+	b.renewInterval = 10 * time.Millisecond
+	SetBackend(b)
+	defer SetBackend(nil)
+
+	ctx, cancel := WithCancelOnLostLock(context.Background())
+	defer cancel()
+	if err := ByNameWithContext(ctx, "example", "azurerm_virtual_network"); err != nil {
+		t.Fatalf("acquiring lock: %+v", err)
+	}
+
+	// the lease is lost whilst the operation holding the lock is still in progress
+	client.lock.Lock()
+	for _, blob := range client.blobs {
+		blob.leaseId = ""
+	}
+	client.lock.Unlock()
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected the operation holding the lock to be cancelled when the lease was lost")
+	}
+	if err := LostLockError(ctx); err == nil {
+		t.Fatalf("expected the operation to be cancelled because the lock was lost, but got: %+v", context.Cause(ctx))
+	}
+
+	UnlockByName("example", "azurerm_virtual_network")
+
+	// an operation which completes without losing its locks isn't reported as having lost them
+	ctx, cancel = WithCancelOnLostLock(context.Background())
+	if err := ByNameWithContext(ctx, "example", "azurerm_virtual_network"); err != nil {
+		t.Fatalf("acquiring lock: %+v", err)
+	}
+	UnlockByName("example", "azurerm_virtual_network")
+	cancel()
+	if err := LostLockError(ctx); err != nil {
+		t.Fatalf("expected no lost lock once the operation completed but got: %+v", err)
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package locks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+// fileLeaseDuration is how long a lock file is valid for without being renewed, after which it's considered
+// stale (for example when the process holding the lock was killed) and can be acquired by another process
+const fileLeaseDuration = 30 * time.Second
+
+// fileGuardPollInterval is how often the guard for a lock file is checked whilst waiting for it, which is only
+// held briefly
+const fileGuardPollInterval = 10 * time.Millisecond
+
+var _ Backend = &FileBackend{}
+
+// FileBackend is a Backend which uses lock files within a directory, which can be shared between processes
+// on the same machine - or between machines using a shared file system.
+type FileBackend struct {
+	directory     string
+	leaseDuration time.Duration
+	pollInterval  time.Duration
+
+	lock sync.Mutex
+	held map[string]*heldFileLock
+}
+
+type heldFileLock struct {
+	token   string
+	renewer *leaseRenewer
+}
+
+// fileLockContents is the contents of a lock file
+type fileLockContents struct {
+	LockInfo
+
+	// Token uniquely identifies this acquisition of the lock
+	Token string `json:"token"`
+
+	// Expires is when the lock is considered stale unless it's renewed
+	Expires time.Time `json:"expires"`
+}
+
+// NewFileBackend returns a Backend which stores lock files in the specified directory, creating it if necessary
+func NewFileBackend(directory string) (*FileBackend, error) {
+	if directory == "" {
+		return nil, fmt.Errorf("a directory must be specified for the file lock backend")
+	}
+
+	if err := os.MkdirAll(directory, 0o755); err != nil {
+		return nil, fmt.Errorf("creating lock directory %q: %+v", directory, err)
+	}
+
+	return &FileBackend{
+		directory:     directory,
+		leaseDuration: fileLeaseDuration,
+		pollInterval:  backendPollInterval,
+		held:          map[string]*heldFileLock{},
+	}, nil
+}
+
+func (b *FileBackend) Lock(ctx context.Context, key string) error {
+	token, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Errorf("generating lock token: %+v", err)
+	}
+
+	path := b.path(key)
+	err = waitForLock(ctx, key, b.pollInterval, func() (bool, *LockInfo, error) {
+		return b.tryLock(ctx, path, key, token)
+	})
+	if err != nil {
+		return err
+	}
+
+	renewer := startRenewing(ctx, key, b.leaseDuration/3, b.leaseDuration, func(ctx context.Context) error {
+		return b.renew(ctx, path, key, token)
+	})
+
+	b.lock.Lock()
+	b.held[key] = &heldFileLock{
+		token:   token,
+		renewer: renewer,
+	}
+	b.lock.Unlock()
+
+	return nil
+}
+
+func (b *FileBackend) Unlock(ctx context.Context, key string) error {
+	b.lock.Lock()
+	held, ok := b.held[key]
+	delete(b.held, key)
+	b.lock.Unlock()
+
+	if !ok {
+		return fmt.Errorf("lock %q is not held by this process", key)
+	}
+	if err := held.renewer.stop(); err != nil {
+		return fmt.Errorf("lock %q was lost whilst it was held: %+v", key, err)
+	}
+
+	path := b.path(key)
+	return b.withGuard(ctx, path, func() error {
+		existing, err := readLockFile(path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return fmt.Errorf("reading lock file for %q: %+v", key, err)
+		}
+
+		// the lock file could have been taken over by another process if it wasn't renewed in time
+		if existing.Token != held.token {
+			return fmt.Errorf("lock %q is no longer held by this process, and is now held by %s", key, existing.Holder)
+		}
+
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("removing lock file for %q: %+v", key, err)
+		}
+		return nil
+	})
+}
+
+// tryLock attempts to create the lock file, returning the current holder if the lock is held by another process
+func (b *FileBackend) tryLock(ctx context.Context, path, key, token string) (bool, *LockInfo, error) {
+	contents, err := b.contents(key, token, time.Now())
+	if err != nil {
+		return false, nil, err
+	}
+
+	// the lock file is written to a temporary file and then linked into place, which fails if the lock file
+	// already exists - so that the lock file is created atomically and is never partially written
+	temp := fmt.Sprintf("%s.%s.tmp", path, token)
+	if err := os.WriteFile(temp, contents, 0o644); err != nil {
+		return false, nil, fmt.Errorf("writing lock file: %+v", err)
+	}
+	err = os.Link(temp, path)
+	os.Remove(temp)
+	if err == nil {
+		return true, nil, nil
+	}
+	if !errors.Is(err, os.ErrExist) {
+		return false, nil, fmt.Errorf("creating lock file %q: %+v", path, err)
+	}
+
+	existing, err := readLockFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// the lock was released in the meantime
+			return false, nil, nil
+		}
+		return false, nil, fmt.Errorf("reading lock file %q: %+v", path, err)
+	}
+
+	if time.Now().After(existing.Expires) {
+		// the holder hasn't renewed the lock, so it's stale - the lock file is only removed if it's still the
+		// same stale lock once the guard is held, since it could otherwise have been renewed or replaced
+		err := b.withGuard(ctx, path, func() error {
+			current, err := readLockFile(path)
+			if err != nil || current.Token != existing.Token || !time.Now().After(current.Expires) {
+				return nil
+			}
+			if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("removing stale lock file %q: %+v", path, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return false, nil, err
+		}
+	}
+
+	return false, &existing.LockInfo, nil
+}
+
+// renew extends the expiry of the lock file, provided that it's still held by this process
+func (b *FileBackend) renew(ctx context.Context, path, key, token string) error {
+	return b.withGuard(ctx, path, func() error {
+		existing, err := readLockFile(path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("%w: the lock file has been removed", errLeaseLost)
+			}
+			return fmt.Errorf("reading lock file: %+v", err)
+		}
+		if existing.Token != token {
+			return fmt.Errorf("%w: the lock is now held by %s", errLeaseLost, existing.Holder)
+		}
+
+		contents, err := b.contents(key, token, existing.Acquired)
+		if err != nil {
+			return err
+		}
+
+		// write to a temporary file and rename it, so that the lock file is never partially written
+		temp := fmt.Sprintf("%s.%s.tmp", path, token)
+		if err := os.WriteFile(temp, contents, 0o644); err != nil {
+			return fmt.Errorf("writing lock file: %+v", err)
+		}
+		if err := os.Rename(temp, path); err != nil {
+			os.Remove(temp)
+			return fmt.Errorf("replacing lock file: %+v", err)
+		}
+		return nil
+	})
+}
+
+// withGuard calls fn whilst holding the guard for the lock file, which serializes changes to an existing lock
+// file (renewing, releasing or removing a stale lock) between processes - so that the owner of the lock file
+// can be checked and the lock file changed without another process changing it in the meantime.
+//
+// The guard is a file which is created exclusively, and since it's only held briefly a guard older than the
+// lease is left over from a process which was killed whilst holding it, and is removed.
+func (b *FileBackend) withGuard(ctx context.Context, path string, fn func() error) error {
+	guard := path + ".guard"
+	for {
+		file, err := os.OpenFile(guard, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err == nil {
+			file.Close()
+			defer os.Remove(guard)
+			return fn()
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return fmt.Errorf("creating lock guard %q: %+v", guard, err)
+		}
+
+		if info, err := os.Stat(guard); err == nil && time.Since(info.ModTime()) > b.leaseDuration {
+			removeStaleGuard(guard, info)
+			continue
+		}
+
+		timer := time.NewTimer(fileGuardPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("waiting for lock guard %q: %+v", guard, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// removeStaleGuard removes the guard, provided that it's the same stale guard which was found - it's first moved
+// aside so that a guard which was replaced by another process in the meantime can be identified and restored
+func removeStaleGuard(guard string, stale os.FileInfo) {
+	token, err := uuid.GenerateUUID()
+	if err != nil {
+		return
+	}
+
+	moved := fmt.Sprintf("%s.%s.stale", guard, token)
+	if err := os.Rename(guard, moved); err != nil {
+		return
+	}
+	if info, err := os.Stat(moved); err == nil && !os.SameFile(info, stale) {
+		// linking (unlike renaming) fails rather than replacing a guard created by another process since
+		os.Link(moved, guard)
+	}
+	os.Remove(moved)
+}
+
+func (b *FileBackend) contents(key, token string, acquired time.Time) ([]byte, error) {
+	contents, err := json.Marshal(fileLockContents{
+		LockInfo: LockInfo{
+			Key:      key,
+			Holder:   holderName,
+			Acquired: acquired,
+		},
+		Token:   token,
+		Expires: time.Now().Add(b.leaseDuration),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshalling lock file contents: %+v", err)
+	}
+	return contents, nil
+}
+
+func (b *FileBackend) path(key string) string {
+	return filepath.Join(b.directory, backendKey(key)+".lock")
+}
+
+func readLockFile(path string) (*fileLockContents, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var output fileLockContents
+	if err := json.Unmarshal(contents, &output); err != nil {
+		return nil, fmt.Errorf("parsing lock file %q: %+v", path, err)
+	}
+	return &output, nil
+}
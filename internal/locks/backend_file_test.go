@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package locks
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testFileBackend returns a FileBackend with short intervals, so that tests don't need to wait long
+func testFileBackend(t *testing.T, directory string) *FileBackend {
+	b, err := NewFileBackend(directory)
+	if err != nil {
+		t.Fatalf("building file backend: %+v", err)
+	}
+	b.leaseDuration = 300 * time.Millisecond
+	b.pollInterval = 10 * time.Millisecond
+	return b
+}
+
+func TestFileBackend_Contention(t *testing.T) {
+	directory := t.TempDir()
+	first := testFileBackend(t, directory)
+	second := testFileBackend(t, directory)
+
+	if err := first.Lock(context.Background(), "example"); err != nil {
+		t.Fatalf("acquiring lock: %+v", err)
+	}
+
+	acquired := make(chan error)
+	go func() {
+		acquired <- second.Lock(context.Background(), "example")
+	}()
+
+	// the lease is renewed whilst it's held, so the lock isn't acquired by the second backend once it expires
+	select {
+	case err := <-acquired:
+		t.Fatalf("expected the lock to be held by the first backend, but it was acquired by the second: %+v", err)
+	case <-time.After(3 * first.leaseDuration):
+	}
+
+	if err := first.Unlock(context.Background(), "example"); err != nil {
+		t.Fatalf("releasing lock: %+v", err)
+	}
+
+	select {
+	case err := <-acquired:
+		if err != nil {
+			t.Fatalf("acquiring lock: %+v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the second backend to acquire the lock")
+	}
+
+	// locks for other keys are independent
+	if err := first.Lock(context.Background(), "other"); err != nil {
+		t.Fatalf("acquiring lock: %+v", err)
+	}
+
+	if err := first.Unlock(context.Background(), "other"); err != nil {
+		t.Fatalf("releasing lock: %+v", err)
+	}
+	if err := second.Unlock(context.Background(), "example"); err != nil {
+		t.Fatalf("releasing lock: %+v", err)
+	}
+
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		t.Fatalf("reading lock directory: %+v", err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("expected all of the lock files to be removed but got %d", len(entries))
+	}
+}
+
+func TestFileBackend_Timeout(t *testing.T) {
+	directory := t.TempDir()
+	first := testFileBackend(t, directory)
+	second := testFileBackend(t, directory)
+
+	if err := first.Lock(context.Background(), "example"); err != nil {
+		t.Fatalf("acquiring lock: %+v", err)
+	}
+	defer first.Unlock(context.Background(), "example")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	err := second.Lock(ctx, "example")
+	if err == nil {
+		t.Fatalf("expected an error acquiring a lock held by another backend")
+	}
+	if !strings.Contains(err.Error(), holderName) {
+		t.Fatalf("expected the error to name the holder %q but got: %+v", holderName, err)
+	}
+}
+
+func TestFileBackend_StaleLock(t *testing.T) {
+	directory := t.TempDir()
+	first := testFileBackend(t, directory)
+	second := testFileBackend(t, directory)
+
+	if err := first.Lock(context.Background(), "example"); err != nil {
+		t.Fatalf("acquiring lock: %+v", err)
+	}
+
+	// stopping renewal simulates the holder of the lock being killed
+	first.lock.Lock()
+	first.held["example"].renewer.stop()
+	first.lock.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := second.Lock(ctx, "example"); err != nil {
+		t.Fatalf("expected the stale lock to be acquired: %+v", err)
+	}
+
+	// the original holder is told that it no longer holds the lock, without releasing it
+	first.lock.Lock()
+	first.held["example"].renewer = startRenewing(context.Background(), "example", time.Hour, time.Hour, func(_ context.Context) error {
+		return nil
+	})
+	first.lock.Unlock()
+	if err := first.Unlock(context.Background(), "example"); err == nil {
+		t.Fatalf("expected an error releasing a lock which was taken over by another backend")
+	}
+
+	if err := second.Unlock(context.Background(), "example"); err != nil {
+		t.Fatalf("releasing lock: %+v", err)
+	}
+}
+
+func TestFileBackend_UnlockNotHeld(t *testing.T) {
+	b := testFileBackend(t, t.TempDir())
+	if err := b.Unlock(context.Background(), "example"); err == nil {
+		t.Fatalf("expected an error releasing a lock which isn't held")
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package locks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestStartRenewing(t *testing.T) {
+	testData := []struct {
+		name          string
+		leaseDuration time.Duration
+		err           error
+		expectLost    bool
+	}{
+		{
+			name:          "renewed",
+			leaseDuration: time.Second,
+		},
+		{
+			name:          "transient error",
+			leaseDuration: time.Second,
+			err:           errors.New("connection reset"),
+		},
+		{
+			name:          "lease expired",
+			leaseDuration: 30 * time.Millisecond,
+			err:           errors.New("connection reset"),
+			expectLost:    true,
+		},
+		{
+			name:          "lease lost",
+			leaseDuration: time.Second,
+			err:           fmt.Errorf("%w: the lock is now held by another process", errLeaseLost),
+			expectLost:    true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		r := startRenewing(context.Background(), "example", 10*time.Millisecond, v.leaseDuration, func(context.Context) error {
+			return v.err
+		})
+		time.Sleep(100 * time.Millisecond)
+
+		if err := r.stop(); (err != nil) != v.expectLost {
+			t.Fatalf("expected the lease to be lost to be %t but got: %+v", v.expectLost, err)
+		}
+	}
+}
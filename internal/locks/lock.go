@@ -3,48 +3,122 @@
 
 package locks
 
-import "slices"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+)
 
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = newMutexKV()
 
-func ByID(id string) {
-	armMutexKV.Lock(id)
-}
+type holderContextKey struct{}
 
-// handle the case of using the same name for different kinds of resources
-func ByName(name string, resourceType string) {
-	updatedName := resourceType + "." + name
-	armMutexKV.Lock(updatedName)
+type lostLockContextKey struct{}
+
+// ErrLockLost is the cause of an operation being cancelled when a lock it holds is lost, for example when the
+// lease on the lock can't be renewed - meaning that another process may have acquired the lock
+var ErrLockLost = errors.New("a lock held by this operation was lost")
+
+// WithHolder returns a context which identifies the holder of any locks acquired using it (for example the
+// resource being created), which is used to diagnose timeouts and deadlocks. When not specified the holder
+// is the name of the function acquiring the lock.
+func WithHolder(ctx context.Context, holder string) context.Context {
+	return context.WithValue(ctx, holderContextKey{}, holder)
 }
 
-func MultipleByID(ids *[]string) {
-	newSlice := removeDuplicatesFromStringArray(*ids)
+// WithCancelOnLostLock returns a context which is cancelled should a lock acquired using it (from a Backend) be
+// lost whilst it's held, so that the operation holding the lock doesn't continue without it. The reason for the
+// cancellation is available using LostLockError.
+func WithCancelOnLostLock(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	ctx = context.WithValue(ctx, lostLockContextKey{}, cancel)
+	return ctx, func() {
+		cancel(nil)
+	}
+}
 
-	slices.Sort(newSlice)
+// LostLockError returns the error describing the lock which was lost, if the context was cancelled because of this
+func LostLockError(ctx context.Context) error {
+	if err := context.Cause(ctx); errors.Is(err, ErrLockLost) {
+		return err
+	}
+	return nil
+}
 
-	for _, id := range newSlice {
-		ByID(id)
+// cancelOnLostLock cancels the operation which acquired a lock using the context, if it was built using
+// WithCancelOnLostLock
+func cancelOnLostLock(ctx context.Context, err error) {
+	if cancel, ok := ctx.Value(lostLockContextKey{}).(context.CancelCauseFunc); ok {
+		cancel(err)
 	}
 }
 
-func MultipleByName(names *[]string, resourceType string) {
-	newSlice := removeDuplicatesFromStringArray(*names)
+// ByIDWithContext acquires the lock for the specified ID, returning an error naming the current holder
+// of the lock if the context is done before the lock is acquired
+func ByIDWithContext(ctx context.Context, id string) error {
+	return lock(ctx, id, holderFor(ctx))
+}
 
-	slices.Sort(newSlice)
+// ByNameWithContext acquires the lock for the specified name of the resource type, returning an error naming
+// the current holder of the lock if the context is done before the lock is acquired
+func ByNameWithContext(ctx context.Context, name string, resourceType string) error {
+	updatedName := resourceType + "." + name
+	return lock(ctx, updatedName, holderFor(ctx))
+}
 
-	for _, name := range newSlice {
-		ByName(name, resourceType)
+// MultipleByIDWithContext acquires the locks for each of the specified IDs in a consistent order, returning an
+// error if the context is done before all of the locks are acquired - in which case none of the locks are held
+func MultipleByIDWithContext(ctx context.Context, ids *[]string) error {
+	return lockMultiple(ctx, *ids, holderFor(ctx))
+}
+
+// MultipleByNameWithContext acquires the locks for each of the specified names of the resource type in a
+// consistent order, returning an error if the context is done before all of the locks are acquired - in
+// which case none of the locks are held
+func MultipleByNameWithContext(ctx context.Context, names *[]string, resourceType string) error {
+	return lockMultiple(ctx, namesToKeys(*names, resourceType), holderFor(ctx))
+}
+
+// ByIDForHolder acquires the lock for the specified ID on behalf of the holder (for example the resource being
+// created), returning an error naming the lock if the context is done before the lock is acquired
+func ByIDForHolder(ctx context.Context, holder string, id string) error {
+	if err := lock(ctx, id, holder); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
 	}
+	return nil
+}
+
+// ByNameForHolder acquires the lock for the specified name of the resource type on behalf of the holder (for
+// example the resource being created), returning an error naming the lock if the context is done before the
+// lock is acquired
+func ByNameForHolder(ctx context.Context, holder string, name string, resourceType string) error {
+	key := resourceType + "." + name
+	if err := lock(ctx, key, holder); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", key, err)
+	}
+	return nil
+}
+
+// MultipleByNameForHolder acquires the locks for each of the specified names of the resource type on behalf of
+// the holder in a consistent order, returning an error naming the lock if the context is done before all of the
+// locks are acquired - in which case none of the locks are held
+func MultipleByNameForHolder(ctx context.Context, holder string, names *[]string, resourceType string) error {
+	return lockMultiple(ctx, namesToKeys(*names, resourceType), holder)
 }
 
 func UnlockByID(id string) {
-	armMutexKV.Unlock(id)
+	unlock(id)
 }
 
 func UnlockByName(name string, resourceType string) {
 	updatedName := resourceType + "." + name
-	armMutexKV.Unlock(updatedName)
+	unlock(updatedName)
 }
 
 func UnlockMultipleByID(ids *[]string) {
@@ -62,3 +136,98 @@ func UnlockMultipleByName(names *[]string, resourceType string) {
 		UnlockByName(name, resourceType)
 	}
 }
+
+// lock acquires the lock for the key in-process, and then from the Backend (if configured) so that the
+// lock is also held across processes
+func lock(ctx context.Context, key string, holder string) error {
+	lockOrder.acquiring(key, holder)
+
+	if err := armMutexKV.LockWithContext(ctx, key, holder); err != nil {
+		return err
+	}
+
+	b := currentBackend()
+	if b == nil {
+		lockOrder.acquired(key)
+		return nil
+	}
+
+	// errors from the Backend (e.g. transient network errors) are retried until the context is done
+	for {
+		err := b.Lock(ctx, key)
+		if err == nil {
+			lockOrder.acquired(key)
+			return nil
+		}
+		if ctx.Err() != nil {
+			armMutexKV.Unlock(key)
+			return err
+		}
+
+		log.Printf("[ERROR] Acquiring lock %q from the lock backend: %+v - retrying in %s", key, err, backendPollInterval)
+		select {
+		case <-ctx.Done():
+		case <-time.After(backendPollInterval):
+		}
+	}
+}
+
+// lockMultiple acquires the locks for each of the keys in a consistent order, releasing any locks which
+// have been acquired if the context is done before all of the locks are acquired
+func lockMultiple(ctx context.Context, keys []string, holder string) error {
+	newSlice := removeDuplicatesFromStringArray(keys)
+
+	slices.Sort(newSlice)
+
+	for i, key := range newSlice {
+		if err := lock(ctx, key, holder); err != nil {
+			for _, acquired := range newSlice[:i] {
+				unlock(acquired)
+			}
+			return fmt.Errorf("acquiring lock for %s: %+v", key, err)
+		}
+	}
+
+	return nil
+}
+
+// unlock releases the lock for the key from the Backend (if configured), and then in-process
+func unlock(key string) {
+	if b := currentBackend(); b != nil {
+		if err := b.Unlock(context.Background(), key); err != nil {
+			log.Printf("[ERROR] Releasing lock %q from the lock backend: %+v", key, err)
+		}
+	}
+
+	lockOrder.released(key)
+	armMutexKV.Unlock(key)
+}
+
+// holderFor returns the holder of any locks acquired using the context - which is either the value set using
+// WithHolder, or the name of the function calling the exported function which called holderFor
+func holderFor(ctx context.Context) string {
+	if v, ok := ctx.Value(holderContextKey{}).(string); ok && v != "" {
+		return v
+	}
+
+	if pc, _, _, ok := runtime.Caller(2); ok {
+		if fn := runtime.FuncForPC(pc); fn != nil {
+			// trim the package path, e.g. `github.com/hashicorp/terraform-provider-azurerm/internal/services/network.resourceSubnetCreate`
+			name := fn.Name()
+			if i := strings.LastIndex(name, "/"); i >= 0 {
+				name = name[i+1:]
+			}
+			return name
+		}
+	}
+
+	return "unknown"
+}
+
+func namesToKeys(names []string, resourceType string) []string {
+	keys := make([]string, 0, len(names))
+	for _, name := range names {
+		keys = append(keys, resourceType+"."+name)
+	}
+	return keys
+}
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package locks
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// LockOrderDebugEnvVar is the Environment Variable which enables recording the order in which locks are acquired,
+// so that locks acquired in an inconsistent order (which can deadlock) are reported
+const LockOrderDebugEnvVar = "ARM_PROVIDER_LOCK_ORDER_DEBUG"
+
+// LockOrderInversion describes two locks which have been acquired in opposite orders, meaning that operations
+// acquiring these locks concurrently can deadlock. Since the same operations acquire different locks depending
+// on the configuration (e.g. the Virtual Network containing a Subnet), locks of two types which have been acquired
+// in opposite orders are also reported, even if the locks themselves differ.
+type LockOrderInversion struct {
+	// First and Second are the keys for the locks (or, where different locks were acquired in the opposite
+	// order, the types of the locks), in the order in which they're acquired by Holder
+	First  string `json:"first"`
+	Second string `json:"second"`
+
+	// Holder is the caller which acquired Second whilst holding First
+	Holder string `json:"holder"`
+
+	// PreviousHolder is the caller which previously acquired First whilst holding Second
+	PreviousHolder string `json:"previous_holder"`
+}
+
+func (i LockOrderInversion) String() string {
+	return fmt.Sprintf("%s acquired lock %q whilst holding lock %q, however %s previously acquired these locks in the opposite order", i.Holder, i.Second, i.First, i.PreviousHolder)
+}
+
+// lockOrder records the locks held by each goroutine and the order in which locks are acquired, and is
+// only enabled when debugging since determining the current goroutine is relatively expensive
+var lockOrder = newLockOrderTracker(os.Getenv(LockOrderDebugEnvVar) != "")
+
+type lockOrderTracker struct {
+	enabled bool
+
+	lock sync.Mutex
+
+	// held is the keys for the locks held by each goroutine, in the order in which they were acquired
+	held map[uint64][]string
+
+	// edges records the first caller which acquired the lock `[1]` whilst holding the lock `[0]`
+	edges map[[2]string]string
+
+	// typeEdges records the first caller which acquired a lock of the type `[1]` whilst holding a lock of the type `[0]`
+	typeEdges map[[2]string]string
+
+	inversions []LockOrderInversion
+
+	// reportedTypes is the pairs of lock types for which an inversion has been reported, so that each is only
+	// reported once regardless of the locks involved
+	reportedTypes map[[2]string]struct{}
+}
+
+func newLockOrderTracker(enabled bool) *lockOrderTracker {
+	return &lockOrderTracker{
+		enabled:       enabled,
+		held:          map[uint64][]string{},
+		edges:         map[[2]string]string{},
+		typeEdges:     map[[2]string]string{},
+		reportedTypes: map[[2]string]struct{}{},
+	}
+}
+
+// LockOrderInversions returns the lock-order inversions detected within this process, which is only
+// populated when the `ARM_PROVIDER_LOCK_ORDER_DEBUG` Environment Variable is set
+func LockOrderInversions() []LockOrderInversion {
+	return lockOrder.reported()
+}
+
+// acquiring records that the current goroutine is about to acquire the lock for the key, reporting any
+// lock-order inversions - which is called prior to waiting for the lock, so that any inversion is reported
+// even if this deadlocks
+func (t *lockOrderTracker) acquiring(key, holder string) {
+	if !t.enabled {
+		return
+	}
+
+	goroutine := currentGoroutineId()
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	held := t.held[goroutine]
+	if len(held) > 0 {
+		log.Printf("[DEBUG] Lock Order: %s is acquiring lock %q whilst holding %s", holder, key, strings.Join(quoteAll(held), ", "))
+	}
+
+	keyType := lockType(key)
+	for _, existing := range held {
+		if existing == key {
+			continue
+		}
+		existingType := lockType(existing)
+
+		if previousHolder, ok := t.edges[[2]string{key, existing}]; ok {
+			t.report(LockOrderInversion{
+				First:          existing,
+				Second:         key,
+				Holder:         holder,
+				PreviousHolder: previousHolder,
+			}, existingType, keyType, false)
+		} else if previousHolder, ok := t.typeEdges[[2]string{keyType, existingType}]; ok && keyType != existingType {
+			t.report(LockOrderInversion{
+				First:          existingType,
+				Second:         keyType,
+				Holder:         holder,
+				PreviousHolder: previousHolder,
+			}, existingType, keyType, true)
+		}
+
+		if _, ok := t.edges[[2]string{existing, key}]; !ok {
+			t.edges[[2]string{existing, key}] = holder
+		}
+		if _, ok := t.typeEdges[[2]string{existingType, keyType}]; !ok {
+			t.typeEdges[[2]string{existingType, keyType}] = holder
+		}
+	}
+}
+
+// report records the lock-order inversion between locks of the specified types - where the inversion is between
+// different locks of these types (`betweenTypes`), this is only reported if no inversion has already been reported
+// for these types
+func (t *lockOrderTracker) report(inversion LockOrderInversion, firstType, secondType string, betweenTypes bool) {
+	types := [2]string{firstType, secondType}
+	slices.Sort(types[:])
+
+	if _, ok := t.reportedTypes[types]; ok && betweenTypes {
+		return
+	}
+	if slices.Contains(t.inversions, inversion) {
+		return
+	}
+
+	log.Printf("[WARN] Potential deadlock detected: %s", inversion)
+	t.inversions = append(t.inversions, inversion)
+	t.reportedTypes[types] = struct{}{}
+}
+
+// acquired records that the current goroutine holds the lock for the key
+func (t *lockOrderTracker) acquired(key string) {
+	if !t.enabled {
+		return
+	}
+
+	goroutine := currentGoroutineId()
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.held[goroutine] = append(t.held[goroutine], key)
+}
+
+// released records that the current goroutine no longer holds the lock for the key
+func (t *lockOrderTracker) released(key string) {
+	if !t.enabled {
+		return
+	}
+
+	goroutine := currentGoroutineId()
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	held := t.held[goroutine]
+	if i := slices.Index(held, key); i >= 0 {
+		held = slices.Delete(held, i, i+1)
+	}
+	if len(held) == 0 {
+		delete(t.held, goroutine)
+		return
+	}
+	t.held[goroutine] = held
+}
+
+func (t *lockOrderTracker) reported() []LockOrderInversion {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return slices.Clone(t.inversions)
+}
+
+// lockType returns the type of the lock for the key - which is the resource type for locks acquired by name
+// (e.g. `azurerm_virtual_network`), or the type of the resource for locks acquired by Resource ID
+// (e.g. `Microsoft.Network/virtualNetworks/subnets`)
+func lockType(key string) string {
+	if !strings.HasPrefix(key, "/") {
+		resourceType, _, _ := strings.Cut(key, ".")
+		return resourceType
+	}
+
+	// Resource IDs alternate between the type and name of each segment, with any Resource Provider namespace
+	// following the (last) `providers` segment
+	segments := strings.Split(strings.Trim(key, "/"), "/")
+	types := make([]string, 0)
+	for i := 0; i < len(segments); i += 2 {
+		if strings.EqualFold(segments[i], "providers") && i+1 < len(segments) {
+			types = []string{segments[i+1]}
+			continue
+		}
+		types = append(types, segments[i])
+	}
+	return strings.Join(types, "/")
+}
+
+// currentGoroutineId returns the ID of the current goroutine, parsed from the header of its stack trace
+// (e.g. `goroutine 123 [running]:`)
+func currentGoroutineId() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+	if i := bytes.IndexByte(buf, ' '); i > 0 {
+		buf = buf[:i]
+	}
+
+	id, _ := strconv.ParseUint(string(buf), 10, 64)
+	return id
+}
+
+func quoteAll(input []string) []string {
+	output := make([]string, 0, len(input))
+	for _, v := range input {
+		output = append(output, strconv.Quote(v))
+	}
+	return output
+}
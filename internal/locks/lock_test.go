@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package locks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestByIDWithContext_Timeout(t *testing.T) {
+	id := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/timeout"
+
+	holder := WithHolder(context.Background(), "azurerm_subnet.example")
+	if err := ByIDWithContext(holder, id); err != nil {
+		t.Fatalf("acquiring lock: %+v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := ByIDWithContext(ctx, id)
+	if err == nil {
+		t.Fatalf("expected an error acquiring a lock which is already held")
+	}
+	if !strings.Contains(err.Error(), "azurerm_subnet.example") {
+		t.Fatalf("expected the error to name the holder of the lock but got: %+v", err)
+	}
+
+	UnlockByID(id)
+
+	// once released, the lock can be acquired - and the holder defaults to the calling function
+	if err := ByIDWithContext(context.Background(), id); err != nil {
+		t.Fatalf("acquiring lock: %+v", err)
+	}
+	if holder := armMutexKV.holder(id).Holder; holder != "locks.TestByIDWithContext_Timeout" {
+		t.Fatalf("expected the holder to be the calling function but got %q", holder)
+	}
+	UnlockByID(id)
+}
+
+func TestMultipleByNameWithContext_ReleasesOnTimeout(t *testing.T) {
+	names := []string{"first", "second", "third"}
+
+	if err := ByNameWithContext(context.Background(), "second", "azurerm_subnet"); err != nil {
+		t.Fatalf("acquiring lock: %+v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := MultipleByNameWithContext(ctx, &names, "azurerm_subnet"); err == nil {
+		t.Fatalf("expected an error acquiring locks when one is already held")
+	}
+
+	// the lock acquired before timing out should have been released
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := ByNameWithContext(ctx, "first", "azurerm_subnet"); err != nil {
+		t.Fatalf("expected the lock for `first` to have been released: %+v", err)
+	}
+
+	UnlockByName("first", "azurerm_subnet")
+	UnlockByName("second", "azurerm_subnet")
+}
+
+func TestLockOrderInversions(t *testing.T) {
+	existing := lockOrder
+	lockOrder = newLockOrderTracker(true)
+	defer func() {
+		lockOrder = existing
+	}()
+
+	mustLock := func(ctx context.Context, name, resourceType string) {
+		if err := ByNameWithContext(ctx, name, resourceType); err != nil {
+			t.Fatalf("acquiring lock: %+v", err)
+		}
+	}
+
+	// a Subnet/Network Security Group association locks the Network Security Group and then the Virtual Network..
+	ctx := WithHolder(context.Background(), "azurerm_subnet_network_security_group_association.example")
+	mustLock(ctx, "example-nsg", "azurerm_network_security_group")
+	mustLock(ctx, "example-vnet", "azurerm_virtual_network")
+	UnlockByName("example-vnet", "azurerm_virtual_network")
+	UnlockByName("example-nsg", "azurerm_network_security_group")
+
+	if inversions := LockOrderInversions(); len(inversions) != 0 {
+		t.Fatalf("expected no lock-order inversions but got %+v", inversions)
+	}
+
+	// .. so another operation locking these in the opposite order can deadlock with it
+	ctx = WithHolder(context.Background(), "azurerm_example.example")
+	mustLock(ctx, "example-vnet", "azurerm_virtual_network")
+	mustLock(ctx, "example-nsg", "azurerm_network_security_group")
+	UnlockByName("example-nsg", "azurerm_network_security_group")
+	UnlockByName("example-vnet", "azurerm_virtual_network")
+
+	inversions := LockOrderInversions()
+	if len(inversions) != 1 {
+		t.Fatalf("expected a single lock-order inversion but got %+v", inversions)
+	}
+	expected := LockOrderInversion{
+		First:          "azurerm_virtual_network.example-vnet",
+		Second:         "azurerm_network_security_group.example-nsg",
+		Holder:         "azurerm_example.example",
+		PreviousHolder: "azurerm_subnet_network_security_group_association.example",
+	}
+	if inversions[0] != expected {
+		t.Fatalf("expected the lock-order inversion %+v but got %+v", expected, inversions[0])
+	}
+
+	// locks held by other goroutines aren't considered
+	done := make(chan struct{})
+	mustLock(ctx, "example-nsg", "azurerm_network_security_group")
+	go func() {
+		defer close(done)
+		mustLock(ctx, "other-vnet", "azurerm_virtual_network")
+		UnlockByName("other-vnet", "azurerm_virtual_network")
+	}()
+	<-done
+	UnlockByName("example-nsg", "azurerm_network_security_group")
+
+	mustLock(ctx, "other-vnet", "azurerm_virtual_network")
+	mustLock(ctx, "example-nsg", "azurerm_network_security_group")
+	UnlockByName("example-nsg", "azurerm_network_security_group")
+	UnlockByName("other-vnet", "azurerm_virtual_network")
+
+	if inversions := LockOrderInversions(); len(inversions) != 1 {
+		t.Fatalf("expected a single lock-order inversion but got %+v", inversions)
+	}
+}
+
+func TestLockOrderInversions_DifferentLocksOfTheSameTypes(t *testing.T) {
+	existing := lockOrder
+	lockOrder = newLockOrderTracker(true)
+	defer func() {
+		lockOrder = existing
+	}()
+
+	mustLock := func(ctx context.Context, name, resourceType string) {
+		if err := ByNameForHolder(ctx, holderFor(ctx), name, resourceType); err != nil {
+			t.Fatalf("acquiring lock: %+v", err)
+		}
+	}
+
+	// the locks acquired by these operations differ, but they would deadlock were they configured to use the
+	// same Network Security Group and Virtual Network
+	ctx := WithHolder(context.Background(), "azurerm_subnet_network_security_group_association.first")
+	mustLock(ctx, "first-nsg", "azurerm_network_security_group")
+	mustLock(ctx, "first-vnet", "azurerm_virtual_network")
+	UnlockByName("first-vnet", "azurerm_virtual_network")
+	UnlockByName("first-nsg", "azurerm_network_security_group")
+
+	ctx = WithHolder(context.Background(), "azurerm_example.second")
+	mustLock(ctx, "second-vnet", "azurerm_virtual_network")
+	mustLock(ctx, "second-nsg", "azurerm_network_security_group")
+	UnlockByName("second-nsg", "azurerm_network_security_group")
+	UnlockByName("second-vnet", "azurerm_virtual_network")
+
+	inversions := LockOrderInversions()
+	if len(inversions) != 1 {
+		t.Fatalf("expected a single lock-order inversion but got %+v", inversions)
+	}
+	expected := LockOrderInversion{
+		First:          "azurerm_virtual_network",
+		Second:         "azurerm_network_security_group",
+		Holder:         "azurerm_example.second",
+		PreviousHolder: "azurerm_subnet_network_security_group_association.first",
+	}
+	if inversions[0] != expected {
+		t.Fatalf("expected the lock-order inversion %+v but got %+v", expected, inversions[0])
+	}
+}
+
+func TestLockType(t *testing.T) {
+	testData := map[string]string{
+		"azurerm_virtual_network.example":                                            "azurerm_virtual_network",
+		"azurerm_subnet.example.internal":                                            "azurerm_subnet",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example": "subscriptions/resourceGroups",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example/subnets/internal": "Microsoft.Network/virtualNetworks/subnets",
+	}
+
+	for input, expected := range testData {
+		if actual := lockType(input); actual != expected {
+			t.Fatalf("expected the lock type for %q to be %q but got %q", input, expected, actual)
+		}
+	}
+}
+
+func TestByNameForHolder_Timeout(t *testing.T) {
+	if err := ByNameForHolder(context.Background(), "azurerm_subnet.first", "example", "azurerm_virtual_network"); err != nil {
+		t.Fatalf("acquiring lock: %+v", err)
+	}
+	defer UnlockByName("example", "azurerm_virtual_network")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := ByNameForHolder(ctx, "azurerm_subnet.second", "example", "azurerm_virtual_network")
+	if err == nil {
+		t.Fatalf("expected an error acquiring a lock which is already held")
+	}
+	if !strings.HasPrefix(err.Error(), "acquiring lock for azurerm_virtual_network.example: ") {
+		t.Fatalf("expected the error to name the lock but got: %+v", err)
+	}
+	if !strings.Contains(err.Error(), "azurerm_subnet.first") {
+		t.Fatalf("expected the error to name the holder of the lock but got: %+v", err)
+	}
+}
+
+type unavailableBackend struct{}
+
+func (unavailableBackend) Lock(context.Context, string) error {
+	return fmt.Errorf("backend is unavailable")
+}
+
+func (unavailableBackend) Unlock(context.Context, string) error {
+	return nil
+}
+
+func TestByNameWithContext_FailsWhenBackendUnavailable(t *testing.T) {
+	SetBackend(unavailableBackend{})
+	defer SetBackend(nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := ByNameWithContext(ctx, "example", "azurerm_virtual_network"); err == nil {
+		t.Fatalf("expected acquiring a lock from an unavailable backend to fail")
+	}
+
+	// the in-process lock shouldn't be held after failing to acquire the lock from the backend
+	SetBackend(nil)
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := ByNameWithContext(ctx, "example", "azurerm_virtual_network"); err != nil {
+		t.Fatalf("expected the in-process lock to have been released: %+v", err)
+	}
+	UnlockByName("example", "azurerm_virtual_network")
+}
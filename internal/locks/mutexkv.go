@@ -4,8 +4,11 @@
 package locks
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"sync"
+	"time"
 )
 
 // mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
@@ -13,31 +16,98 @@ import (
 // keys they must serialize on.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*keyMutex
+}
+
+// keyMutex is a mutex which can be acquired with a context, and which records its current holder
+type keyMutex struct {
+	ch chan struct{}
+
+	// holder is the current holder of the mutex, which is guarded by the lock on the mutexKV
+	holder *LockInfo
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
+	// this can't fail, since the context is never done
+	_ = m.LockWithContext(context.Background(), key, "unknown")
+}
+
+// LockWithContext locks the mutex for the given key, returning an error naming the current
+// holder if the context is done before the lock is acquired. Caller is responsible for calling
+// Unlock for the same key
+func (m *mutexKV) LockWithContext(ctx context.Context, key string, holder string) error {
 	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	mutex := m.get(key)
+	started := time.Now()
+
+	ticker := time.NewTicker(backendDiagnosticsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case mutex.ch <- struct{}{}:
+			m.lock.Lock()
+			mutex.holder = &LockInfo{
+				Key:      key,
+				Holder:   holder,
+				Acquired: time.Now(),
+			}
+			m.lock.Unlock()
+
+			log.Printf("[DEBUG] Locked %q", key)
+			return nil
+
+		case <-ticker.C:
+			log.Printf("[WARN] Waited %s to acquire lock %s - if this lock isn't released this may indicate a deadlock", time.Since(started).Round(time.Second), m.holder(key))
+
+		case <-ctx.Done():
+			return fmt.Errorf("timed out after %s waiting for lock %s: %+v", time.Since(started).Round(time.Second), m.holder(key), ctx.Err())
+		}
+	}
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
+	mutex := m.get(key)
+
+	m.lock.Lock()
+	mutex.holder = nil
+	m.lock.Unlock()
+
+	select {
+	case <-mutex.ch:
+	default:
+		panic(fmt.Sprintf("unlock of unlocked lock %q", key))
+	}
 	log.Printf("[DEBUG] Unlocked %q", key)
 }
 
+// holder returns the current holder of the mutex for the given key, for diagnostic purposes
+func (m *mutexKV) holder(key string) LockInfo {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if mutex, ok := m.store[key]; ok && mutex.holder != nil {
+		return *mutex.holder
+	}
+	return LockInfo{
+		Key:    key,
+		Holder: "unknown",
+	}
+}
+
 // Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+func (m *mutexKV) get(key string) *keyMutex {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	mutex, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
+		mutex = &keyMutex{
+			ch: make(chan struct{}, 1),
+		}
 		m.store[key] = mutex
 	}
 	return mutex
@@ -46,6 +116,6 @@ func (m *mutexKV) get(key string) *sync.Mutex {
 // newMutexKV returns a properly initialized mutexKV
 func newMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*keyMutex),
 	}
 }
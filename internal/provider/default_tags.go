@@ -0,0 +1,412 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	resourceTags "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/tags"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// tagsClient is the subset of the Tags client used to assign the default tags and retain the ignored tags
+type tagsClient interface {
+	GetAtScope(ctx context.Context, id commonids.ScopeId) (resourceTags.GetAtScopeOperationResponse, error)
+	UpdateAtScopeThenPoll(ctx context.Context, id commonids.ScopeId, input resourceTags.TagsPatchResource) error
+}
+
+// tagsClientFor returns the Tags client used to assign the default tags and retain the ignored tags, which is
+// replaced within the tests
+var tagsClientFor = func(client *clients.Client) tagsClient {
+	return client.Resource.TagsClient
+}
+
+func schemaDefaultTags() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configures the tags which should be assigned to all resources supporting tags, in addition to the tags specified on the resource.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"tags": {
+					Type:         pluginsdk.TypeMap,
+					Optional:     true,
+					ValidateFunc: tags.Validate,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+					Description: "The tags which should be assigned to all resources. Tags specified on a resource take precedence over these.",
+				},
+			},
+		},
+	}
+}
+
+func schemaIgnoreTags() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configures the tags which are managed outside of Terraform (for example by Azure Policy) and which should be ignored on all resources.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"keys": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The keys of the tags which should be ignored.",
+				},
+
+				"key_prefixes": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The prefixes of the keys of the tags which should be ignored.",
+				},
+			},
+		},
+	}
+}
+
+func expandDefaultTags(input []interface{}) map[string]string {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	val := input[0].(map[string]interface{})
+	output := make(map[string]string)
+	for k, v := range val["tags"].(map[string]interface{}) {
+		// Validate should have ignored this error already
+		value, _ := tags.TagValueToString(v)
+		output[k] = value
+	}
+
+	return output
+}
+
+func expandIgnoreTags(input []interface{}) tags.IgnoreConfig {
+	output := tags.IgnoreConfig{}
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	val := input[0].(map[string]interface{})
+	for _, v := range val["keys"].([]interface{}) {
+		output.Keys = append(output.Keys, v.(string))
+	}
+	for _, v := range val["key_prefixes"].([]interface{}) {
+		output.KeyPrefixes = append(output.KeyPrefixes, v.(string))
+	}
+
+	return output
+}
+
+// supportsDefaultTags returns whether the default tags can be assigned to the resource, which requires that the
+// resource has a top-level `tags` argument which can be updated
+func supportsDefaultTags(resource *schema.Resource) bool {
+	if _, exists := resource.Schema["tags_all"]; exists {
+		return false
+	}
+
+	v, ok := resource.Schema["tags"]
+	if !ok || v.Type != schema.TypeMap {
+		return false
+	}
+
+	return v.Optional && !v.Computed && !v.ForceNew
+}
+
+// wrapDefaultTags assigns the default tags specified in the provider block to the resource, by merging these into
+// the `tags` used by the CRUD functions of the resource (and so by `tags.Expand` and `tags.FromTypedObject`), whilst
+// hiding these from the `tags` in the state so that they don't show in the diff for the resource. Instead, when default
+// tags are specified, the tags assigned to the resource (excluding those being ignored) are exposed via the computed
+// `tags_all` attribute.
+func wrapDefaultTags(resource *schema.Resource) {
+	resource.Schema["tags_all"] = schemaTagsAll()
+
+	if existing := resource.CustomizeDiff; existing != nil {
+		resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if err := existing(ctx, d, meta); err != nil {
+				return err
+			}
+			return defaultTagsCustomizeDiff(ctx, d, meta)
+		}
+	} else {
+		resource.CustomizeDiff = defaultTagsCustomizeDiff
+	}
+
+	wrapCrudFunctions(resource, func(ctx context.Context, operation string, d *schema.ResourceData, meta interface{}, next func() error) error {
+		client, ok := meta.(*clients.Client)
+		if !ok || client == nil || operation == schema.TimeoutDelete {
+			return next()
+		}
+
+		// the tags specified on the resource, either in the configuration (when creating/updating) or the state (when
+		// reading) - which are used to determine which of the tags assigned to the resource are default tags
+		specifiedTags := d.Get("tags").(map[string]interface{})
+
+		if operation == schema.TimeoutRead {
+			if err := next(); err != nil {
+				return err
+			}
+			if d.Id() == "" {
+				return nil
+			}
+			return setTagsWithoutDefaultTags(d, client, specifiedTags, nil)
+		}
+
+		allTags := tags.MergeDefaultTags(client.DefaultTags, specifiedTags)
+
+		// whether the tags have changed is determined prior to merging in the ignored tags below
+		tagsChanged := d.HasChange("tags")
+
+		var ignoredTags map[string]interface{}
+		if operation == schema.TimeoutUpdate {
+			var err error
+			if allTags, ignoredTags, err = mergeIgnoredTags(ctx, client, d.Id(), allTags); err != nil {
+				return err
+			}
+		}
+
+		if len(client.DefaultTags) > 0 || len(ignoredTags) > 0 {
+			if err := d.Set("tags", allTags); err != nil {
+				return fmt.Errorf("setting `tags`: %+v", err)
+			}
+		}
+
+		if err := next(); err != nil {
+			return err
+		}
+		if d.Id() == "" {
+			return nil
+		}
+
+		// when only the default tags have changed the resource may not update the tags, since there's no change to the
+		// `tags` of the resource, so these are instead assigned to the resource - replacing the existing tags so that
+		// any default tags which are no longer specified are removed
+		var assignedTags map[string]interface{}
+		if operation == schema.TimeoutUpdate && d.HasChange("tags_all") && !tagsChanged {
+			if err := assignTags(ctx, client, d.Id(), allTags); err != nil {
+				return err
+			}
+			assignedTags = allTags
+		}
+
+		return setTagsWithoutDefaultTags(d, client, specifiedTags, assignedTags)
+	})
+}
+
+func schemaTagsAll() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Description: "A mapping of all of the tags assigned to the resource, including those inherited from the `default_tags` specified in the provider block - which is only populated when `default_tags` are specified.",
+	}
+}
+
+// SchemaWithDefaultTags returns the schema for the resource, including the `tags_all` attribute added by wrapDefaultTags
+// when the resource supports the default tags - which allows the documentation for the resource to be checked.
+func SchemaWithDefaultTags(resource *schema.Resource) map[string]*schema.Schema {
+	if !supportsDefaultTags(resource) {
+		return resource.Schema
+	}
+
+	output := make(map[string]*schema.Schema, len(resource.Schema)+1)
+	for k, v := range resource.Schema {
+		output[k] = v
+	}
+	output["tags_all"] = schemaTagsAll()
+	return output
+}
+
+// exposesTags returns whether the resource (or data source) has a top-level `tags` attribute
+func exposesTags(resource *schema.Resource) bool {
+	v, ok := resource.Schema["tags"]
+	return ok && v.Type == schema.TypeMap
+}
+
+// wrapIgnoreTags removes the tags being ignored (as specified in the provider block) from the `tags` of a resource or
+// data source which doesn't support the default tags - since wrapDefaultTags otherwise handles these. When a resource
+// is updated, the ignored tags assigned to the resource are merged back in so that they're retained.
+func wrapIgnoreTags(resource *schema.Resource) {
+	wrapCrudFunctions(resource, func(ctx context.Context, operation string, d *schema.ResourceData, meta interface{}, next func() error) error {
+		client, ok := meta.(*clients.Client)
+		if !ok || client == nil || client.IgnoreTags.IsEmpty() || operation == schema.TimeoutDelete {
+			return next()
+		}
+
+		if operation == schema.TimeoutUpdate {
+			allTags, ignoredTags, err := mergeIgnoredTags(ctx, client, d.Id(), d.Get("tags").(map[string]interface{}))
+			if err != nil {
+				return err
+			}
+			if len(ignoredTags) > 0 {
+				if err := d.Set("tags", allTags); err != nil {
+					return fmt.Errorf("setting `tags`: %+v", err)
+				}
+			}
+		}
+
+		if err := next(); err != nil {
+			return err
+		}
+		if d.Id() == "" {
+			return nil
+		}
+
+		if err := d.Set("tags", client.IgnoreTags.WithoutIgnoredTags(d.Get("tags").(map[string]interface{}))); err != nil {
+			return fmt.Errorf("setting `tags`: %+v", err)
+		}
+
+		return nil
+	})
+}
+
+// setTagsWithoutDefaultTags sets the `tags` to the tags assigned to the resource (that is, set within the Read function)
+// excluding the default tags, and when default tags are specified the `tags_all` to all of these tags - optionally
+// using the tags which have since been assigned to the resource instead
+func setTagsWithoutDefaultTags(d *schema.ResourceData, client *clients.Client, specifiedTags map[string]interface{}, assignedTags map[string]interface{}) error {
+	allTags := d.Get("tags").(map[string]interface{})
+	if assignedTags != nil {
+		allTags = assignedTags
+	}
+	allTags = client.IgnoreTags.WithoutIgnoredTags(allTags)
+
+	if err := d.Set("tags", tags.WithoutDefaultTags(client.DefaultTags, allTags, specifiedTags)); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	// `tags_all` is only populated when default tags are specified, since it'd otherwise be the same as `tags`
+	if len(client.DefaultTags) == 0 {
+		allTags = nil
+	}
+	if err := d.Set("tags_all", allTags); err != nil {
+		return fmt.Errorf("setting `tags_all`: %+v", err)
+	}
+
+	return nil
+}
+
+// assignTags replaces the tags assigned to a Resource Manager resource with the tags - which must include any ignored
+// tags assigned to the resource (see mergeIgnoredTags), since these would otherwise be removed
+func assignTags(ctx context.Context, client *clients.Client, id string, input map[string]interface{}) error {
+	if !strings.HasPrefix(strings.ToLower(id), "/subscriptions/") {
+		log.Printf("[DEBUG] Skipping assigning the tags to %q since it's not a Resource Manager resource", id)
+		return nil
+	}
+
+	tagsToAssign := make(map[string]string, len(input))
+	for k, v := range input {
+		tagsToAssign[k] = v.(string)
+	}
+
+	payload := resourceTags.TagsPatchResource{
+		Operation: pointer.To(resourceTags.TagsPatchOperationReplace),
+		Properties: &resourceTags.Tags{
+			Tags: pointer.To(tagsToAssign),
+		},
+	}
+	if err := tagsClientFor(client).UpdateAtScopeThenPoll(ctx, commonids.NewScopeID(id), payload); err != nil {
+		return fmt.Errorf("assigning the tags to %q: %+v", id, err)
+	}
+
+	return nil
+}
+
+// mergeIgnoredTags merges the ignored tags assigned to a Resource Manager resource into the tags which are being
+// assigned to it, returning the merged tags and the ignored tags which were merged in. The ignored tags aren't present
+// in the state, so this ensures that a resource updating its tags doesn't remove them.
+//
+// Since many resources send their tags on every update (replacing the resource in its entirety), rather than only
+// when the tags have changed, this must be called for every update of a resource.
+func mergeIgnoredTags(ctx context.Context, client *clients.Client, id string, input map[string]interface{}) (map[string]interface{}, map[string]interface{}, error) {
+	existing, err := retrieveIgnoredTags(ctx, client, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	output := make(map[string]interface{}, len(input)+len(existing))
+	for k, v := range input {
+		output[k] = v
+	}
+
+	ignoredTags := make(map[string]interface{})
+	for k, v := range existing {
+		if _, ok := output[k]; !ok {
+			ignoredTags[k] = v
+			output[k] = v
+		}
+	}
+
+	return output, ignoredTags, nil
+}
+
+// retrieveIgnoredTags returns the tags being ignored which are assigned to a Resource Manager resource
+func retrieveIgnoredTags(ctx context.Context, client *clients.Client, id string) (map[string]interface{}, error) {
+	if client.IgnoreTags.IsEmpty() || !strings.HasPrefix(strings.ToLower(id), "/subscriptions/") {
+		return nil, nil
+	}
+
+	resp, err := tagsClientFor(client).GetAtScope(ctx, commonids.NewScopeID(id))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the tags assigned to %q: %+v", id, err)
+	}
+
+	output := make(map[string]interface{})
+	if model := resp.Model; model != nil && model.Properties.Tags != nil {
+		for k, v := range client.IgnoreTags.OnlyIgnoredTags(tags.FromTypedObject(*model.Properties.Tags)) {
+			output[k] = *v
+		}
+	}
+
+	return output, nil
+}
+
+// defaultTagsCustomizeDiff sets `tags_all` to the tags which will be assigned to the resource, so that a change to the
+// default tags within the provider block is shown within the diff for the resource
+func defaultTagsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil {
+		return nil
+	}
+
+	// `tags_all` is only populated when default tags are specified - so is cleared when these are no longer specified
+	if len(client.DefaultTags) == 0 {
+		if len(d.Get("tags_all").(map[string]interface{})) > 0 {
+			return d.SetNew("tags_all", map[string]interface{}{})
+		}
+		return nil
+	}
+
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	allTags := client.IgnoreTags.WithoutIgnoredTags(tags.MergeDefaultTags(client.DefaultTags, d.Get("tags").(map[string]interface{})))
+	if d.HasChange("tags") || !reflect.DeepEqual(allTags, d.Get("tags_all")) {
+		return d.SetNew("tags_all", allTags)
+	}
+
+	return nil
+}
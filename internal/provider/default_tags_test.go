@@ -0,0 +1,545 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	resourceTags "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/tags"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+)
+
+// fakeTagsClient is an in-memory implementation of the Tags client, which assigns the tags to `remoteTags`
+type fakeTagsClient struct {
+	remoteTags map[string]interface{}
+}
+
+func (c fakeTagsClient) GetAtScope(_ context.Context, _ commonids.ScopeId) (resourceTags.GetAtScopeOperationResponse, error) {
+	output := make(map[string]string, len(c.remoteTags))
+	for k, v := range c.remoteTags {
+		output[k] = v.(string)
+	}
+	return resourceTags.GetAtScopeOperationResponse{
+		Model: &resourceTags.TagsResource{
+			Properties: resourceTags.Tags{
+				Tags: pointer.To(output),
+			},
+		},
+	}, nil
+}
+
+func (c fakeTagsClient) UpdateAtScopeThenPoll(_ context.Context, _ commonids.ScopeId, input resourceTags.TagsPatchResource) error {
+	operation := pointer.From(input.Operation)
+	if operation == resourceTags.TagsPatchOperationReplace {
+		for k := range c.remoteTags {
+			delete(c.remoteTags, k)
+		}
+	}
+	for k, v := range pointer.From(input.Properties.Tags) {
+		if operation == resourceTags.TagsPatchOperationDelete {
+			delete(c.remoteTags, k)
+			continue
+		}
+		c.remoteTags[k] = v
+	}
+	return nil
+}
+
+// withFakeTagsClient replaces the Tags client used to assign the default tags and retain the ignored tags with one
+// assigning the tags to `remoteTags` for the duration of the test
+func withFakeTagsClient(t *testing.T, remoteTags map[string]interface{}) {
+	existing := tagsClientFor
+	tagsClientFor = func(*clients.Client) tagsClient {
+		return fakeTagsClient{remoteTags: remoteTags}
+	}
+	t.Cleanup(func() {
+		tagsClientFor = existing
+	})
+}
+
+// testTaggedResource returns a resource storing its tags in `remoteTags`, in the same way as the tags of a resource in
+// Azure - which is used to test the default tags without making requests to Azure. As with the majority of resources,
+// updating the resource replaces it in its entirety (including the tags), regardless of which arguments have changed.
+func testTaggedResource(remoteTags map[string]interface{}) *schema.Resource {
+	read := func(d *schema.ResourceData, _ interface{}) error {
+		return d.Set("tags", remoteTags)
+	}
+	update := func(d *schema.ResourceData, meta interface{}) error {
+		for k := range remoteTags {
+			delete(remoteTags, k)
+		}
+		for k, v := range d.Get("tags").(map[string]interface{}) {
+			remoteTags[k] = v
+		}
+		return read(d, meta)
+	}
+
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			d.SetId("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example")
+			return update(d, meta)
+		},
+		Read:   read,
+		Update: update,
+		Delete: func(*schema.ResourceData, interface{}) error {
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sku_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func TestSupportsDefaultTags(t *testing.T) {
+	testData := []struct {
+		name     string
+		schema   map[string]*schema.Schema
+		expected bool
+	}{
+		{
+			name:     "tags",
+			schema:   map[string]*schema.Schema{"tags": tags.Schema()},
+			expected: true,
+		},
+		{
+			name:     "no tags",
+			schema:   map[string]*schema.Schema{},
+			expected: false,
+		},
+		{
+			name:     "force new tags",
+			schema:   map[string]*schema.Schema{"tags": tags.ForceNewSchema()},
+			expected: false,
+		},
+		{
+			name:     "computed tags",
+			schema:   map[string]*schema.Schema{"tags": tags.SchemaDataSource()},
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		if actual := supportsDefaultTags(&schema.Resource{Schema: v.schema}); actual != v.expected {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func TestDefaultTagsFrameworkTypedResources(t *testing.T) {
+	// Typed Resources exposed via the Plugin Framework should support the default tags in the same way as when they're
+	// exposed via Plugin SDKv2 - which is determined by the (wrapped) Plugin SDKv2 representation of the Resource
+	for _, service := range SupportedTypedServices() {
+		for _, r := range service.Resources() {
+			wrapper := sdk.NewResourceWrapper(r)
+			pluginSdkResource, err := wrapper.Resource()
+			if err != nil {
+				t.Fatalf("building Resource %q: %+v", r.ResourceType(), err)
+			}
+			expected := supportsDefaultTags(pluginSdkResource)
+
+			response := resource.SchemaResponse{}
+			sdk.NewFrameworkResourceWrapper(r, WrapFrameworkTypedResource(service, r.ResourceType()))().Schema(context.TODO(), resource.SchemaRequest{}, &response)
+			if response.Diagnostics.HasError() {
+				t.Fatalf("building Framework Schema for %q: %+v", r.ResourceType(), response.Diagnostics)
+			}
+
+			if _, actual := response.Schema.Attributes["tags_all"]; actual != expected {
+				t.Fatalf("expected the presence of `tags_all` in the Framework Schema for %q to be %t but got %t", r.ResourceType(), expected, actual)
+			}
+		}
+	}
+}
+
+func TestDefaultTags(t *testing.T) {
+	remoteTags := make(map[string]interface{})
+	resource := testTaggedResource(remoteTags)
+	wrapDefaultTags(resource)
+
+	client := &clients.Client{
+		StopContext: context.Background(),
+		DefaultTags: map[string]string{
+			"cost_center": "1234",
+			"owner":       "platform",
+		},
+		IgnoreTags: tags.IgnoreConfig{
+			KeyPrefixes: []string{"hidden-"},
+		},
+	}
+
+	// the default tags are shown in the diff for `tags_all` rather than `tags`
+	config := map[string]interface{}{
+		"tags": map[string]interface{}{
+			"env":   "test",
+			"owner": "networking",
+		},
+	}
+	diff, err := resource.SimpleDiff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatalf("diffing: %+v", err)
+	}
+	if v := diff.Attributes["tags_all.cost_center"]; v == nil || v.New != "1234" {
+		t.Fatalf("expected the diff for `tags_all` to contain the default tags but got %+v", diff.Attributes)
+	}
+	if v := diff.Attributes["tags.cost_center"]; v != nil {
+		t.Fatalf("expected the diff for `tags` not to contain the default tags but got %+v", diff.Attributes)
+	}
+
+	// the default tags are assigned to the resource, where the tags on the resource take precedence
+	d := schema.TestResourceDataRaw(t, resource.Schema, config)
+	if err := resource.Create(d, client); err != nil { //nolint:staticcheck
+		t.Fatalf("creating: %+v", err)
+	}
+	expected := map[string]interface{}{
+		"cost_center": "1234",
+		"env":         "test",
+		"owner":       "networking",
+	}
+	if !reflect.DeepEqual(remoteTags, expected) {
+		t.Fatalf("expected the tags %+v to be assigned but got %+v", expected, remoteTags)
+	}
+	if actual := d.Get("tags_all"); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected `tags_all` to be %+v but got %+v", expected, actual)
+	}
+	expected = map[string]interface{}{
+		"env":   "test",
+		"owner": "networking",
+	}
+	if actual := d.Get("tags"); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expected, actual)
+	}
+
+	// tags assigned outside of Terraform are shown in the state, unless they're ignored
+	remoteTags["hidden-link"] = "example"
+	remoteTags["department"] = "finance"
+	state := d.State()
+	d = resource.Data(state)
+	if err := resource.Read(d, client); err != nil { //nolint:staticcheck
+		t.Fatalf("reading: %+v", err)
+	}
+	expected = map[string]interface{}{
+		"department": "finance",
+		"env":        "test",
+		"owner":      "networking",
+	}
+	if actual := d.Get("tags"); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expected, actual)
+	}
+	expected["cost_center"] = "1234"
+	if actual := d.Get("tags_all"); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected `tags_all` to be %+v but got %+v", expected, actual)
+	}
+
+	// importing the resource excludes the default tags from `tags`
+	d = resource.Data(&terraform.InstanceState{ID: state.ID})
+	if err := resource.Read(d, client); err != nil { //nolint:staticcheck
+		t.Fatalf("importing: %+v", err)
+	}
+	if _, ok := d.Get("tags").(map[string]interface{})["cost_center"]; ok {
+		t.Fatalf("expected `tags` not to contain the default tags but got %+v", d.Get("tags"))
+	}
+}
+
+func TestDefaultTagsNotConfigured(t *testing.T) {
+	remoteTags := make(map[string]interface{})
+	resource := testTaggedResource(remoteTags)
+	wrapDefaultTags(resource)
+
+	client := &clients.Client{
+		StopContext: context.Background(),
+	}
+
+	config := map[string]interface{}{
+		"tags": map[string]interface{}{
+			"env": "test",
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resource.Schema, config)
+	if err := resource.Create(d, client); err != nil { //nolint:staticcheck
+		t.Fatalf("creating: %+v", err)
+	}
+
+	expected := map[string]interface{}{
+		"env": "test",
+	}
+	if !reflect.DeepEqual(remoteTags, expected) {
+		t.Fatalf("expected the tags %+v to be assigned but got %+v", expected, remoteTags)
+	}
+	if actual := d.Get("tags"); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expected, actual)
+	}
+
+	// `tags_all` is only populated when default tags are specified
+	if actual := d.Get("tags_all").(map[string]interface{}); len(actual) != 0 {
+		t.Fatalf("expected `tags_all` to be empty but got %+v", actual)
+	}
+}
+
+func TestDefaultTagsRemoved(t *testing.T) {
+	remoteTags := make(map[string]interface{})
+	withFakeTagsClient(t, remoteTags)
+
+	// this resource only updates the tags when creating the resource, so the default tags are instead assigned
+	// using the Tags client when these change
+	resource := testTaggedResource(remoteTags)
+	read := resource.Read                                                    //nolint:staticcheck
+	resource.Update = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+		return read(d, meta)
+	}
+	wrapDefaultTags(resource)
+
+	client := &clients.Client{
+		StopContext: context.Background(),
+		DefaultTags: map[string]string{
+			"cost_center": "1234",
+			"owner":       "platform",
+		},
+	}
+	config := map[string]interface{}{
+		"tags": map[string]interface{}{
+			"env": "test",
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resource.Schema, config)
+	if err := resource.Create(d, client); err != nil { //nolint:staticcheck
+		t.Fatalf("creating: %+v", err)
+	}
+
+	testData := []struct {
+		name        string
+		defaultTags map[string]string
+		expected    map[string]interface{}
+		tagsAll     map[string]interface{}
+	}{
+		{
+			name: "default tag removed",
+			defaultTags: map[string]string{
+				"cost_center": "1234",
+			},
+			expected: map[string]interface{}{
+				"cost_center": "1234",
+				"env":         "test",
+			},
+			tagsAll: map[string]interface{}{
+				"cost_center": "1234",
+				"env":         "test",
+			},
+		},
+		{
+			name:        "default tags no longer specified",
+			defaultTags: nil,
+			expected: map[string]interface{}{
+				"env": "test",
+			},
+			tagsAll: map[string]interface{}{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		client.DefaultTags = v.defaultTags
+		state := d.State()
+		diff, err := resource.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+		if err != nil {
+			t.Fatalf("diffing: %+v", err)
+		}
+		if _, ok := diff.Attributes["tags.env"]; ok {
+			t.Fatalf("expected the diff not to contain the `tags` but got %+v", diff.Attributes)
+		}
+
+		if d, err = schema.InternalMap(resource.Schema).Data(state, diff); err != nil {
+			t.Fatalf("building data: %+v", err)
+		}
+		if err := resource.Update(d, client); err != nil { //nolint:staticcheck
+			t.Fatalf("updating: %+v", err)
+		}
+
+		if !reflect.DeepEqual(remoteTags, v.expected) {
+			t.Fatalf("expected the tags %+v to be assigned but got %+v", v.expected, remoteTags)
+		}
+		if actual := d.Get("tags_all"); !reflect.DeepEqual(actual, v.tagsAll) {
+			t.Fatalf("expected `tags_all` to be %+v but got %+v", v.tagsAll, actual)
+		}
+		expected := map[string]interface{}{
+			"env": "test",
+		}
+		if actual := d.Get("tags"); !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("expected `tags` to be %+v but got %+v", expected, actual)
+		}
+	}
+}
+
+func TestIgnoreTags(t *testing.T) {
+	remoteTags := map[string]interface{}{
+		"env":         "test",
+		"hidden-link": "example",
+	}
+	resource := testTaggedResource(remoteTags)
+	resource.Schema["tags"] = tags.ForceNewSchema()
+	wrapIgnoreTags(resource)
+
+	// the ignored tags are configured per instance of the Provider (e.g. for each alias)
+	testData := []struct {
+		name     string
+		client   *clients.Client
+		expected map[string]interface{}
+	}{
+		{
+			name: "ignoring tags",
+			client: &clients.Client{
+				StopContext: context.Background(),
+				IgnoreTags: tags.IgnoreConfig{
+					KeyPrefixes: []string{"hidden-"},
+				},
+			},
+			expected: map[string]interface{}{
+				"env": "test",
+			},
+		},
+		{
+			name: "not ignoring tags",
+			client: &clients.Client{
+				StopContext: context.Background(),
+			},
+			expected: map[string]interface{}{
+				"env":         "test",
+				"hidden-link": "example",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		d := resource.Data(&terraform.InstanceState{ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"})
+		if err := resource.Read(d, v.client); err != nil { //nolint:staticcheck
+			t.Fatalf("reading: %+v", err)
+		}
+		if actual := d.Get("tags"); !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected `tags` to be %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestIgnoreTagsRetainedOnUpdateWithoutTagsChange(t *testing.T) {
+	client := &clients.Client{
+		StopContext: context.Background(),
+		IgnoreTags: tags.IgnoreConfig{
+			KeyPrefixes: []string{"hidden-"},
+		},
+	}
+
+	testData := []struct {
+		name string
+		wrap func(*schema.Resource)
+	}{
+		{
+			name: "default tags",
+			wrap: wrapDefaultTags,
+		},
+		{
+			name: "ignore tags",
+			wrap: wrapIgnoreTags,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		remoteTags := map[string]interface{}{
+			"env":         "test",
+			"hidden-link": "example",
+		}
+		withFakeTagsClient(t, remoteTags)
+		resource := testTaggedResource(remoteTags)
+		v.wrap(resource)
+
+		// only the `sku_name` changes, however the resource sends its tags when updating regardless
+		state := &terraform.InstanceState{
+			ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			Attributes: map[string]string{
+				"sku_name":     "Basic",
+				"tags.%":       "1",
+				"tags.env":     "test",
+				"tags_all.%":   "1",
+				"tags_all.env": "test",
+			},
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"sku_name": "Standard",
+			"tags": map[string]interface{}{
+				"env": "test",
+			},
+		})
+		diff, err := resource.SimpleDiff(context.Background(), state, config, client)
+		if err != nil {
+			t.Fatalf("diffing: %+v", err)
+		}
+		if _, ok := diff.Attributes["tags.env"]; ok {
+			t.Fatalf("expected the diff not to contain the `tags` but got %+v", diff.Attributes)
+		}
+
+		d, err := schema.InternalMap(resource.Schema).Data(state, diff)
+		if err != nil {
+			t.Fatalf("building data: %+v", err)
+		}
+		if err := resource.Update(d, client); err != nil { //nolint:staticcheck
+			t.Fatalf("updating: %+v", err)
+		}
+
+		expected := map[string]interface{}{
+			"env":         "test",
+			"hidden-link": "example",
+		}
+		if !reflect.DeepEqual(remoteTags, expected) {
+			t.Fatalf("expected the ignored tags to be retained, with the tags %+v assigned but got %+v", expected, remoteTags)
+		}
+		expected = map[string]interface{}{
+			"env": "test",
+		}
+		if actual := d.Get("tags"); !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("expected `tags` to be %+v but got %+v", expected, actual)
+		}
+	}
+}
+
+func TestMergeIgnoredTagsNotResourceManager(t *testing.T) {
+	client := &clients.Client{
+		StopContext: context.Background(),
+		IgnoreTags: tags.IgnoreConfig{
+			Keys: []string{"hidden-link"},
+		},
+	}
+
+	input := map[string]interface{}{
+		"env": "test",
+	}
+	output, ignored, err := mergeIgnoredTags(context.Background(), client, "/things/example", input)
+	if err != nil {
+		t.Fatalf("merging: %+v", err)
+	}
+	if !reflect.DeepEqual(output, input) {
+		t.Fatalf("expected the tags to be %+v but got %+v", input, output)
+	}
+	if len(ignored) != 0 {
+		t.Fatalf("expected no ignored tags to be merged but got %+v", ignored)
+	}
+}
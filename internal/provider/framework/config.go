@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"time"
 
@@ -127,6 +128,35 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 	p.clientBuilder.DisableTerraformPartnerID = getEnvBoolOrDefault(data.DisableTerraformPartnerId, "ARM_DISABLE_TERRAFORM_PARTNER_ID", false)
 	p.clientBuilder.StorageUseAzureAD = getEnvBoolOrDefault(data.StorageUseAzureAD, "ARM_STORAGE_USE_AZUREAD", false)
 
+	requestsPerSecond, err := getEnvInt64OrDefault(data.SubscriptionRequestsPerSecond, "ARM_SUBSCRIPTION_REQUESTS_PER_SECOND", 0)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("parsing ARM_SUBSCRIPTION_REQUESTS_PER_SECOND", err.Error()))
+		return
+	}
+	if requestsPerSecond < 0 {
+		diags.Append(diag.NewErrorDiagnostic("validating `subscription_requests_per_second`", fmt.Sprintf("expected `subscription_requests_per_second` to be at least 0, got %d", requestsPerSecond)))
+		return
+	}
+	p.clientBuilder.SubscriptionRequestsPerSecond = int(requestsPerSecond)
+
+	if !data.LockBackend.IsNull() && !data.LockBackend.IsUnknown() {
+		var lockBackend []LockBackend
+		d := data.LockBackend.ElementsAs(ctx, &lockBackend, true)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(lockBackend) > 0 {
+			p.clientBuilder.LockBackend = &clients.LockBackend{
+				Type:               lockBackend[0].Type.ValueString(),
+				Path:               lockBackend[0].Path.ValueString(),
+				StorageAccountName: lockBackend[0].StorageAccountName.ValueString(),
+				ContainerName:      lockBackend[0].ContainerName.ValueString(),
+			}
+		}
+	}
+
 	f := providerfeatures.UserFeatures{}
 
 	// features is required, but we'll play safe here
@@ -556,6 +586,39 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 		diags.Append(diag.NewErrorDiagnostic("building resource providers", err.Error()))
 		return
 	}
+	client.RegisterResourceProvidersOnDemand = resourceProviderRegistrationSet == resourceproviders.ProviderRegistrationsOnDemand
+
+	if !data.DefaultTags.IsNull() && !data.DefaultTags.IsUnknown() {
+		var defaultTags []DefaultTags
+		diags.Append(data.DefaultTags.ElementsAs(ctx, &defaultTags, true)...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(defaultTags) > 0 && !defaultTags[0].Tags.IsNull() {
+			client.DefaultTags = make(map[string]string)
+			diags.Append(defaultTags[0].Tags.ElementsAs(ctx, &client.DefaultTags, false)...)
+			if diags.HasError() {
+				return
+			}
+		}
+	}
+
+	if !data.IgnoreTags.IsNull() && !data.IgnoreTags.IsUnknown() {
+		var ignoreTags []IgnoreTags
+		diags.Append(data.IgnoreTags.ElementsAs(ctx, &ignoreTags, true)...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(ignoreTags) > 0 {
+			diags.Append(ignoreTags[0].Keys.ElementsAs(ctx, &client.IgnoreTags.Keys, true)...)
+			diags.Append(ignoreTags[0].KeyPrefixes.ElementsAs(ctx, &client.IgnoreTags.KeyPrefixes, true)...)
+			if diags.HasError() {
+				return
+			}
+		}
+	}
 
 	additionalResourceProvidersToRegister := make([]string, 0)
 	if !data.ResourceProvidersToRegister.IsNull() {
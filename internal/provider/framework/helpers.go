@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -182,6 +183,23 @@ func getEnvBoolOrDefault(val types.Bool, envVar string, def bool) bool {
 	return val.ValueBool()
 }
 
+// getEnvInt64OrDefault returns the value of the types.Int64, or the value of the supplied Environment Variable `envVar`
+// if set - falling back to the defaultValue when neither are set.
+func getEnvInt64OrDefault(val types.Int64, envVar string, defaultValue int64) (int64, error) {
+	if val.IsNull() || val.IsUnknown() {
+		if v := os.Getenv(envVar); v != "" {
+			i, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("parsing the value of %q (%q) as an integer: %+v", envVar, v, err)
+			}
+			return i, nil
+		}
+		return defaultValue, nil
+	}
+
+	return val.ValueInt64(), nil
+}
+
 // getEnvListOfStringsIfAbsent returns a []string for the types.List, or the contents of the supplied Environment
 // Variable `envVar` if set. If the separator is an empty string, then "," will be used as a default.
 func getEnvListOfStringsIfAbsent(val types.List, envVar string, separator string) []string {
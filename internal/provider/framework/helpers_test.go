@@ -289,3 +289,24 @@ func Test_decodeCertificateExpectError(t *testing.T) {
 		t.Fatalf("did not get expected error, got '%v'", err)
 	}
 }
+
+func Test_getEnvInt64OrDefault(t *testing.T) {
+	t.Setenv("ARM_SUBSCRIPTION_REQUESTS_PER_SECOND", "")
+	if result, err := getEnvInt64OrDefault(basetypes.NewInt64Null(), "ARM_SUBSCRIPTION_REQUESTS_PER_SECOND", 5); err != nil || result != 5 {
+		t.Fatalf("expected the default value 5 but got %d (%+v)", result, err)
+	}
+
+	t.Setenv("ARM_SUBSCRIPTION_REQUESTS_PER_SECOND", "20")
+	if result, err := getEnvInt64OrDefault(basetypes.NewInt64Null(), "ARM_SUBSCRIPTION_REQUESTS_PER_SECOND", 5); err != nil || result != 20 {
+		t.Fatalf("expected the value from the environment variable 20 but got %d (%+v)", result, err)
+	}
+
+	if result, err := getEnvInt64OrDefault(basetypes.NewInt64Value(10), "ARM_SUBSCRIPTION_REQUESTS_PER_SECOND", 5); err != nil || result != 10 {
+		t.Fatalf("expected the configured value 10 but got %d (%+v)", result, err)
+	}
+
+	t.Setenv("ARM_SUBSCRIPTION_REQUESTS_PER_SECOND", "lots")
+	if _, err := getEnvInt64OrDefault(basetypes.NewInt64Null(), "ARM_SUBSCRIPTION_REQUESTS_PER_SECOND", 5); err == nil {
+		t.Fatalf("expected an error parsing an invalid value but didn't get one")
+	}
+}
@@ -36,12 +36,48 @@ type ProviderModel struct {
 	DisableCorrelationRequestId    types.Bool   `tfsdk:"disable_correlation_request_id"`
 	DisableTerraformPartnerId      types.Bool   `tfsdk:"disable_terraform_partner_id"`
 	StorageUseAzureAD              types.Bool   `tfsdk:"storage_use_azuread"`
+	SubscriptionRequestsPerSecond  types.Int64  `tfsdk:"subscription_requests_per_second"`
 	Features                       types.List   `tfsdk:"features"`
+	LockBackend                    types.List   `tfsdk:"lock_backend"`
+	DefaultTags                    types.List   `tfsdk:"default_tags"`
+	IgnoreTags                     types.List   `tfsdk:"ignore_tags"`
 	SkipProviderRegistration       types.Bool   `tfsdk:"skip_provider_registration"` // TODO - Remove in 5.0
 	ResourceProviderRegistrations  types.String `tfsdk:"resource_provider_registrations"`
 	ResourceProvidersToRegister    types.List   `tfsdk:"resource_providers_to_register"`
 }
 
+type LockBackend struct {
+	Type               types.String `tfsdk:"type"`
+	Path               types.String `tfsdk:"path"`
+	StorageAccountName types.String `tfsdk:"storage_account_name"`
+	ContainerName      types.String `tfsdk:"container_name"`
+}
+
+var LockBackendAttributes = map[string]attr.Type{
+	"type":                 types.StringType,
+	"path":                 types.StringType,
+	"storage_account_name": types.StringType,
+	"container_name":       types.StringType,
+}
+
+type DefaultTags struct {
+	Tags types.Map `tfsdk:"tags"`
+}
+
+var DefaultTagsAttributes = map[string]attr.Type{
+	"tags": types.MapType{ElemType: types.StringType},
+}
+
+type IgnoreTags struct {
+	Keys        types.List `tfsdk:"keys"`
+	KeyPrefixes types.List `tfsdk:"key_prefixes"`
+}
+
+var IgnoreTagsAttributes = map[string]attr.Type{
+	"keys":         types.ListType{ElemType: types.StringType},
+	"key_prefixes": types.ListType{ElemType: types.StringType},
+}
+
 type Features struct {
 	APIManagement            types.List `tfsdk:"api_management"`
 	AppConfiguration         types.List `tfsdk:"app_configuration"`
@@ -16,9 +16,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	providerfunction "github.com/hashicorp/terraform-provider-azurerm/internal/provider/function"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
 
 	pluginsdkprovider "github.com/hashicorp/terraform-provider-azurerm/internal/provider"
@@ -37,8 +39,12 @@ var _ provider.ProviderWithEphemeralResources = &azureRmFrameworkProvider{}
 
 func (p *azureRmFrameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
+		providerfunction.NewBuildResourceIDFunction,
 		providerfunction.NewNormaliseResourceIDFunction,
 		providerfunction.NewParseResourceIDFunction,
+		providerfunction.NewPlanSubnetCIDRsFunction,
+		providerfunction.NewResourceGroupIDFunction,
+		providerfunction.NewSubnetIDFunction,
 	}
 }
 
@@ -213,6 +219,11 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 				Description: "Should the AzureRM Provider use Azure AD Authentication when accessing the Storage Data Plane APIs?",
 			},
 
+			"subscription_requests_per_second": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of requests per second which should be sent to Azure Resource Manager for each Subscription. Defaults to `0`, meaning requests are only slowed down when the remaining quota returned by Azure Resource Manager is running low.",
+			},
+
 			"resource_provider_registrations": schema.StringAttribute{
 				Optional:    true,
 				Description: "The set of Resource Providers which should be automatically registered for the subscription.",
@@ -223,6 +234,7 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 						resourceproviders.ProviderRegistrationsCore,
 						resourceproviders.ProviderRegistrationsExtended,
 						resourceproviders.ProviderRegistrationsAll,
+						resourceproviders.ProviderRegistrationsOnDemand,
 					),
 				},
 			},
@@ -242,6 +254,80 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 		},
 
 		Blocks: map[string]schema.Block{
+			"lock_backend": schema.ListNestedBlock{
+				Description: "Configures a backend used to share locks between instances of the Provider, so that operations on the same resources are serialized across multiple Terraform runs.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:    true,
+							Description: "The type of lock backend, either `azure_blob` or `file`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									clients.LockBackendTypeAzureBlob,
+									clients.LockBackendTypeFile,
+								),
+							},
+						},
+
+						"path": schema.StringAttribute{
+							Optional:    true,
+							Description: "The directory used to store lock files, when using the `file` lock backend.",
+						},
+
+						"storage_account_name": schema.StringAttribute{
+							Optional:    true,
+							Description: "The name of the Storage Account containing the Blobs used as locks, when using the `azure_blob` lock backend.",
+						},
+
+						"container_name": schema.StringAttribute{
+							Optional:    true,
+							Description: "The name of the Storage Container containing the Blobs used as locks, when using the `azure_blob` lock backend.",
+						},
+					},
+				},
+			},
+
+			"default_tags": schema.ListNestedBlock{
+				Description: "Configures the tags which should be assigned to all resources supporting tags, in addition to the tags specified on the resource.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "The tags which should be assigned to all resources. Tags specified on a resource take precedence over these.",
+						},
+					},
+				},
+			},
+
+			"ignore_tags": schema.ListNestedBlock{
+				Description: "Configures the tags which are managed outside of Terraform (for example by Azure Policy) and which should be ignored on all resources.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"keys": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "The keys of the tags which should be ignored.",
+						},
+
+						"key_prefixes": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "The prefixes of the keys of the tags which should be ignored.",
+						},
+					},
+				},
+			},
+
 			"features": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeBetween(1, 1),
@@ -558,6 +644,14 @@ func (p *azureRmFrameworkProvider) Resources(_ context.Context) []func() resourc
 		output = append(output, service.FrameworkResources()...)
 	}
 
+	if features.TypedResourcesViaFramework() {
+		for _, service := range pluginsdkprovider.SupportedTypedServices() {
+			for _, r := range service.Resources() {
+				output = append(output, sdk.NewFrameworkResourceWrapper(r, pluginsdkprovider.WrapFrameworkTypedResource(service, r.ResourceType())))
+			}
+		}
+	}
+
 	return output
 }
 
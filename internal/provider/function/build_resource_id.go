@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BuildResourceIDFunction struct{}
+
+var _ function.Function = BuildResourceIDFunction{}
+
+var parentResourceAttributeTypes = map[string]attr.Type{
+	"type": types.StringType,
+	"name": types.StringType,
+}
+
+type buildResourceIdSegment struct {
+	argument int64
+	name     string
+	value    string
+}
+
+type parentResource struct {
+	Type string `tfsdk:"type"`
+	Name string `tfsdk:"name"`
+}
+
+func NewBuildResourceIDFunction() function.Function {
+	return &BuildResourceIDFunction{}
+}
+
+func (b BuildResourceIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "build_resource_id"
+}
+
+func (b BuildResourceIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "build_resource_id",
+		Description:         "Builds an Azure Resource Manager ID from its component parts, normalising the casing where the type of Resource ID is known",
+		MarkdownDescription: "Builds an Azure Resource Manager ID from its component parts, normalising the casing where the type of Resource ID is known",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "subscription_id",
+				Description:         "Subscription ID",
+				MarkdownDescription: "Subscription ID",
+			},
+			function.StringParameter{
+				Name:                "resource_group_name",
+				Description:         "Resource Group Name",
+				MarkdownDescription: "Resource Group Name",
+			},
+			function.StringParameter{
+				Name:                "resource_provider",
+				Description:         "Resource Provider Namespace, e.g. `Microsoft.Network`",
+				MarkdownDescription: "Resource Provider Namespace, e.g. `Microsoft.Network`",
+			},
+			function.ListParameter{
+				Name:                "parent_resources",
+				Description:         "An ordered list of the Parent Resources, each containing a `type` and a `name`",
+				MarkdownDescription: "An ordered list of the Parent Resources, each containing a `type` and a `name`",
+				ElementType: types.ObjectType{
+					AttrTypes: parentResourceAttributeTypes,
+				},
+			},
+			function.StringParameter{
+				Name:                "resource_type",
+				Description:         "Resource Type, e.g. `subnets`",
+				MarkdownDescription: "Resource Type, e.g. `subnets`",
+			},
+			function.StringParameter{
+				Name:                "resource_name",
+				Description:         "Resource Name",
+				MarkdownDescription: "Resource Name",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (b BuildResourceIDFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var subscriptionId, resourceGroupName, resourceProvider, resourceType, resourceName string
+	var parentResources []parentResource
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &subscriptionId, &resourceGroupName, &resourceProvider, &parentResources, &resourceType, &resourceName))
+
+	if response.Error != nil {
+		return
+	}
+
+	segments := []buildResourceIdSegment{
+		{argument: 0, name: "subscription_id", value: subscriptionId},
+		{argument: 1, name: "resource_group_name", value: resourceGroupName},
+		{argument: 2, name: "resource_provider", value: resourceProvider},
+	}
+	for i, v := range parentResources {
+		segments = append(segments,
+			buildResourceIdSegment{argument: 3, name: fmt.Sprintf("parent_resources.%d.type", i), value: v.Type},
+			buildResourceIdSegment{argument: 3, name: fmt.Sprintf("parent_resources.%d.name", i), value: v.Name},
+		)
+	}
+	segments = append(segments,
+		buildResourceIdSegment{argument: 4, name: "resource_type", value: resourceType},
+		buildResourceIdSegment{argument: 5, name: "resource_name", value: resourceName},
+	)
+	for _, v := range segments {
+		if err := validateResourceIdSegment(v.name, v.value); err != nil {
+			response.Error = function.NewArgumentFuncError(v.argument, err.Error())
+			return
+		}
+	}
+
+	id := commonids.NewResourceGroupID(subscriptionId, resourceGroupName).ID()
+	id += fmt.Sprintf("/providers/%s", resourceProvider)
+	for _, v := range parentResources {
+		id += fmt.Sprintf("/%s/%s", v.Type, v.Name)
+	}
+	id += fmt.Sprintf("/%s/%s", resourceType, resourceName)
+
+	result, err := normaliseBuiltResourceId(id)
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionBuildResourceID_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testBuildResourceIdOutput(),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("no_parents", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1"),
+					acceptance.TestCheckOutput("with_parents", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/gateways/gateway1/hostnameConfigurations/config1"),
+					acceptance.TestCheckOutput("unknown_type", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Contoso.Example/widgets/widget1"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionBuildResourceID_invalidSegment(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "azurerm" {
+  features {}
+}
+
+output "test" {
+  value = provider::azurerm::build_resource_id("12345678-1234-9876-4563-123456789012", "resGroup1", "Microsoft.Web", [], "sites", "site/1")
+}
+`,
+				ExpectError: regexp.MustCompile("`resource_name` cannot contain a `/`"),
+			},
+		},
+	})
+}
+
+func testBuildResourceIdOutput() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+output "no_parents" {
+  value = provider::azurerm::build_resource_id("12345678-1234-9876-4563-123456789012", "resGroup1", "microsoft.web", [], "SITES", "site1")
+}
+
+output "with_parents" {
+  value = provider::azurerm::build_resource_id("12345678-1234-9876-4563-123456789012", "resGroup1", "Microsoft.ApiManagement", [
+    {
+      type = "service"
+      name = "service1"
+    },
+    {
+      type = "gateways"
+      name = "gateway1"
+    },
+  ], "hostnameconfigurations", "config1")
+}
+
+output "unknown_type" {
+  value = provider::azurerm::build_resource_id("12345678-1234-9876-4563-123456789012", "resGroup1", "Contoso.Example", [], "widgets", "widget1")
+}
+`
+}
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"net"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+)
+
+const (
+	// subnetReservedAddresses is the number of addresses Azure reserves within each Subnet
+	// (the network address, the default gateway, two addresses for Azure DNS and the broadcast address)
+	subnetReservedAddresses = 5
+
+	// subnetMaximumPrefixLength is the smallest Subnet supported by Azure
+	subnetMaximumPrefixLength = 29
+)
+
+// subnetMaximumPrefixLengthByName contains the minimum sizes for the Subnets which are used by specific Azure Services
+var subnetMaximumPrefixLengthByName = map[string]int{
+	"AzureBastionSubnet":            26,
+	"AzureFirewallManagementSubnet": 26,
+	"AzureFirewallSubnet":           26,
+	"GatewaySubnet":                 27,
+	"RouteServerSubnet":             27,
+}
+
+type PlanSubnetCIDRsFunction struct{}
+
+var _ function.Function = PlanSubnetCIDRsFunction{}
+
+var subnetRequestAttributeTypes = map[string]attr.Type{
+	"name": types.StringType,
+	"size": types.Int64Type,
+}
+
+type subnetRequest struct {
+	Name string `tfsdk:"name"`
+	Size int64  `tfsdk:"size"`
+}
+
+func NewPlanSubnetCIDRsFunction() function.Function {
+	return &PlanSubnetCIDRsFunction{}
+}
+
+func (p PlanSubnetCIDRsFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "plan_subnet_cidrs"
+}
+
+func (p PlanSubnetCIDRsFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "plan_subnet_cidrs",
+		Description:         "Allocates non-overlapping CIDR ranges for the named Subnets within the Address Space of a Virtual Network",
+		MarkdownDescription: "Allocates non-overlapping CIDR ranges for the named Subnets within the Address Space of a Virtual Network",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "address_space",
+				Description:         "The IPv4 Address Space of the Virtual Network",
+				MarkdownDescription: "The IPv4 Address Space of the Virtual Network",
+				ElementType:         types.StringType,
+			},
+			function.ListParameter{
+				Name:                "subnets",
+				Description:         "A list of Subnets, each containing a `name` and the `size` (the number of usable IP Addresses required)",
+				MarkdownDescription: "A list of Subnets, each containing a `name` and the `size` (the number of usable IP Addresses required)",
+				ElementType: types.ObjectType{
+					AttrTypes: subnetRequestAttributeTypes,
+				},
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (p PlanSubnetCIDRsFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var addressSpace []string
+	var subnets []subnetRequest
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &addressSpace, &subnets))
+
+	if response.Error != nil {
+		return
+	}
+
+	result, err := planSubnetCIDRs(addressSpace, subnets)
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
+
+type addressRange struct {
+	start uint32
+	end   uint32
+}
+
+// planSubnetCIDRs allocates a CIDR range for each of the Subnets within the Address Space.
+//
+// Subnets are allocated largest first, each aligned to its own size, which means that the next available
+// address within each Address Space is always correctly aligned for the next Subnet - and that the Subnets
+// are packed without any gaps.
+func planSubnetCIDRs(addressSpace []string, subnets []subnetRequest) (map[string]string, error) {
+	if len(addressSpace) == 0 {
+		return nil, fmt.Errorf("`address_space` must contain at least one CIDR range")
+	}
+
+	ranges := make([]addressRange, 0)
+	for _, v := range addressSpace {
+		if _, errs := validate.CIDR(v, "address_space"); len(errs) > 0 {
+			return nil, errs[0]
+		}
+
+		ip, network, err := net.ParseCIDR(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `address_space` %q: %+v", v, err)
+		}
+		if !ip.Equal(network.IP) {
+			return nil, fmt.Errorf("`address_space` %q must be a network address, such as %q", v, network.String())
+		}
+
+		ones, _ := network.Mask.Size()
+		start := binary.BigEndian.Uint32(network.IP.To4())
+		r := addressRange{
+			start: start,
+			end:   start + uint32((uint64(1)<<(32-ones))-1),
+		}
+		for _, existing := range ranges {
+			if r.start <= existing.end && existing.start <= r.end {
+				return nil, fmt.Errorf("`address_space` %q overlaps with another CIDR range in the `address_space`", v)
+			}
+		}
+		ranges = append(ranges, r)
+	}
+
+	type allocation struct {
+		name         string
+		prefixLength int
+	}
+	allocations := make([]allocation, 0)
+	names := make(map[string]struct{})
+	for _, v := range subnets {
+		if v.Name == "" {
+			return nil, fmt.Errorf("the `name` for a Subnet cannot be empty")
+		}
+		if _, exists := names[v.Name]; exists {
+			return nil, fmt.Errorf("the Subnet %q is specified more than once", v.Name)
+		}
+		names[v.Name] = struct{}{}
+
+		prefixLength, err := subnetPrefixLength(v.Name, v.Size)
+		if err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, allocation{
+			name:         v.Name,
+			prefixLength: prefixLength,
+		})
+	}
+
+	sort.SliceStable(allocations, func(i, j int) bool {
+		return allocations[i].prefixLength < allocations[j].prefixLength
+	})
+
+	// next contains the next available address within each Address Space, as an offset from the start
+	next := make([]uint64, len(ranges))
+	output := make(map[string]string)
+	for _, v := range allocations {
+		blockSize := uint64(1) << (32 - v.prefixLength)
+
+		allocated := false
+		for i, r := range ranges {
+			if next[i]+blockSize > uint64(r.end-r.start)+1 {
+				continue
+			}
+
+			ip := make(net.IP, net.IPv4len)
+			binary.BigEndian.PutUint32(ip, r.start+uint32(next[i]))
+			output[v.name] = fmt.Sprintf("%s/%d", ip.String(), v.prefixLength)
+
+			next[i] += blockSize
+			allocated = true
+			break
+		}
+
+		if !allocated {
+			return nil, fmt.Errorf("there isn't enough space remaining in the `address_space` to allocate a /%d for the Subnet %q", v.prefixLength, v.name)
+		}
+	}
+
+	return output, nil
+}
+
+// subnetPrefixLength returns the prefix length for the smallest Subnet which contains the number of usable
+// IP Addresses requested, taking into account the addresses reserved by Azure and the minimum sizes
+func subnetPrefixLength(name string, size int64) (int, error) {
+	if size < 1 {
+		return 0, fmt.Errorf("the `size` for the Subnet %q must be at least 1, got %d", name, size)
+	}
+
+	required := uint64(size) + subnetReservedAddresses
+	if required > uint64(1)<<32 {
+		return 0, fmt.Errorf("the `size` for the Subnet %q is too large, got %d", name, size)
+	}
+
+	// the number of host bits needed to contain the required number of addresses
+	hostBits := bits.Len64(required - 1)
+	prefixLength := min(32-hostBits, subnetMaximumPrefixLength)
+	if v, ok := subnetMaximumPrefixLengthByName[name]; ok {
+		prefixLength = min(prefixLength, v)
+	}
+
+	return prefixLength, nil
+}
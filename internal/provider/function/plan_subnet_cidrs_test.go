@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPlanSubnetCIDRs(t *testing.T) {
+	testData := []struct {
+		name         string
+		addressSpace []string
+		subnets      []subnetRequest
+		expected     map[string]string
+		shouldError  bool
+	}{
+		{
+			name:         "no address space",
+			addressSpace: []string{},
+			subnets: []subnetRequest{
+				{Name: "example", Size: 10},
+			},
+			shouldError: true,
+		},
+		{
+			name:         "invalid address space",
+			addressSpace: []string{"10.0.0.0/33"},
+			shouldError:  true,
+		},
+		{
+			name:         "address space isn't a network address",
+			addressSpace: []string{"10.0.0.1/16"},
+			shouldError:  true,
+		},
+		{
+			name:         "overlapping address spaces",
+			addressSpace: []string{"10.0.0.0/16", "10.0.1.0/24"},
+			shouldError:  true,
+		},
+		{
+			name:         "no subnets",
+			addressSpace: []string{"10.0.0.0/16"},
+			subnets:      []subnetRequest{},
+			expected:     map[string]string{},
+		},
+		{
+			name:         "duplicate subnet names",
+			addressSpace: []string{"10.0.0.0/16"},
+			subnets: []subnetRequest{
+				{Name: "example", Size: 10},
+				{Name: "example", Size: 20},
+			},
+			shouldError: true,
+		},
+		{
+			name:         "invalid size",
+			addressSpace: []string{"10.0.0.0/16"},
+			subnets: []subnetRequest{
+				{Name: "example", Size: 0},
+			},
+			shouldError: true,
+		},
+		{
+			name:         "smallest subnet is a /29",
+			addressSpace: []string{"10.0.0.0/24"},
+			subnets: []subnetRequest{
+				{Name: "example", Size: 1},
+			},
+			expected: map[string]string{
+				"example": "10.0.0.0/29",
+			},
+		},
+		{
+			name:         "reserved addresses are taken into account",
+			addressSpace: []string{"10.0.0.0/24"},
+			subnets: []subnetRequest{
+				// a /27 contains 32 addresses, of which 27 are usable
+				{Name: "fits", Size: 27},
+				{Name: "doesnt-fit", Size: 28},
+			},
+			expected: map[string]string{
+				"doesnt-fit": "10.0.0.0/26",
+				"fits":       "10.0.0.64/27",
+			},
+		},
+		{
+			name:         "service subnets have a minimum size",
+			addressSpace: []string{"10.0.0.0/24"},
+			subnets: []subnetRequest{
+				{Name: "GatewaySubnet", Size: 1},
+				{Name: "AzureFirewallSubnet", Size: 1},
+				{Name: "workload", Size: 1},
+			},
+			expected: map[string]string{
+				"AzureFirewallSubnet": "10.0.0.0/26",
+				"GatewaySubnet":       "10.0.0.64/27",
+				"workload":            "10.0.0.96/29",
+			},
+		},
+		{
+			name:         "subnets are allocated largest first",
+			addressSpace: []string{"10.0.0.0/22"},
+			subnets: []subnetRequest{
+				{Name: "small", Size: 3},
+				{Name: "large", Size: 500},
+				{Name: "medium", Size: 100},
+				{Name: "medium2", Size: 100},
+			},
+			expected: map[string]string{
+				"large":   "10.0.0.0/23",
+				"medium":  "10.0.2.0/25",
+				"medium2": "10.0.2.128/25",
+				"small":   "10.0.3.0/29",
+			},
+		},
+		{
+			name:         "spills into the next address space",
+			addressSpace: []string{"10.0.0.0/24", "192.168.0.0/24"},
+			subnets: []subnetRequest{
+				{Name: "first", Size: 200},
+				{Name: "second", Size: 100},
+			},
+			expected: map[string]string{
+				"first":  "10.0.0.0/24",
+				"second": "192.168.0.0/25",
+			},
+		},
+		{
+			name:         "not enough space",
+			addressSpace: []string{"10.0.0.0/24"},
+			subnets: []subnetRequest{
+				{Name: "first", Size: 200},
+				{Name: "second", Size: 1},
+			},
+			shouldError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual, err := planSubnetCIDRs(v.addressSpace, v.subnets)
+		if err != nil {
+			if v.shouldError {
+				continue
+			}
+
+			t.Fatalf("unexpected error for %q: %+v", v.name, err)
+		}
+		if v.shouldError {
+			t.Fatalf("expected an error for %q but didn't get one", v.name)
+		}
+
+		if !reflect.DeepEqual(v.expected, actual) {
+			t.Fatalf("expected %+v but got %+v for %q", v.expected, actual, v.name)
+		}
+	}
+}
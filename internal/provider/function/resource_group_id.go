@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type ResourceGroupIDFunction struct{}
+
+var _ function.Function = ResourceGroupIDFunction{}
+
+func NewResourceGroupIDFunction() function.Function {
+	return &ResourceGroupIDFunction{}
+}
+
+func (r ResourceGroupIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "resource_group_id"
+}
+
+func (r ResourceGroupIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "resource_group_id",
+		Description:         "Builds the Resource ID for a Resource Group within the specified Subscription",
+		MarkdownDescription: "Builds the Resource ID for a Resource Group within the specified Subscription",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "subscription_id",
+				Description:         "Subscription ID",
+				MarkdownDescription: "Subscription ID",
+			},
+			function.StringParameter{
+				Name:                "name",
+				Description:         "Resource Group Name",
+				MarkdownDescription: "Resource Group Name",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (r ResourceGroupIDFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var subscriptionId, name string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &subscriptionId, &name))
+
+	if response.Error != nil {
+		return
+	}
+
+	if _, err := uuid.ParseUUID(subscriptionId); err != nil {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("expected `subscription_id` to be a UUID, got %q", subscriptionId))
+		return
+	}
+
+	if _, errs := resourcegroups.ValidateName(name, "name"); len(errs) > 0 {
+		response.Error = function.NewArgumentFuncError(1, errs[0].Error())
+		return
+	}
+
+	id := commonids.NewResourceGroupID(subscriptionId, name)
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, id.ID()))
+}
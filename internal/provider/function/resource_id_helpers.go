@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// normaliseBuiltResourceId validates a Resource ID built by one of the provider functions and returns it in
+// the correct casing - where the type of Resource ID is known this is parsed to ensure it's valid, otherwise
+// the casing of the common segments is corrected on a best-effort basis.
+func normaliseBuiltResourceId(id string) (string, error) {
+	idType := recaser.ResourceIdTypeFromResourceId(id)
+	if idType == nil {
+		return recaser.ReCase(id), nil
+	}
+
+	parser := resourceids.NewParserFromResourceIdType(idType)
+	parsed, err := parser.Parse(id, true)
+	if err != nil {
+		return "", fmt.Errorf("validating Resource ID %q: %+v", id, err)
+	}
+
+	if err := idType.FromParseResult(*parsed); err != nil {
+		return "", fmt.Errorf("validating Resource ID %q: %+v", id, err)
+	}
+
+	return idType.ID(), nil
+}
+
+// validateResourceIdSegment validates that a user specified value can be used as a segment within a Resource ID
+func validateResourceIdSegment(name, value string) error {
+	if value == "" {
+		return fmt.Errorf("`%s` cannot be empty", name)
+	}
+
+	if strings.Contains(value, "/") {
+		return fmt.Errorf("`%s` cannot contain a `/`, got %q", name, value)
+	}
+
+	return nil
+}
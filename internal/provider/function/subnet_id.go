@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type SubnetIDFunction struct{}
+
+var _ function.Function = SubnetIDFunction{}
+
+func NewSubnetIDFunction() function.Function {
+	return &SubnetIDFunction{}
+}
+
+func (s SubnetIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "subnet_id"
+}
+
+func (s SubnetIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "subnet_id",
+		Description:         "Builds the Resource ID for a Subnet within the specified Virtual Network",
+		MarkdownDescription: "Builds the Resource ID for a Subnet within the specified Virtual Network",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "virtual_network_id",
+				Description:         "Virtual Network Resource ID",
+				MarkdownDescription: "Virtual Network Resource ID",
+			},
+			function.StringParameter{
+				Name:                "name",
+				Description:         "Subnet Name",
+				MarkdownDescription: "Subnet Name",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (s SubnetIDFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var virtualNetworkId, name string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &virtualNetworkId, &name))
+
+	if response.Error != nil {
+		return
+	}
+
+	vnetId, err := commonids.ParseVirtualNetworkIDInsensitively(virtualNetworkId)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("parsing Virtual Network ID: %s", err))
+		return
+	}
+
+	if err := validateResourceIdSegment("name", name); err != nil {
+		response.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	id := commonids.NewSubnetID(vnetId.SubscriptionId, vnetId.ResourceGroupName, vnetId.VirtualNetworkName, name)
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, id.ID()))
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionSubnetID_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testSubnetIdOutput("/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/resGroup1/providers/Microsoft.Network/VIRTUALNETWORKS/network1", "subnet1"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("test", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionSubnetID_invalidVirtualNetworkId(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testSubnetIdOutput("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1", "subnet1"),
+				ExpectError: regexp.MustCompile("parsing Virtual Network ID"),
+			},
+		},
+	})
+}
+
+func testSubnetIdOutput(virtualNetworkId, name string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+output "test" {
+  value = provider::azurerm::subnet_id("%s", "%s")
+}
+`, virtualNetworkId, name)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func schemaLockBackend() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configures a backend used to share locks between instances of the Provider, so that operations on the same resources are serialized across multiple Terraform runs.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"type": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						clients.LockBackendTypeAzureBlob,
+						clients.LockBackendTypeFile,
+					}, false),
+					Description: "The type of lock backend, either `azure_blob` or `file`.",
+				},
+
+				"path": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The directory used to store lock files, when using the `file` lock backend.",
+				},
+
+				"storage_account_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Storage Account containing the Blobs used as locks, when using the `azure_blob` lock backend.",
+				},
+
+				"container_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Storage Container containing the Blobs used as locks, when using the `azure_blob` lock backend.",
+				},
+			},
+		},
+	}
+}
+
+func expandLockBackend(input []interface{}) *clients.LockBackend {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	val := input[0].(map[string]interface{})
+	return &clients.LockBackend{
+		Type:               val["type"].(string),
+		Path:               val["path"].(string),
+		StorageAccountName: val["storage_account_name"].(string),
+		ContainerName:      val["container_name"].(string),
+	}
+}
@@ -17,8 +17,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -79,7 +81,26 @@ func ValidatePartnerID(i interface{}, k string) ([]string, []error) {
 	}
 }
 
+// WrapFrameworkTypedResource returns a function which applies the same wrappers to a Typed Resource exposed via the
+// Plugin Framework (see `sdk.NewFrameworkResourceWrapper`) as are applied to the Resources exposed via Plugin SDKv2 -
+// that is registering the Resource Providers used by the Resource on-demand, assigning the `default_tags` and removing
+// the tags specified in `ignore_tags`.
+func WrapFrameworkTypedResource(service sdk.TypedServiceRegistration, resourceType string) func(*schema.Resource) {
+	return func(resource *schema.Resource) {
+		registerResourceProvidersOnDemand(resource, resourceProvidersForResource(service, resourceType))
+		if supportsDefaultTags(resource) {
+			wrapDefaultTags(resource)
+		} else if exposesTags(resource) {
+			wrapIgnoreTags(resource)
+		}
+	}
+}
+
 func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
+	// the Resource ID types used by Typed Resources must be registered prior to building these, so that a Resource
+	// Identity can be generated for the Typed Resources which don't define one
+	registerResourceIdentities()
+
 	dataSources := make(map[string]*schema.Resource)
 	resources := make(map[string]*schema.Resource)
 
@@ -101,6 +122,11 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 			dataSources[key] = dataSource
 		}
 
+		if features.TypedResourcesViaFramework() {
+			// these are instead registered within the Framework Provider (see WrapFrameworkTypedResource)
+			continue
+		}
+
 		logEntry("[DEBUG] Registering Resources for %q..", service.Name())
 		for _, r := range service.Resources() {
 			key := r.ResourceType()
@@ -113,11 +139,13 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 			if err != nil {
 				panic(fmt.Errorf("creating Wrapper for Resource %q: %+v", key, err))
 			}
+			registerResourceProvidersOnDemand(resource, resourceProvidersForResource(service, key))
 			resources[key] = resource
 		}
 	}
 
 	// then handle the untyped services
+	untypedIdentities := untypedResourceIdentities()
 	for _, service := range SupportedUntypedServices() {
 		logEntry("[DEBUG] Registering Data Sources for %q..", service.Name())
 		for k, v := range service.SupportedDataSources() {
@@ -134,10 +162,30 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				panic(fmt.Sprintf("An existing Resource exists for %q", k))
 			}
 
+			registerResourceProvidersOnDemand(v, resourceProvidersForResource(service, k))
+			// Resources which don't define a Resource Identity are given one when the type of Resource ID is known
+			if id, ok := untypedIdentities[k]; ok && v.Identity == nil {
+				pluginsdk.WithResourceIdentity(v, id)
+			}
 			resources[k] = v
 		}
 	}
 
+	// then assign the default tags to the resources supporting tags, and remove the ignored tags from the remaining
+	// resources and data sources exposing tags
+	for _, resource := range resources {
+		if supportsDefaultTags(resource) {
+			wrapDefaultTags(resource)
+		} else if exposesTags(resource) {
+			wrapIgnoreTags(resource)
+		}
+	}
+	for _, dataSource := range dataSources {
+		if exposesTags(dataSource) {
+			wrapIgnoreTags(dataSource)
+		}
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -335,6 +383,12 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"lock_backend": schemaLockBackend(),
+
+			"default_tags": schemaDefaultTags(),
+
+			"ignore_tags": schemaIgnoreTags(),
+
 			// Advanced feature flags
 			"resource_provider_registrations": {
 				Type:        schema.TypeString,
@@ -347,6 +401,7 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 					resourceproviders.ProviderRegistrationsAll,
 					resourceproviders.ProviderRegistrationsNone,
 					resourceproviders.ProviderRegistrationsLegacy,
+					resourceproviders.ProviderRegistrationsOnDemand,
 				}, false),
 			},
 
@@ -375,6 +430,14 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_AZUREAD", false),
 				Description: "Should the AzureRM Provider use Azure AD Authentication when accessing the Storage Data Plane APIs?",
 			},
+
+			"subscription_requests_per_second": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_SUBSCRIPTION_REQUESTS_PER_SECOND", 0),
+				Description:  "The maximum number of requests per second which should be sent to Azure Resource Manager for each Subscription. Defaults to `0`, meaning requests are only slowed down when the remaining quota returned by Azure Resource Manager is running low.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 
 		DataSourcesMap: dataSources,
@@ -527,6 +590,7 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 		DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 		Features:                    expandFeatures(d.Get("features").([]interface{})),
+		LockBackend:                 expandLockBackend(d.Get("lock_backend").([]interface{})),
 		MetadataHost:                d.Get("metadata_host").(string),
 		PartnerID:                   d.Get("partner_id").(string),
 		RegisteredResourceProviders: requiredResourceProviders,
@@ -534,6 +598,8 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		SubscriptionID:              d.Get("subscription_id").(string),
 		TerraformVersion:            p.TerraformVersion,
 
+		SubscriptionRequestsPerSecond: d.Get("subscription_requests_per_second").(int),
+
 		// this field is intentionally not exposed in the provider block, since it's only used for
 		// platform level tracing
 		CustomCorrelationRequestID: os.Getenv("ARM_CORRELATION_REQUEST_ID"),
@@ -551,6 +617,9 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 	}
 
 	client.StopContext = stopCtx
+	client.RegisterResourceProvidersOnDemand = providerRegistrations == resourceproviders.ProviderRegistrationsOnDemand
+	client.DefaultTags = expandDefaultTags(d.Get("default_tags").([]interface{}))
+	client.IgnoreTags = expandIgnoreTags(d.Get("ignore_tags").([]interface{}))
 
 	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
 
@@ -0,0 +1,1041 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/aadb2c/2021-04-01-preview/tenants"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2019-06-01/smartdetectoralertrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2021-08-08/alertprocessingrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2023-03-01/prometheusrulegroups"
+	servers_2017_08_01 "github.com/hashicorp/go-azure-sdk/resource-manager/analysisservices/2017-08-01/servers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/api"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apidiagnostic"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apioperation"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apioperationpolicy"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apioperationtag"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apipolicy"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apirelease"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apischema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apitag"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apitagdescription"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apiversionset"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/authorizationserver"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/backend"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/cache"
+	certificate_2022_08_01 "github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/certificate"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/diagnostic"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/gateway"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/gatewayapi"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/gatewaycertificateauthority"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/gatewayhostnameconfiguration"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/group"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/groupuser"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/logger"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/namedvalue"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/openidconnectprovider"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/policy"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/product"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/productapi"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/productgroup"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/productpolicy"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/producttag"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/schema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/subscription"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/tag"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/user"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/apimanagementservice"
+	workspace_2024_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/workspace"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2024-05-01/configurationstores"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2015-05-01/componentapikeysapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2015-05-01/componentproactivedetectionapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-02-02/componentsapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-11-20/workbooktemplatesapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2022-04-01/workbooksapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2022-06-15/webtestsapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appplatform/2024-01-01-preview/appplatform"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/attestation/2020-10-01/attestationproviders"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automanage/2022-05-04/configurationprofileassignments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automanage/2022-05-04/configurationprofilehcrpassignments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automanage/2022-05-04/configurationprofiles"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2015-10-31/webhook"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2019-06-01/softwareupdateconfiguration"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2020-01-13-preview/watcher"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/automationaccount"
+	certificate_2023_11_01 "github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/certificate"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/connection"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/connectiontype"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/credential"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/dscconfiguration"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/dscnodeconfiguration"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/hybridrunbookworker"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/hybridrunbookworkergroup"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/module"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/python3package"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/runbook"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/schedule"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/sourcecontrol"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/variable"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azureactivedirectory/2017-04-01/diagnosticsettings"
+	clusters_2024_01_01 "github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/clusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/deploymentsettings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/extensions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/logicalnetworks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/marketplacegalleryimages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/networkinterfaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/storagecontainers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/virtualharddisks"
+	application_2024_07_01 "github.com/hashicorp/go-azure-sdk/resource-manager/batch/2024-07-01/application"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2024-07-01/batchaccount"
+	certificate_2024_07_01 "github.com/hashicorp/go-azure-sdk/resource-manager/batch/2024-07-01/certificate"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2024-07-01/pool"
+	profiles_2024_02_01 "github.com/hashicorp/go-azure-sdk/resource-manager/cdn/2024-02-01/profiles"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cdn/2024-02-01/rulesets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cdn/2024-02-01/securitypolicies"
+	rules_2024_09_01 "github.com/hashicorp/go-azure-sdk/resource-manager/cdn/2024-09-01/rules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2023-11-01/experiments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/codesigning/2024-09-30-preview/codesigningaccounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/cognitiveservicesaccounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/deployments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/raiblocklists"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/raipolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/communicationservices"
+	domains_2023_03_31 "github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/domains"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/emailservices"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/senderusernames"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/images"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/diskaccesses"
+	snapshots_2022_03_02 "github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/snapshots"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-03-01/virtualmachineruncommands"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-07-03/galleryimageversions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/restorepointcollections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/restorepoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/sshpublickeys"
+	virtualmachines_2024_03_01 "github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetextensions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-11-01/virtualmachinescalesets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/confidentialledger/2022-05-13/confidentialledger"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/certificates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/daprcomponents"
+	jobs_2025_01_01 "github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/jobs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/managedenvironments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/managedenvironmentsstorages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerinstance/2023-05-01/containerinstance"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/agentpools"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/tasks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-07-01/cacherules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-07-01/credentialsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/connectedregistries"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/registries"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/scopemaps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/tokens"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/webhooks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/fleetmembers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/fleets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/fleetupdatestrategies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/updateruns"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-02-01/trustedaccess"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-05-15/sqldedicatedgateway"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-11-15/mongorbacs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2023-04-15/managedcassandras"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/customproviders/2018-09-01-preview/customresourceprovider"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2023-09-01/grafanaresource"
+	managedprivateendpoints_2023_09_01 "github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2023-09-01/managedprivateendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/databoxedge/2022-03-01/devices"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2022-10-01-preview/accessconnector"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2024-05-01/vnetpeering"
+	workspaces_2024_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2024-05-01/workspaces"
+	monitorsresource_2021_03_01 "github.com/hashicorp/go-azure-sdk/resource-manager/datadog/2021-03-01/monitorsresource"
+	rules_2021_03_01 "github.com/hashicorp/go-azure-sdk/resource-manager/datadog/2021-03-01/rules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datadog/2021-03-01/singlesignon"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/credentials"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/dataflows"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/integrationruntimes"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/pipelines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datamigration/2021-06-30/projectresource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datamigration/2021-06-30/serviceresource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backupinstances"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backuppolicies"
+	backupvaults_2024_04_01 "github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backupvaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/resourceguards"
+	account_2019_11_01 "github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/account"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/dataset"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/share"
+	application_2024_04_03 "github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/application"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/applicationgroup"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/hostpool"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/scalingplan"
+	workspace_2024_04_03 "github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/workspace"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/attachednetworkconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/catalogs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/devboxdefinitions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/devcenters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/environmenttypes"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/galleries"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/networkconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/pools"
+	projects_2025_02_01 "github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/projects"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceprovisioningservices/2022-02-05/dpscertificate"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceprovisioningservices/2022-02-05/iotdpsresource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceupdate/2022-10-01/deviceupdates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/globalschedules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/labs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/policies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/schedules"
+	virtualmachines_2018_09_15 "github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/virtualmachines"
+	virtualnetworks_2018_09_15 "github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/virtualnetworks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/digitaltwins/2023-01-31/digitaltwinsinstance"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/digitaltwins/2023-01-31/timeseriesdatabaseconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsforwardingrulesets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsresolvers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/forwardingrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/inboundendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/outboundendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/virtualnetworklinks"
+	monitors_2023_04_27 "github.com/hashicorp/go-azure-sdk/resource-manager/dynatrace/2023-04-27/monitors"
+	tagrules_2023_04_27 "github.com/hashicorp/go-azure-sdk/resource-manager/dynatrace/2023-04-27/tagrules"
+	monitorsresource_2023_06_01 "github.com/hashicorp/go-azure-sdk/resource-manager/elastic/2023-06-01/monitorsresource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2023-01-01/elasticsans"
+	volumegroups_2023_01_01 "github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2023-01-01/volumegroups"
+	volumes_2023_01_01 "github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2023-01-01/volumes"
+	domains_2022_06_15 "github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/domains"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/domaintopics"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/eventsubscriptions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/systemtopics"
+	topics_2022_06_15 "github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/topics"
+	namespaces_2023_12_15_preview "github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/authorizationrulesnamespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/consumergroups"
+	disasterrecoveryconfigs_2024_01_01 "github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/disasterrecoveryconfigs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/eventhubs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/eventhubsclusters"
+	namespaces_2024_01_01 "github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/schemaregistry"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/fabric/2023-11-01/fabriccapacities"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/fluidrelay/2022-05-26/fluidrelayservers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/frontdoor/2020-05-01/frontdoors"
+	webapplicationfirewallpolicies_2024_02_01 "github.com/hashicorp/go-azure-sdk/resource-manager/frontdoor/2024-02-01/webapplicationfirewallpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/graphservices/2023-04-13/graphservicesprods"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/guestconfiguration/2020-06-25/guestconfigurationassignments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hardwaresecuritymodules/2021-11-30/dedicatedhsms"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/healthbot/2022-08-08/healthbots"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/healthcareapis/2022-12-01/fhirservices"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/healthcareapis/2022-12-01/iotconnectors"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/healthcareapis/2022-12-01/resource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/healthcareapis/2024-03-31/dicomservices"
+	workspaces_2024_03_31 "github.com/hashicorp/go-azure-sdk/resource-manager/healthcareapis/2024-03-31/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machineextensions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/privatelinkscopes"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/machines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridkubernetes/2024-01-01/connectedclusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2018-03-01/metricalerts"
+	scheduledqueryrules_2018_04_16 "github.com/hashicorp/go-azure-sdk/resource-manager/insights/2018-04-16/scheduledqueryrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2019-10-17-preview/privatelinkscopedresources"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2020-10-01/activitylogalertsapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-07-01-preview/privatelinkscopesapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-10-01/autoscalesettings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-11/datacollectionendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-11/datacollectionrules"
+	scheduledqueryrules_2023_03_15_preview "github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-15-preview/scheduledqueryrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-04-03/azuremonitorworkspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/iotcentral/2021-11-01-preview/apps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/managedhsms"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2024-04-13/attacheddatabaseconfigurations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2024-04-13/clusterprincipalassignments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2024-04-13/databaseprincipalassignments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2024-04-13/dataconnections"
+	managedprivateendpoints_2024_04_13 "github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2024-04-13/managedprivateendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2024-04-13/scripts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2022-12-01/loadtests"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountagreements"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountassemblies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountbatchconfigurations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountcertificates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountmaps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountpartners"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountschemas"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountsessions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/workflows"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/workflowtriggers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/datastore"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/machinelearningcomputes"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/managednetwork"
+	workspaces_2024_04_01 "github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2023-04-01/configurationassignments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedapplications/2021-07-01/applicationdefinitions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedapplications/2021-07-01/applications"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedidentity/2023-01-31/managedidentities"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managementgroups/2020-05-01/managementgroups"
+	accounts_2023_06_01 "github.com/hashicorp/go-azure-sdk/resource-manager/maps/2023-06-01/accounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/marketplaceordering/2015-06-01/agreements"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/attacheddatanetwork"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/datanetwork"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/mobilenetwork"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/packetcorecontrolplane"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/packetcoredataplane"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/service"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/sim"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/simgroup"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/simpolicy"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/site"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/slice"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mongocluster/2024-07-01/mongoclusters"
+	configurations_2023_12_30 "github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/configurations"
+	databases_2023_12_30 "github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/databases"
+	firewallrules_2023_12_30 "github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/firewallrules"
+	servers_2023_12_30 "github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/servers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-01-01/backuppolicy"
+	backupvaults_2025_01_01 "github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-01-01/backupvaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-01-01/capacitypools"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-01-01/netappaccounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-01-01/snapshotpolicy"
+	snapshots_2025_01_01 "github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-01-01/snapshots"
+	volumegroups_2025_01_01 "github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-01-01/volumegroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-01-01/volumequotarules"
+	volumes_2025_01_01 "github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-01-01/volumes"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/loadbalancers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/adminrulecollections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/adminrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/connectionmonitors"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/connectivityconfigurations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/customipprefixes"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/ddosprotectionplans"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/expressroutecircuitauthorizations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/expressroutecircuitconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/expressroutecircuits"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/expressrouteconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/expressroutegateways"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/expressrouteportauthorizations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/expressrouteports"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/firewallpolicyrulecollectiongroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/flowlogs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/ipampools"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/networkgroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/networkmanagerconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/networkmanagerroutingconfigurations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/networkmanagers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/networkvirtualappliances"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/networkwatchers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/packetcaptures"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/reachabilityanalysisintent"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/scopeconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/securityadminconfigurations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/securitypartnerproviders"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/staticmembers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/verifierworkspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/virtualnetworkgatewayconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/virtualnetworkgateways"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/virtualnetworkpeerings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/virtualwans"
+	webapplicationfirewallpolicies_2024_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/webapplicationfirewallpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/networkfunction/2022-11-01/azuretrafficcollectors"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/networkfunction/2022-11-01/collectorpolicies"
+	monitors_2024_03_01 "github.com/hashicorp/go-azure-sdk/resource-manager/newrelic/2024-03-01/monitors"
+	tagrules_2024_03_01 "github.com/hashicorp/go-azure-sdk/resource-manager/newrelic/2024-03-01/tagrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-11-01-preview/nginxapikey"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-11-01-preview/nginxcertificate"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-11-01-preview/nginxconfiguration"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-11-01-preview/nginxdeployment"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/notificationhubs/2023-09-01/hubs"
+	namespaces_2023_09_01 "github.com/hashicorp/go-azure-sdk/resource-manager/notificationhubs/2023-09-01/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2019-09-01/querypackqueries"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2019-09-01/querypacks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/dataexport"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/linkedservices"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/savedsearches"
+	clusters_2022_10_01 "github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/clusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+	workspaces_2022_10_01 "github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationsmanagement/2015-11-01-preview/solution"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-03-01/autonomousdatabases"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-03-01/cloudexadatainfrastructures"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-03-01/cloudvmclusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/orbital/2022-11-01/contact"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/orbital/2022-11-01/contactprofile"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/orbital/2022-11-01/spacecraft"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/certificateobjectlocalrulestack"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/fqdnlistlocalrulestack"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/prefixlistlocalrulestack"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2023-09-01/firewalls"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/portal/2019-01-01-preview/dashboard"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2024-08-01/administrators"
+	configurations_2024_08_01 "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2024-08-01/configurations"
+	databases_2024_08_01 "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2024-08-01/databases"
+	servers_2024_08_01 "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2024-08-01/servers"
+	clusters_2022_11_08 "github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/clusters"
+	configurations_2022_11_08 "github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/configurations"
+	firewallrules_2022_11_08 "github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/firewallrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/roles"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/powerbidedicated/2021-01-01/capacities"
+	account_2021_12_01 "github.com/hashicorp/go-azure-sdk/resource-manager/purview/2021-12-01/account"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/qumulostorage/2024-06-19/filesystems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservices/2024-01-01/vaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protecteditems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protectioncontainers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/resourceguardproxy"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2024-10-01/protectionpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationfabrics"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationnetworkmappings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationprotecteditems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationprotectioncontainermappings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationprotectioncontainers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationrecoveryplans"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/redhatopenshift/2023-09-04/openshiftclusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2024-11-01/redis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/redisenterprise/2024-10-01/redisenterprise"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/hybridconnections"
+	namespaces_2021_11_01 "github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resourceconnector/2022-10-27/appliances"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/privatelinkassociation"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/resourcemanagementprivatelink"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-10-01/deploymentscripts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2025-01-01/policysetdefinitions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/sharedprivatelinkresources"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/security/2021-06-01/assessmentsmetadata"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/metadata"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/sentinelonboardingstates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/watchlistitems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/watchlists"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/alertrules"
+	disasterrecoveryconfigs_2021_06_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/disasterrecoveryconfigs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/namespacesauthorizationrule"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/queues"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/queuesauthorizationrule"
+	rules_2021_06_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/rules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/subscriptions"
+	topics_2021_06_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/topics"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/topicsauthorizationrule"
+	namespaces_2022_10_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2022-10-01-preview/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabric/2021-06-01/cluster"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/managedcluster"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2025-01-01/associationsinterface"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2025-01-01/frontendsinterface"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2025-01-01/trafficcontrollerinterface"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2024-03-01/signalr"
+	firewallrules_2023_08_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/firewallrules"
+	jobs_2023_08_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobsteps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobtargetgroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2023-10-01/availabilitygrouplisteners"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2023-10-01/sqlvirtualmachines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/standbypool/2025-03-01/standbyvirtualmachinepools"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2023-05-01/caches"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2023-05-01/storagetargets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01/amlfilesystems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/agents"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/endpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/jobdefinitions"
+	projects_2023_03_01 "github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/projects"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/storagemovers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/serverendpointresource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/storagesyncservicesresource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/syncgroupresource"
+	clusters_2020_03_01 "github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/clusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/functions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/inputs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/privateendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/outputs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/streamingjobs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/availabilitysets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/clouds"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/virtualmachinetemplates"
+	virtualnetworks_2023_10_07 "github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/virtualnetworks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/vmmservers"
+	profiles_2022_04_01 "github.com/hashicorp/go-azure-sdk/resource-manager/trafficmanager/2022-04-01/profiles"
+	accounts_2025_04_01 "github.com/hashicorp/go-azure-sdk/resource-manager/videoindexer/2025-04-01/accounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/authorizations"
+	clusters_2022_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/clusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/datastores"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/privateclouds"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/voiceservices/2023-04-03/communicationsgateways"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/voiceservices/2023-04-03/testlines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2016-06-01/connections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/staticsites"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2024-03-01/webpubsub"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2024-09-01/sapvirtualinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// registerResourceIdentities registers the Resource ID types used by Typed Resources which don't define a Resource
+// Identity, so that a Resource Identity is generated for these Resources
+func registerResourceIdentities() {
+	pluginsdk.RegisterResourceIdentity(commonids.ValidateAppServiceID, &commonids.AppServiceId{})
+	pluginsdk.RegisterResourceIdentity(commonids.ValidateAppServicePlanID, &commonids.AppServicePlanId{})
+	pluginsdk.RegisterResourceIdentity(commonids.ValidateResourceGroupID, &commonids.ResourceGroupId{})
+	pluginsdk.RegisterResourceIdentity(commonids.ValidateStorageAccountID, &commonids.StorageAccountId{})
+	pluginsdk.RegisterResourceIdentity(commonids.ValidateUserAssignedIdentityID, &commonids.UserAssignedIdentityId{})
+	pluginsdk.RegisterResourceIdentity(tenants.ValidateB2CDirectoryID, &tenants.B2CDirectoryId{})
+	pluginsdk.RegisterResourceIdentity(alertprocessingrules.ValidateActionRuleID, &alertprocessingrules.ActionRuleId{})
+	pluginsdk.RegisterResourceIdentity(prometheusrulegroups.ValidatePrometheusRuleGroupID, &prometheusrulegroups.PrometheusRuleGroupId{})
+	pluginsdk.RegisterResourceIdentity(workspace_2024_05_01.ValidateWorkspaceID, &workspace_2024_05_01.WorkspaceId{})
+	pluginsdk.RegisterResourceIdentity(workbooktemplatesapis.ValidateWorkbookTemplateID, &workbooktemplatesapis.WorkbookTemplateId{})
+	pluginsdk.RegisterResourceIdentity(workbooksapis.ValidateWorkbookID, &workbooksapis.WorkbookId{})
+	pluginsdk.RegisterResourceIdentity(webtestsapis.ValidateWebTestID, &webtestsapis.WebTestId{})
+	pluginsdk.RegisterResourceIdentity(appplatform.ValidateApiPortalID, &appplatform.ApiPortalId{})
+	pluginsdk.RegisterResourceIdentity(appplatform.ValidateApmID, &appplatform.ApmId{})
+	pluginsdk.RegisterResourceIdentity(appplatform.ValidateCustomizedAcceleratorID, &appplatform.CustomizedAcceleratorId{})
+	pluginsdk.RegisterResourceIdentity(appplatform.ValidateGatewayID, &appplatform.GatewayId{})
+	pluginsdk.RegisterResourceIdentity(configurationprofileassignments.ValidateVirtualMachineProviders2ConfigurationProfileAssignmentID, &configurationprofileassignments.VirtualMachineProviders2ConfigurationProfileAssignmentId{})
+	pluginsdk.RegisterResourceIdentity(configurationprofilehcrpassignments.ValidateProviders2ConfigurationProfileAssignmentID, &configurationprofilehcrpassignments.Providers2ConfigurationProfileAssignmentId{})
+	pluginsdk.RegisterResourceIdentity(configurationprofiles.ValidateConfigurationProfileID, &configurationprofiles.ConfigurationProfileId{})
+	pluginsdk.RegisterResourceIdentity(softwareupdateconfiguration.ValidateSoftwareUpdateConfigurationID, &softwareupdateconfiguration.SoftwareUpdateConfigurationId{})
+	pluginsdk.RegisterResourceIdentity(watcher.ValidateWatcherID, &watcher.WatcherId{})
+	pluginsdk.RegisterResourceIdentity(connectiontype.ValidateConnectionTypeID, &connectiontype.ConnectionTypeId{})
+	pluginsdk.RegisterResourceIdentity(hybridrunbookworker.ValidateHybridRunbookWorkerID, &hybridrunbookworker.HybridRunbookWorkerId{})
+	pluginsdk.RegisterResourceIdentity(hybridrunbookworkergroup.ValidateHybridRunbookWorkerGroupID, &hybridrunbookworkergroup.HybridRunbookWorkerGroupId{})
+	pluginsdk.RegisterResourceIdentity(module.ValidatePowerShell72ModuleID, &module.PowerShell72ModuleId{})
+	pluginsdk.RegisterResourceIdentity(python3package.ValidatePython3PackageID, &python3package.Python3PackageId{})
+	pluginsdk.RegisterResourceIdentity(sourcecontrol.ValidateSourceControlID, &sourcecontrol.SourceControlId{})
+	pluginsdk.RegisterResourceIdentity(deploymentsettings.ValidateDeploymentSettingID, &deploymentsettings.DeploymentSettingId{})
+	pluginsdk.RegisterResourceIdentity(extensions.ValidateExtensionID, &extensions.ExtensionId{})
+	pluginsdk.RegisterResourceIdentity(logicalnetworks.ValidateLogicalNetworkID, &logicalnetworks.LogicalNetworkId{})
+	pluginsdk.RegisterResourceIdentity(marketplacegalleryimages.ValidateMarketplaceGalleryImageID, &marketplacegalleryimages.MarketplaceGalleryImageId{})
+	pluginsdk.RegisterResourceIdentity(networkinterfaces.ValidateNetworkInterfaceID, &networkinterfaces.NetworkInterfaceId{})
+	pluginsdk.RegisterResourceIdentity(storagecontainers.ValidateStorageContainerID, &storagecontainers.StorageContainerId{})
+	pluginsdk.RegisterResourceIdentity(virtualharddisks.ValidateVirtualHardDiskID, &virtualharddisks.VirtualHardDiskId{})
+	pluginsdk.RegisterResourceIdentity(experiments.ValidateExperimentID, &experiments.ExperimentId{})
+	pluginsdk.RegisterResourceIdentity(codesigningaccounts.ValidateCodeSigningAccountID, &codesigningaccounts.CodeSigningAccountId{})
+	pluginsdk.RegisterResourceIdentity(cognitiveservicesaccounts.ValidateAccountID, &cognitiveservicesaccounts.AccountId{})
+	pluginsdk.RegisterResourceIdentity(deployments.ValidateDeploymentID, &deployments.DeploymentId{})
+	pluginsdk.RegisterResourceIdentity(raiblocklists.ValidateRaiBlocklistID, &raiblocklists.RaiBlocklistId{})
+	pluginsdk.RegisterResourceIdentity(raipolicies.ValidateRaiPolicyID, &raipolicies.RaiPolicyId{})
+	pluginsdk.RegisterResourceIdentity(communicationservices.ValidateCommunicationServiceID, &communicationservices.CommunicationServiceId{})
+	pluginsdk.RegisterResourceIdentity(domains_2023_03_31.ValidateDomainID, &domains_2023_03_31.DomainId{})
+	pluginsdk.RegisterResourceIdentity(emailservices.ValidateEmailServiceID, &emailservices.EmailServiceId{})
+	pluginsdk.RegisterResourceIdentity(senderusernames.ValidateSenderUsernameID, &senderusernames.SenderUsernameId{})
+	pluginsdk.RegisterResourceIdentity(virtualmachineruncommands.ValidateVirtualMachineRunCommandID, &virtualmachineruncommands.VirtualMachineRunCommandId{})
+	pluginsdk.RegisterResourceIdentity(restorepointcollections.ValidateRestorePointCollectionID, &restorepointcollections.RestorePointCollectionId{})
+	pluginsdk.RegisterResourceIdentity(restorepoints.ValidateRestorePointID, &restorepoints.RestorePointId{})
+	pluginsdk.RegisterResourceIdentity(certificates.ValidateCertificateID, &certificates.CertificateId{})
+	pluginsdk.RegisterResourceIdentity(containerapps.ValidateContainerAppID, &containerapps.ContainerAppId{})
+	pluginsdk.RegisterResourceIdentity(daprcomponents.ValidateDaprComponentID, &daprcomponents.DaprComponentId{})
+	pluginsdk.RegisterResourceIdentity(jobs_2025_01_01.ValidateJobID, &jobs_2025_01_01.JobId{})
+	pluginsdk.RegisterResourceIdentity(managedenvironments.ValidateManagedEnvironmentID, &managedenvironments.ManagedEnvironmentId{})
+	pluginsdk.RegisterResourceIdentity(managedenvironmentsstorages.ValidateStorageID, &managedenvironmentsstorages.StorageId{})
+	pluginsdk.RegisterResourceIdentity(tasks.ValidateTaskID, &tasks.TaskId{})
+	pluginsdk.RegisterResourceIdentity(cacherules.ValidateCacheRuleID, &cacherules.CacheRuleId{})
+	pluginsdk.RegisterResourceIdentity(credentialsets.ValidateCredentialSetID, &credentialsets.CredentialSetId{})
+	pluginsdk.RegisterResourceIdentity(connectedregistries.ValidateConnectedRegistryID, &connectedregistries.ConnectedRegistryId{})
+	pluginsdk.RegisterResourceIdentity(fleetmembers.ValidateMemberID, &fleetmembers.MemberId{})
+	pluginsdk.RegisterResourceIdentity(fleets.ValidateFleetID, &fleets.FleetId{})
+	pluginsdk.RegisterResourceIdentity(fleetupdatestrategies.ValidateUpdateStrategyID, &fleetupdatestrategies.UpdateStrategyId{})
+	pluginsdk.RegisterResourceIdentity(updateruns.ValidateUpdateRunID, &updateruns.UpdateRunId{})
+	pluginsdk.RegisterResourceIdentity(trustedaccess.ValidateTrustedAccessRoleBindingID, &trustedaccess.TrustedAccessRoleBindingId{})
+	pluginsdk.RegisterResourceIdentity(sqldedicatedgateway.ValidateServiceID, &sqldedicatedgateway.ServiceId{})
+	pluginsdk.RegisterResourceIdentity(mongorbacs.ValidateMongodbRoleDefinitionID, &mongorbacs.MongodbRoleDefinitionId{})
+	pluginsdk.RegisterResourceIdentity(mongorbacs.ValidateMongodbUserDefinitionID, &mongorbacs.MongodbUserDefinitionId{})
+	pluginsdk.RegisterResourceIdentity(grafanaresource.ValidateGrafanaID, &grafanaresource.GrafanaId{})
+	pluginsdk.RegisterResourceIdentity(managedprivateendpoints_2023_09_01.ValidateManagedPrivateEndpointID, &managedprivateendpoints_2023_09_01.ManagedPrivateEndpointId{})
+	pluginsdk.RegisterResourceIdentity(devices.ValidateDataBoxEdgeDeviceID, &devices.DataBoxEdgeDeviceId{})
+	pluginsdk.RegisterResourceIdentity(accessconnector.ValidateAccessConnectorID, &accessconnector.AccessConnectorId{})
+	pluginsdk.RegisterResourceIdentity(credentials.ValidateCredentialID, &credentials.CredentialId{})
+	pluginsdk.RegisterResourceIdentity(backupinstances.ValidateBackupInstanceID, &backupinstances.BackupInstanceId{})
+	pluginsdk.RegisterResourceIdentity(backuppolicies.ValidateBackupPolicyID, &backuppolicies.BackupPolicyId{})
+	pluginsdk.RegisterResourceIdentity(backupvaults_2024_04_01.ValidateBackupVaultID, &backupvaults_2024_04_01.BackupVaultId{})
+	pluginsdk.RegisterResourceIdentity(attachednetworkconnections.ValidateDevCenterAttachedNetworkID, &attachednetworkconnections.DevCenterAttachedNetworkId{})
+	pluginsdk.RegisterResourceIdentity(catalogs.ValidateDevCenterCatalogID, &catalogs.DevCenterCatalogId{})
+	pluginsdk.RegisterResourceIdentity(devboxdefinitions.ValidateDevCenterDevBoxDefinitionID, &devboxdefinitions.DevCenterDevBoxDefinitionId{})
+	pluginsdk.RegisterResourceIdentity(devcenters.ValidateDevCenterID, &devcenters.DevCenterId{})
+	pluginsdk.RegisterResourceIdentity(environmenttypes.ValidateDevCenterEnvironmentTypeID, &environmenttypes.DevCenterEnvironmentTypeId{})
+	pluginsdk.RegisterResourceIdentity(environmenttypes.ValidateEnvironmentTypeID, &environmenttypes.EnvironmentTypeId{})
+	pluginsdk.RegisterResourceIdentity(galleries.ValidateGalleryID, &galleries.GalleryId{})
+	pluginsdk.RegisterResourceIdentity(networkconnections.ValidateNetworkConnectionID, &networkconnections.NetworkConnectionId{})
+	pluginsdk.RegisterResourceIdentity(pools.ValidatePoolID, &pools.PoolId{})
+	pluginsdk.RegisterResourceIdentity(projects_2025_02_01.ValidateProjectID, &projects_2025_02_01.ProjectId{})
+	pluginsdk.RegisterResourceIdentity(deviceupdates.ValidateAccountID, &deviceupdates.AccountId{})
+	pluginsdk.RegisterResourceIdentity(deviceupdates.ValidateInstanceID, &deviceupdates.InstanceId{})
+	pluginsdk.RegisterResourceIdentity(timeseriesdatabaseconnections.ValidateTimeSeriesDatabaseConnectionID, &timeseriesdatabaseconnections.TimeSeriesDatabaseConnectionId{})
+	pluginsdk.RegisterResourceIdentity(zones.ValidateDnsZoneID, &zones.DnsZoneId{})
+	pluginsdk.RegisterResourceIdentity(dnsforwardingrulesets.ValidateDnsForwardingRulesetID, &dnsforwardingrulesets.DnsForwardingRulesetId{})
+	pluginsdk.RegisterResourceIdentity(dnsresolvers.ValidateDnsResolverID, &dnsresolvers.DnsResolverId{})
+	pluginsdk.RegisterResourceIdentity(forwardingrules.ValidateForwardingRuleID, &forwardingrules.ForwardingRuleId{})
+	pluginsdk.RegisterResourceIdentity(inboundendpoints.ValidateInboundEndpointID, &inboundendpoints.InboundEndpointId{})
+	pluginsdk.RegisterResourceIdentity(outboundendpoints.ValidateOutboundEndpointID, &outboundendpoints.OutboundEndpointId{})
+	pluginsdk.RegisterResourceIdentity(virtualnetworklinks.ValidateVirtualNetworkLinkID, &virtualnetworklinks.VirtualNetworkLinkId{})
+	pluginsdk.RegisterResourceIdentity(monitors_2023_04_27.ValidateMonitorID, &monitors_2023_04_27.MonitorId{})
+	pluginsdk.RegisterResourceIdentity(tagrules_2023_04_27.ValidateTagRuleID, &tagrules_2023_04_27.TagRuleId{})
+	pluginsdk.RegisterResourceIdentity(elasticsans.ValidateElasticSanID, &elasticsans.ElasticSanId{})
+	pluginsdk.RegisterResourceIdentity(volumegroups_2023_01_01.ValidateVolumeGroupID, &volumegroups_2023_01_01.VolumeGroupId{})
+	pluginsdk.RegisterResourceIdentity(volumes_2023_01_01.ValidateVolumeID, &volumes_2023_01_01.VolumeId{})
+	pluginsdk.RegisterResourceIdentity(namespaces_2023_12_15_preview.ValidateNamespaceID, &namespaces_2023_12_15_preview.NamespaceId{})
+	pluginsdk.RegisterResourceIdentity(consumergroups.ValidateConsumerGroupID, &consumergroups.ConsumerGroupId{})
+	pluginsdk.RegisterResourceIdentity(customlocations.ValidateCustomLocationID, &customlocations.CustomLocationId{})
+	pluginsdk.RegisterResourceIdentity(fabriccapacities.ValidateCapacityID, &fabriccapacities.CapacityId{})
+	pluginsdk.RegisterResourceIdentity(fluidrelayservers.ValidateFluidRelayServerID, &fluidrelayservers.FluidRelayServerId{})
+	pluginsdk.RegisterResourceIdentity(graphservicesprods.ValidateAccountID, &graphservicesprods.AccountId{})
+	pluginsdk.RegisterResourceIdentity(machineextensions.ValidateExtensionID, &machineextensions.ExtensionId{})
+	pluginsdk.RegisterResourceIdentity(privatelinkscopes.ValidateProviderPrivateLinkScopeID, &privatelinkscopes.ProviderPrivateLinkScopeId{})
+	pluginsdk.RegisterResourceIdentity(machines.ValidateMachineID, &machines.MachineId{})
+	pluginsdk.RegisterResourceIdentity(connectedclusters.ValidateConnectedClusterID, &connectedclusters.ConnectedClusterId{})
+	pluginsdk.RegisterResourceIdentity(datacollectionendpoints.ValidateDataCollectionEndpointID, &datacollectionendpoints.DataCollectionEndpointId{})
+	pluginsdk.RegisterResourceIdentity(datacollectionrules.ValidateDataCollectionRuleID, &datacollectionrules.DataCollectionRuleId{})
+	pluginsdk.RegisterResourceIdentity(scheduledqueryrules_2023_03_15_preview.ValidateScheduledQueryRuleID, &scheduledqueryrules_2023_03_15_preview.ScheduledQueryRuleId{})
+	pluginsdk.RegisterResourceIdentity(azuremonitorworkspaces.ValidateAccountID, &azuremonitorworkspaces.AccountId{})
+	pluginsdk.RegisterResourceIdentity(apps.ValidateIotAppID, &apps.IotAppId{})
+	pluginsdk.RegisterResourceIdentity(dataconnections.ValidateDataConnectionID, &dataconnections.DataConnectionId{})
+	pluginsdk.RegisterResourceIdentity(loadtests.ValidateLoadTestID, &loadtests.LoadTestId{})
+	pluginsdk.RegisterResourceIdentity(datastore.ValidateDataStoreID, &datastore.DataStoreId{})
+	pluginsdk.RegisterResourceIdentity(managednetwork.ValidateOutboundRuleID, &managednetwork.OutboundRuleId{})
+	pluginsdk.RegisterResourceIdentity(workspaces_2024_04_01.ValidateWorkspaceID, &workspaces_2024_04_01.WorkspaceId{})
+	pluginsdk.RegisterResourceIdentity(configurationassignments.ValidateConfigurationAssignmentID, &configurationassignments.ConfigurationAssignmentId{})
+	pluginsdk.RegisterResourceIdentity(managedidentities.ValidateFederatedIdentityCredentialID, &managedidentities.FederatedIdentityCredentialId{})
+	pluginsdk.RegisterResourceIdentity(attacheddatanetwork.ValidateAttachedDataNetworkID, &attacheddatanetwork.AttachedDataNetworkId{})
+	pluginsdk.RegisterResourceIdentity(datanetwork.ValidateDataNetworkID, &datanetwork.DataNetworkId{})
+	pluginsdk.RegisterResourceIdentity(mobilenetwork.ValidateMobileNetworkID, &mobilenetwork.MobileNetworkId{})
+	pluginsdk.RegisterResourceIdentity(packetcorecontrolplane.ValidatePacketCoreControlPlaneID, &packetcorecontrolplane.PacketCoreControlPlaneId{})
+	pluginsdk.RegisterResourceIdentity(packetcoredataplane.ValidatePacketCoreDataPlaneID, &packetcoredataplane.PacketCoreDataPlaneId{})
+	pluginsdk.RegisterResourceIdentity(service.ValidateServiceID, &service.ServiceId{})
+	pluginsdk.RegisterResourceIdentity(sim.ValidateSimID, &sim.SimId{})
+	pluginsdk.RegisterResourceIdentity(simgroup.ValidateSimGroupID, &simgroup.SimGroupId{})
+	pluginsdk.RegisterResourceIdentity(simpolicy.ValidateSimPolicyID, &simpolicy.SimPolicyId{})
+	pluginsdk.RegisterResourceIdentity(site.ValidateSiteID, &site.SiteId{})
+	pluginsdk.RegisterResourceIdentity(slice.ValidateSliceID, &slice.SliceId{})
+	pluginsdk.RegisterResourceIdentity(mongoclusters.ValidateMongoClusterID, &mongoclusters.MongoClusterId{})
+	pluginsdk.RegisterResourceIdentity(backuppolicy.ValidateBackupPolicyID, &backuppolicy.BackupPolicyId{})
+	pluginsdk.RegisterResourceIdentity(backupvaults_2025_01_01.ValidateBackupVaultID, &backupvaults_2025_01_01.BackupVaultId{})
+	pluginsdk.RegisterResourceIdentity(netappaccounts.ValidateNetAppAccountID, &netappaccounts.NetAppAccountId{})
+	pluginsdk.RegisterResourceIdentity(volumegroups_2025_01_01.ValidateVolumeGroupID, &volumegroups_2025_01_01.VolumeGroupId{})
+	pluginsdk.RegisterResourceIdentity(volumequotarules.ValidateVolumeQuotaRuleID, &volumequotarules.VolumeQuotaRuleId{})
+	pluginsdk.RegisterResourceIdentity(adminrulecollections.ValidateSecurityAdminConfigurationRuleCollectionID, &adminrulecollections.SecurityAdminConfigurationRuleCollectionId{})
+	pluginsdk.RegisterResourceIdentity(adminrules.ValidateSecurityAdminConfigurationRuleCollectionRuleID, &adminrules.SecurityAdminConfigurationRuleCollectionRuleId{})
+	pluginsdk.RegisterResourceIdentity(connectivityconfigurations.ValidateConnectivityConfigurationID, &connectivityconfigurations.ConnectivityConfigurationId{})
+	pluginsdk.RegisterResourceIdentity(customipprefixes.ValidateCustomIPPrefixID, &customipprefixes.CustomIPPrefixId{})
+	pluginsdk.RegisterResourceIdentity(ipampools.ValidateIPamPoolID, &ipampools.IPamPoolId{})
+	pluginsdk.RegisterResourceIdentity(networkgroups.ValidateNetworkGroupID, &networkgroups.NetworkGroupId{})
+	pluginsdk.RegisterResourceIdentity(networkmanagerconnections.ValidateNetworkManagerConnectionID, &networkmanagerconnections.NetworkManagerConnectionId{})
+	pluginsdk.RegisterResourceIdentity(networkmanagerconnections.ValidateProviders2NetworkManagerConnectionID, &networkmanagerconnections.Providers2NetworkManagerConnectionId{})
+	pluginsdk.RegisterResourceIdentity(networkmanagerroutingconfigurations.ValidateRoutingConfigurationID, &networkmanagerroutingconfigurations.RoutingConfigurationId{})
+	pluginsdk.RegisterResourceIdentity(networkmanagers.ValidateNetworkManagerID, &networkmanagers.NetworkManagerId{})
+	pluginsdk.RegisterResourceIdentity(networkvirtualappliances.ValidateNetworkVirtualApplianceID, &networkvirtualappliances.NetworkVirtualApplianceId{})
+	pluginsdk.RegisterResourceIdentity(reachabilityanalysisintent.ValidateReachabilityAnalysisIntentID, &reachabilityanalysisintent.ReachabilityAnalysisIntentId{})
+	pluginsdk.RegisterResourceIdentity(scopeconnections.ValidateScopeConnectionID, &scopeconnections.ScopeConnectionId{})
+	pluginsdk.RegisterResourceIdentity(securityadminconfigurations.ValidateSecurityAdminConfigurationID, &securityadminconfigurations.SecurityAdminConfigurationId{})
+	pluginsdk.RegisterResourceIdentity(staticmembers.ValidateStaticMemberID, &staticmembers.StaticMemberId{})
+	pluginsdk.RegisterResourceIdentity(verifierworkspaces.ValidateVerifierWorkspaceID, &verifierworkspaces.VerifierWorkspaceId{})
+	pluginsdk.RegisterResourceIdentity(azuretrafficcollectors.ValidateAzureTrafficCollectorID, &azuretrafficcollectors.AzureTrafficCollectorId{})
+	pluginsdk.RegisterResourceIdentity(collectorpolicies.ValidateCollectorPolicyID, &collectorpolicies.CollectorPolicyId{})
+	pluginsdk.RegisterResourceIdentity(monitors_2024_03_01.ValidateMonitorID, &monitors_2024_03_01.MonitorId{})
+	pluginsdk.RegisterResourceIdentity(tagrules_2024_03_01.ValidateTagRuleID, &tagrules_2024_03_01.TagRuleId{})
+	pluginsdk.RegisterResourceIdentity(nginxapikey.ValidateApiKeyID, &nginxapikey.ApiKeyId{})
+	pluginsdk.RegisterResourceIdentity(nginxcertificate.ValidateCertificateID, &nginxcertificate.CertificateId{})
+	pluginsdk.RegisterResourceIdentity(nginxconfiguration.ValidateConfigurationID, &nginxconfiguration.ConfigurationId{})
+	pluginsdk.RegisterResourceIdentity(nginxdeployment.ValidateNginxDeploymentID, &nginxdeployment.NginxDeploymentId{})
+	pluginsdk.RegisterResourceIdentity(querypackqueries.ValidateQueryID, &querypackqueries.QueryId{})
+	pluginsdk.RegisterResourceIdentity(querypacks.ValidateQueryPackID, &querypacks.QueryPackId{})
+	pluginsdk.RegisterResourceIdentity(clusters_2022_10_01.ValidateClusterID, &clusters_2022_10_01.ClusterId{})
+	pluginsdk.RegisterResourceIdentity(tables.ValidateTableID, &tables.TableId{})
+	pluginsdk.RegisterResourceIdentity(solution.ValidateSolutionID, &solution.SolutionId{})
+	pluginsdk.RegisterResourceIdentity(autonomousdatabases.ValidateAutonomousDatabaseID, &autonomousdatabases.AutonomousDatabaseId{})
+	pluginsdk.RegisterResourceIdentity(cloudexadatainfrastructures.ValidateCloudExadataInfrastructureID, &cloudexadatainfrastructures.CloudExadataInfrastructureId{})
+	pluginsdk.RegisterResourceIdentity(cloudvmclusters.ValidateCloudVMClusterID, &cloudvmclusters.CloudVMClusterId{})
+	pluginsdk.RegisterResourceIdentity(contact.ValidateContactID, &contact.ContactId{})
+	pluginsdk.RegisterResourceIdentity(contactprofile.ValidateContactProfileID, &contactprofile.ContactProfileId{})
+	pluginsdk.RegisterResourceIdentity(spacecraft.ValidateSpacecraftID, &spacecraft.SpacecraftId{})
+	pluginsdk.RegisterResourceIdentity(certificateobjectlocalrulestack.ValidateLocalRulestackCertificateID, &certificateobjectlocalrulestack.LocalRulestackCertificateId{})
+	pluginsdk.RegisterResourceIdentity(fqdnlistlocalrulestack.ValidateLocalRulestackFqdnListID, &fqdnlistlocalrulestack.LocalRulestackFqdnListId{})
+	pluginsdk.RegisterResourceIdentity(localrules.ValidateLocalRuleID, &localrules.LocalRuleId{})
+	pluginsdk.RegisterResourceIdentity(localrulestacks.ValidateLocalRulestackID, &localrulestacks.LocalRulestackId{})
+	pluginsdk.RegisterResourceIdentity(prefixlistlocalrulestack.ValidateLocalRulestackPrefixListID, &prefixlistlocalrulestack.LocalRulestackPrefixListId{})
+	pluginsdk.RegisterResourceIdentity(firewalls.ValidateFirewallID, &firewalls.FirewallId{})
+	pluginsdk.RegisterResourceIdentity(clusters_2022_11_08.ValidateServerGroupsv2ID, &clusters_2022_11_08.ServerGroupsv2Id{})
+	pluginsdk.RegisterResourceIdentity(configurations_2022_11_08.ValidateCoordinatorConfigurationID, &configurations_2022_11_08.CoordinatorConfigurationId{})
+	pluginsdk.RegisterResourceIdentity(configurations_2022_11_08.ValidateNodeConfigurationID, &configurations_2022_11_08.NodeConfigurationId{})
+	pluginsdk.RegisterResourceIdentity(firewallrules_2022_11_08.ValidateFirewallRuleID, &firewallrules_2022_11_08.FirewallRuleId{})
+	pluginsdk.RegisterResourceIdentity(roles.ValidateRoleID, &roles.RoleId{})
+	pluginsdk.RegisterResourceIdentity(filesystems.ValidateFileSystemID, &filesystems.FileSystemId{})
+	pluginsdk.RegisterResourceIdentity(resourceguardproxy.ValidateBackupResourceGuardProxyID, &resourceguardproxy.BackupResourceGuardProxyId{})
+	pluginsdk.RegisterResourceIdentity(protectionpolicies.ValidateBackupPolicyID, &protectionpolicies.BackupPolicyId{})
+	pluginsdk.RegisterResourceIdentity(replicationfabrics.ValidateReplicationFabricID, &replicationfabrics.ReplicationFabricId{})
+	pluginsdk.RegisterResourceIdentity(replicationnetworkmappings.ValidateReplicationNetworkMappingID, &replicationnetworkmappings.ReplicationNetworkMappingId{})
+	pluginsdk.RegisterResourceIdentity(replicationpolicies.ValidateReplicationPolicyID, &replicationpolicies.ReplicationPolicyId{})
+	pluginsdk.RegisterResourceIdentity(replicationprotecteditems.ValidateReplicationProtectedItemID, &replicationprotecteditems.ReplicationProtectedItemId{})
+	pluginsdk.RegisterResourceIdentity(replicationprotectioncontainermappings.ValidateReplicationProtectionContainerMappingID, &replicationprotectioncontainermappings.ReplicationProtectionContainerMappingId{})
+	pluginsdk.RegisterResourceIdentity(replicationrecoveryplans.ValidateReplicationRecoveryPlanID, &replicationrecoveryplans.ReplicationRecoveryPlanId{})
+	pluginsdk.RegisterResourceIdentity(openshiftclusters.ValidateProviderOpenShiftClusterID, &openshiftclusters.ProviderOpenShiftClusterId{})
+	pluginsdk.RegisterResourceIdentity(redis.ValidateAccessPolicyAssignmentID, &redis.AccessPolicyAssignmentId{})
+	pluginsdk.RegisterResourceIdentity(redis.ValidateAccessPolicyID, &redis.AccessPolicyId{})
+	pluginsdk.RegisterResourceIdentity(appliances.ValidateApplianceID, &appliances.ApplianceId{})
+	pluginsdk.RegisterResourceIdentity(privatelinkassociation.ValidatePrivateLinkAssociationID, &privatelinkassociation.PrivateLinkAssociationId{})
+	pluginsdk.RegisterResourceIdentity(resourcemanagementprivatelink.ValidateResourceManagementPrivateLinkID, &resourcemanagementprivatelink.ResourceManagementPrivateLinkId{})
+	pluginsdk.RegisterResourceIdentity(deploymentscripts.ValidateDeploymentScriptID, &deploymentscripts.DeploymentScriptId{})
+	pluginsdk.RegisterResourceIdentity(policysetdefinitions.ValidateProviders2PolicySetDefinitionID, &policysetdefinitions.Providers2PolicySetDefinitionId{})
+	pluginsdk.RegisterResourceIdentity(sharedprivatelinkresources.ValidateSharedPrivateLinkResourceID, &sharedprivatelinkresources.SharedPrivateLinkResourceId{})
+	pluginsdk.RegisterResourceIdentity(metadata.ValidateMetadataID, &metadata.MetadataId{})
+	pluginsdk.RegisterResourceIdentity(sentinelonboardingstates.ValidateOnboardingStateID, &sentinelonboardingstates.OnboardingStateId{})
+	pluginsdk.RegisterResourceIdentity(watchlistitems.ValidateWatchlistItemID, &watchlistitems.WatchlistItemId{})
+	pluginsdk.RegisterResourceIdentity(watchlists.ValidateWatchlistID, &watchlists.WatchlistId{})
+	pluginsdk.RegisterResourceIdentity(alertrules.ValidateAlertRuleID, &alertrules.AlertRuleId{})
+	pluginsdk.RegisterResourceIdentity(namespaces_2022_10_01_preview.ValidateNamespaceID, &namespaces_2022_10_01_preview.NamespaceId{})
+	pluginsdk.RegisterResourceIdentity(managedcluster.ValidateManagedClusterID, &managedcluster.ManagedClusterId{})
+	pluginsdk.RegisterResourceIdentity(associationsinterface.ValidateAssociationID, &associationsinterface.AssociationId{})
+	pluginsdk.RegisterResourceIdentity(frontendsinterface.ValidateFrontendID, &frontendsinterface.FrontendId{})
+	pluginsdk.RegisterResourceIdentity(trafficcontrollerinterface.ValidateTrafficControllerID, &trafficcontrollerinterface.TrafficControllerId{})
+	pluginsdk.RegisterResourceIdentity(signalr.ValidateCustomCertificateID, &signalr.CustomCertificateId{})
+	pluginsdk.RegisterResourceIdentity(signalr.ValidateCustomDomainID, &signalr.CustomDomainId{})
+	pluginsdk.RegisterResourceIdentity(jobs_2023_08_01_preview.ValidateJobID, &jobs_2023_08_01_preview.JobId{})
+	pluginsdk.RegisterResourceIdentity(jobsteps.ValidateStepID, &jobsteps.StepId{})
+	pluginsdk.RegisterResourceIdentity(jobtargetgroups.ValidateTargetGroupID, &jobtargetgroups.TargetGroupId{})
+	pluginsdk.RegisterResourceIdentity(availabilitygrouplisteners.ValidateAvailabilityGroupListenerID, &availabilitygrouplisteners.AvailabilityGroupListenerId{})
+	pluginsdk.RegisterResourceIdentity(availabilitygrouplisteners.ValidateSqlVirtualMachineGroupID, &availabilitygrouplisteners.SqlVirtualMachineGroupId{})
+	pluginsdk.RegisterResourceIdentity(standbyvirtualmachinepools.ValidateStandbyVirtualMachinePoolID, &standbyvirtualmachinepools.StandbyVirtualMachinePoolId{})
+	pluginsdk.RegisterResourceIdentity(amlfilesystems.ValidateAmlFilesystemID, &amlfilesystems.AmlFilesystemId{})
+	pluginsdk.RegisterResourceIdentity(agents.ValidateAgentID, &agents.AgentId{})
+	pluginsdk.RegisterResourceIdentity(endpoints.ValidateEndpointID, &endpoints.EndpointId{})
+	pluginsdk.RegisterResourceIdentity(jobdefinitions.ValidateJobDefinitionID, &jobdefinitions.JobDefinitionId{})
+	pluginsdk.RegisterResourceIdentity(projects_2023_03_01.ValidateProjectID, &projects_2023_03_01.ProjectId{})
+	pluginsdk.RegisterResourceIdentity(storagemovers.ValidateStorageMoverID, &storagemovers.StorageMoverId{})
+	pluginsdk.RegisterResourceIdentity(serverendpointresource.ValidateServerEndpointID, &serverendpointresource.ServerEndpointId{})
+	pluginsdk.RegisterResourceIdentity(clusters_2020_03_01.ValidateClusterID, &clusters_2020_03_01.ClusterId{})
+	pluginsdk.RegisterResourceIdentity(inputs.ValidateInputID, &inputs.InputId{})
+	pluginsdk.RegisterResourceIdentity(privateendpoints.ValidatePrivateEndpointID, &privateendpoints.PrivateEndpointId{})
+	pluginsdk.RegisterResourceIdentity(outputs.ValidateOutputID, &outputs.OutputId{})
+	pluginsdk.RegisterResourceIdentity(streamingjobs.ValidateStreamingJobID, &streamingjobs.StreamingJobId{})
+	pluginsdk.RegisterResourceIdentity(availabilitysets.ValidateAvailabilitySetID, &availabilitysets.AvailabilitySetId{})
+	pluginsdk.RegisterResourceIdentity(clouds.ValidateCloudID, &clouds.CloudId{})
+	pluginsdk.RegisterResourceIdentity(virtualmachinetemplates.ValidateVirtualMachineTemplateID, &virtualmachinetemplates.VirtualMachineTemplateId{})
+	pluginsdk.RegisterResourceIdentity(virtualnetworks_2023_10_07.ValidateVirtualNetworkID, &virtualnetworks_2023_10_07.VirtualNetworkId{})
+	pluginsdk.RegisterResourceIdentity(vmmservers.ValidateVMmServerID, &vmmservers.VMmServerId{})
+	pluginsdk.RegisterResourceIdentity(accounts_2025_04_01.ValidateAccountID, &accounts_2025_04_01.AccountId{})
+	pluginsdk.RegisterResourceIdentity(datastores.ValidateDataStoreID, &datastores.DataStoreId{})
+	pluginsdk.RegisterResourceIdentity(communicationsgateways.ValidateCommunicationsGatewayID, &communicationsgateways.CommunicationsGatewayId{})
+	pluginsdk.RegisterResourceIdentity(testlines.ValidateTestLineID, &testlines.TestLineId{})
+	pluginsdk.RegisterResourceIdentity(staticsites.ValidateCustomDomainID, &staticsites.CustomDomainId{})
+	pluginsdk.RegisterResourceIdentity(staticsites.ValidateStaticSiteID, &staticsites.StaticSiteId{})
+	pluginsdk.RegisterResourceIdentity(staticsites.ValidateUserProvidedFunctionAppID, &staticsites.UserProvidedFunctionAppId{})
+	pluginsdk.RegisterResourceIdentity(webapps.ValidateFunctionID, &webapps.FunctionId{})
+	pluginsdk.RegisterResourceIdentity(webapps.ValidateRelayID, &webapps.RelayId{})
+	pluginsdk.RegisterResourceIdentity(webapps.ValidateSlotID, &webapps.SlotId{})
+	pluginsdk.RegisterResourceIdentity(webpubsub.ValidateCustomCertificateID, &webpubsub.CustomCertificateId{})
+	pluginsdk.RegisterResourceIdentity(webpubsub.ValidateCustomDomainID, &webpubsub.CustomDomainId{})
+	pluginsdk.RegisterResourceIdentity(webpubsub.ValidateWebPubSubID, &webpubsub.WebPubSubId{})
+	pluginsdk.RegisterResourceIdentity(sapvirtualinstances.ValidateSapVirtualInstanceID, &sapvirtualinstances.SapVirtualInstanceId{})
+}
+
+// untypedResourceIdentities returns the Resource ID types validated by the Importer of Untyped Resources which don't
+// define a Resource Identity, so that a Resource Identity is generated for these Resources
+func untypedResourceIdentities() map[string]resourceids.ResourceId {
+	return map[string]resourceids.ResourceId{
+		"azurerm_analysis_services_server":                                  &servers_2017_08_01.ServerId{},
+		"azurerm_api_connection":                                            &connections.ConnectionId{},
+		"azurerm_api_management":                                            &apimanagementservice.ServiceId{},
+		"azurerm_api_management_api":                                        &api.ApiId{},
+		"azurerm_api_management_api_diagnostic":                             &apidiagnostic.ApiDiagnosticId{},
+		"azurerm_api_management_api_operation":                              &apioperation.OperationId{},
+		"azurerm_api_management_api_operation_policy":                       &apioperationpolicy.OperationId{},
+		"azurerm_api_management_api_operation_tag":                          &apioperationtag.OperationTagId{},
+		"azurerm_api_management_api_policy":                                 &apipolicy.ApiId{},
+		"azurerm_api_management_api_release":                                &apirelease.ReleaseId{},
+		"azurerm_api_management_api_schema":                                 &apischema.ApiSchemaId{},
+		"azurerm_api_management_api_tag":                                    &apitag.ApiTagId{},
+		"azurerm_api_management_api_tag_description":                        &apitagdescription.TagDescriptionId{},
+		"azurerm_api_management_api_version_set":                            &apiversionset.ApiVersionSetId{},
+		"azurerm_api_management_authorization_server":                       &authorizationserver.AuthorizationServerId{},
+		"azurerm_api_management_backend":                                    &backend.BackendId{},
+		"azurerm_api_management_certificate":                                &certificate_2022_08_01.CertificateId{},
+		"azurerm_api_management_diagnostic":                                 &diagnostic.DiagnosticId{},
+		"azurerm_api_management_gateway":                                    &gateway.GatewayId{},
+		"azurerm_api_management_gateway_api":                                &gatewayapi.GatewayApiId{},
+		"azurerm_api_management_gateway_certificate_authority":              &gatewaycertificateauthority.CertificateAuthorityId{},
+		"azurerm_api_management_gateway_host_name_configuration":            &gatewayhostnameconfiguration.HostnameConfigurationId{},
+		"azurerm_api_management_global_schema":                              &schema.SchemaId{},
+		"azurerm_api_management_group":                                      &group.GroupId{},
+		"azurerm_api_management_group_user":                                 &groupuser.GroupUserId{},
+		"azurerm_api_management_logger":                                     &logger.LoggerId{},
+		"azurerm_api_management_named_value":                                &namedvalue.NamedValueId{},
+		"azurerm_api_management_openid_connect_provider":                    &openidconnectprovider.OpenidConnectProviderId{},
+		"azurerm_api_management_policy":                                     &policy.ServiceId{},
+		"azurerm_api_management_product":                                    &product.ProductId{},
+		"azurerm_api_management_product_api":                                &productapi.ProductApiId{},
+		"azurerm_api_management_product_group":                              &productgroup.ProductGroupId{},
+		"azurerm_api_management_product_policy":                             &productpolicy.ProductId{},
+		"azurerm_api_management_product_tag":                                &producttag.ProductTagId{},
+		"azurerm_api_management_redis_cache":                                &cache.CacheId{},
+		"azurerm_api_management_subscription":                               &subscription.Subscriptions2Id{},
+		"azurerm_api_management_tag":                                        &tag.TagId{},
+		"azurerm_api_management_user":                                       &user.UserId{},
+		"azurerm_app_configuration":                                         &configurationstores.ConfigurationStoreId{},
+		"azurerm_app_service_public_certificate":                            &webapps.PublicCertificateId{},
+		"azurerm_application_insights":                                      &componentsapis.ComponentId{},
+		"azurerm_application_insights_api_key":                              &componentapikeysapis.ApiKeyId{},
+		"azurerm_application_insights_smart_detection_rule":                 &componentproactivedetectionapis.ProactiveDetectionConfigId{},
+		"azurerm_application_insights_web_test":                             &webtestsapis.WebTestId{},
+		"azurerm_arc_kubernetes_cluster":                                    &connectedclusters.ConnectedClusterId{},
+		"azurerm_attestation_provider":                                      &attestationproviders.AttestationProvidersId{},
+		"azurerm_automation_account":                                        &automationaccount.AutomationAccountId{},
+		"azurerm_automation_certificate":                                    &certificate_2023_11_01.CertificateId{},
+		"azurerm_automation_connection":                                     &connection.ConnectionId{},
+		"azurerm_automation_credential":                                     &credential.CredentialId{},
+		"azurerm_automation_dsc_configuration":                              &dscconfiguration.ConfigurationId{},
+		"azurerm_automation_dsc_nodeconfiguration":                          &dscnodeconfiguration.NodeConfigurationId{},
+		"azurerm_automation_module":                                         &module.ModuleId{},
+		"azurerm_automation_runbook":                                        &runbook.RunbookId{},
+		"azurerm_automation_schedule":                                       &schedule.ScheduleId{},
+		"azurerm_automation_variable_bool":                                  &variable.VariableId{},
+		"azurerm_automation_variable_datetime":                              &variable.VariableId{},
+		"azurerm_automation_variable_int":                                   &variable.VariableId{},
+		"azurerm_automation_variable_object":                                &variable.VariableId{},
+		"azurerm_automation_variable_string":                                &variable.VariableId{},
+		"azurerm_automation_webhook":                                        &webhook.WebHookId{},
+		"azurerm_backup_container_storage_account":                          &protectioncontainers.ProtectionContainerId{},
+		"azurerm_backup_policy_file_share":                                  &protectionpolicies.BackupPolicyId{},
+		"azurerm_backup_policy_vm":                                          &protectionpolicies.BackupPolicyId{},
+		"azurerm_backup_protected_vm":                                       &protecteditems.ProtectedItemId{},
+		"azurerm_batch_account":                                             &batchaccount.BatchAccountId{},
+		"azurerm_batch_application":                                         &application_2024_07_01.ApplicationId{},
+		"azurerm_batch_certificate":                                         &certificate_2024_07_01.CertificateId{},
+		"azurerm_batch_pool":                                                &pool.PoolId{},
+		"azurerm_cdn_frontdoor_firewall_policy":                             &webapplicationfirewallpolicies_2024_02_01.FrontDoorWebApplicationFirewallPolicyId{},
+		"azurerm_cdn_frontdoor_profile":                                     &profiles_2024_02_01.ProfileId{},
+		"azurerm_cdn_frontdoor_rule":                                        &rules_2024_09_01.RuleId{},
+		"azurerm_cdn_frontdoor_rule_set":                                    &rulesets.RuleSetId{},
+		"azurerm_cdn_frontdoor_security_policy":                             &securitypolicies.SecurityPolicyId{},
+		"azurerm_cognitive_account":                                         &cognitiveservicesaccounts.AccountId{},
+		"azurerm_cognitive_account_customer_managed_key":                    &cognitiveservicesaccounts.AccountId{},
+		"azurerm_confidential_ledger":                                       &confidentialledger.LedgerId{},
+		"azurerm_container_group":                                           &containerinstance.ContainerGroupId{},
+		"azurerm_container_registry":                                        &registries.RegistryId{},
+		"azurerm_container_registry_agent_pool":                             &agentpools.AgentPoolId{},
+		"azurerm_container_registry_scope_map":                              &scopemaps.ScopeMapId{},
+		"azurerm_container_registry_token":                                  &tokens.TokenId{},
+		"azurerm_container_registry_webhook":                                &webhooks.WebHookId{},
+		"azurerm_cosmosdb_cassandra_datacenter":                             &managedcassandras.DataCenterId{},
+		"azurerm_custom_provider":                                           &customresourceprovider.ResourceProviderId{},
+		"azurerm_data_factory":                                              &factories.FactoryId{},
+		"azurerm_data_factory_data_flow":                                    &dataflows.DataflowId{},
+		"azurerm_data_factory_flowlet_data_flow":                            &dataflows.DataflowId{},
+		"azurerm_data_factory_integration_runtime_azure":                    &integrationruntimes.IntegrationRuntimeId{},
+		"azurerm_data_factory_integration_runtime_azure_ssis":               &integrationruntimes.IntegrationRuntimeId{},
+		"azurerm_data_factory_integration_runtime_self_hosted":              &integrationruntimes.IntegrationRuntimeId{},
+		"azurerm_data_factory_pipeline":                                     &pipelines.PipelineId{},
+		"azurerm_data_protection_backup_policy_postgresql":                  &backuppolicies.BackupPolicyId{},
+		"azurerm_data_protection_resource_guard":                            &resourceguards.ResourceGuardId{},
+		"azurerm_data_share":                                                &share.ShareId{},
+		"azurerm_data_share_account":                                        &account_2019_11_01.AccountId{},
+		"azurerm_data_share_dataset_blob_storage":                           &dataset.DataSetId{},
+		"azurerm_data_share_dataset_data_lake_gen2":                         &dataset.DataSetId{},
+		"azurerm_data_share_dataset_kusto_cluster":                          &dataset.DataSetId{},
+		"azurerm_data_share_dataset_kusto_database":                         &dataset.DataSetId{},
+		"azurerm_database_migration_project":                                &projectresource.ProjectId{},
+		"azurerm_database_migration_service":                                &serviceresource.ServiceId{},
+		"azurerm_databricks_virtual_network_peering":                        &vnetpeering.VirtualNetworkPeeringId{},
+		"azurerm_databricks_workspace":                                      &workspaces_2024_05_01.WorkspaceId{},
+		"azurerm_datadog_monitor":                                           &monitorsresource_2021_03_01.MonitorId{},
+		"azurerm_datadog_monitor_sso_configuration":                         &singlesignon.SingleSignOnConfigurationId{},
+		"azurerm_datadog_monitor_tag_rule":                                  &rules_2021_03_01.TagRuleId{},
+		"azurerm_dedicated_hardware_security_module":                        &dedicatedhsms.DedicatedHSMId{},
+		"azurerm_dev_test_global_vm_shutdown_schedule":                      &globalschedules.ScheduleId{},
+		"azurerm_dev_test_lab":                                              &labs.LabId{},
+		"azurerm_dev_test_linux_virtual_machine":                            &virtualmachines_2018_09_15.VirtualMachineId{},
+		"azurerm_dev_test_policy":                                           &policies.PolicyId{},
+		"azurerm_dev_test_schedule":                                         &schedules.LabScheduleId{},
+		"azurerm_dev_test_virtual_network":                                  &virtualnetworks_2018_09_15.VirtualNetworkId{},
+		"azurerm_dev_test_windows_virtual_machine":                          &virtualmachines_2018_09_15.VirtualMachineId{},
+		"azurerm_digital_twins_instance":                                    &digitaltwinsinstance.DigitalTwinsInstanceId{},
+		"azurerm_disk_access":                                               &diskaccesses.DiskAccessId{},
+		"azurerm_elastic_cloud_elasticsearch":                               &monitorsresource_2023_06_01.MonitorId{},
+		"azurerm_eventgrid_domain":                                          &domains_2022_06_15.DomainId{},
+		"azurerm_eventgrid_domain_topic":                                    &domaintopics.DomainTopicId{},
+		"azurerm_eventgrid_system_topic":                                    &systemtopics.SystemTopicId{},
+		"azurerm_eventgrid_system_topic_event_subscription":                 &eventsubscriptions.SystemTopicEventSubscriptionId{},
+		"azurerm_eventgrid_topic":                                           &topics_2022_06_15.TopicId{},
+		"azurerm_eventhub":                                                  &eventhubs.EventhubId{},
+		"azurerm_eventhub_authorization_rule":                               &eventhubs.EventhubAuthorizationRuleId{},
+		"azurerm_eventhub_cluster":                                          &eventhubsclusters.ClusterId{},
+		"azurerm_eventhub_namespace":                                        &namespaces_2024_01_01.NamespaceId{},
+		"azurerm_eventhub_namespace_authorization_rule":                     &authorizationrulesnamespaces.AuthorizationRuleId{},
+		"azurerm_eventhub_namespace_disaster_recovery_config":               &disasterrecoveryconfigs_2024_01_01.DisasterRecoveryConfigId{},
+		"azurerm_eventhub_namespace_schema_group":                           &schemaregistry.SchemaGroupId{},
+		"azurerm_express_route_circuit":                                     &expressroutecircuits.ExpressRouteCircuitId{},
+		"azurerm_express_route_circuit_authorization":                       &expressroutecircuitauthorizations.AuthorizationId{},
+		"azurerm_express_route_circuit_connection":                          &expressroutecircuitconnections.PeeringConnectionId{},
+		"azurerm_express_route_circuit_peering":                             &commonids.ExpressRouteCircuitPeeringId{},
+		"azurerm_express_route_connection":                                  &expressrouteconnections.ExpressRouteConnectionId{},
+		"azurerm_express_route_gateway":                                     &expressroutegateways.ExpressRouteGatewayId{},
+		"azurerm_express_route_port":                                        &expressrouteports.ExpressRoutePortId{},
+		"azurerm_express_route_port_authorization":                          &expressrouteportauthorizations.ExpressRoutePortAuthorizationId{},
+		"azurerm_firewall_policy_rule_collection_group":                     &firewallpolicyrulecollectiongroups.RuleCollectionGroupId{},
+		"azurerm_frontdoor":                                                 &frontdoors.FrontDoorId{},
+		"azurerm_hdinsight_hadoop_cluster":                                  &commonids.HDInsightClusterId{},
+		"azurerm_hdinsight_hbase_cluster":                                   &commonids.HDInsightClusterId{},
+		"azurerm_hdinsight_interactive_query_cluster":                       &commonids.HDInsightClusterId{},
+		"azurerm_hdinsight_kafka_cluster":                                   &commonids.HDInsightClusterId{},
+		"azurerm_hdinsight_spark_cluster":                                   &commonids.HDInsightClusterId{},
+		"azurerm_healthbot":                                                 &healthbots.HealthBotId{},
+		"azurerm_healthcare_dicom_service":                                  &dicomservices.DicomServiceId{},
+		"azurerm_healthcare_fhir_service":                                   &fhirservices.FhirServiceId{},
+		"azurerm_healthcare_medtech_service":                                &iotconnectors.IotConnectorId{},
+		"azurerm_healthcare_medtech_service_fhir_destination":               &iotconnectors.FhirDestinationId{},
+		"azurerm_healthcare_service":                                        &resource.ServiceId{},
+		"azurerm_healthcare_workspace":                                      &workspaces_2024_03_31.WorkspaceId{},
+		"azurerm_hpc_cache":                                                 &caches.CacheId{},
+		"azurerm_hpc_cache_blob_nfs_target":                                 &storagetargets.StorageTargetId{},
+		"azurerm_hpc_cache_blob_target":                                     &storagetargets.StorageTargetId{},
+		"azurerm_hpc_cache_nfs_target":                                      &storagetargets.StorageTargetId{},
+		"azurerm_image":                                                     &images.ImageId{},
+		"azurerm_iotcentral_application":                                    &apps.IotAppId{},
+		"azurerm_iothub_dps":                                                &commonids.ProvisioningServiceId{},
+		"azurerm_iothub_dps_certificate":                                    &dpscertificate.CertificateId{},
+		"azurerm_iothub_dps_shared_access_policy":                           &iotdpsresource.KeyId{},
+		"azurerm_key_vault":                                                 &commonids.KeyVaultId{},
+		"azurerm_key_vault_managed_hardware_security_module":                &managedhsms.ManagedHSMId{},
+		"azurerm_kusto_attached_database_configuration":                     &attacheddatabaseconfigurations.AttachedDatabaseConfigurationId{},
+		"azurerm_kusto_cluster":                                             &commonids.KustoClusterId{},
+		"azurerm_kusto_cluster_customer_managed_key":                        &commonids.KustoClusterId{},
+		"azurerm_kusto_cluster_managed_private_endpoint":                    &managedprivateendpoints_2024_04_13.ManagedPrivateEndpointId{},
+		"azurerm_kusto_cluster_principal_assignment":                        &clusterprincipalassignments.PrincipalAssignmentId{},
+		"azurerm_kusto_database":                                            &commonids.KustoDatabaseId{},
+		"azurerm_kusto_database_principal_assignment":                       &databaseprincipalassignments.DatabasePrincipalAssignmentId{},
+		"azurerm_kusto_script":                                              &scripts.ScriptId{},
+		"azurerm_lb":                                                        &loadbalancers.LoadBalancerId{},
+		"azurerm_log_analytics_cluster_customer_managed_key":                &clusters_2022_10_01.ClusterId{},
+		"azurerm_log_analytics_data_export_rule":                            &dataexport.DataExportId{},
+		"azurerm_log_analytics_linked_service":                              &linkedservices.LinkedServiceId{},
+		"azurerm_log_analytics_saved_search":                                &savedsearches.SavedSearchId{},
+		"azurerm_log_analytics_workspace":                                   &workspaces_2022_10_01.WorkspaceId{},
+		"azurerm_logic_app_integration_account":                             &integrationaccounts.IntegrationAccountId{},
+		"azurerm_logic_app_integration_account_agreement":                   &integrationaccountagreements.AgreementId{},
+		"azurerm_logic_app_integration_account_assembly":                    &integrationaccountassemblies.AssemblyId{},
+		"azurerm_logic_app_integration_account_batch_configuration":         &integrationaccountbatchconfigurations.BatchConfigurationId{},
+		"azurerm_logic_app_integration_account_certificate":                 &integrationaccountcertificates.CertificateId{},
+		"azurerm_logic_app_integration_account_map":                         &integrationaccountmaps.MapId{},
+		"azurerm_logic_app_integration_account_partner":                     &integrationaccountpartners.PartnerId{},
+		"azurerm_logic_app_integration_account_schema":                      &integrationaccountschemas.SchemaId{},
+		"azurerm_logic_app_integration_account_session":                     &integrationaccountsessions.SessionId{},
+		"azurerm_logic_app_trigger_custom":                                  &workflowtriggers.TriggerId{},
+		"azurerm_logic_app_trigger_http_request":                            &workflowtriggers.TriggerId{},
+		"azurerm_logic_app_trigger_recurrence":                              &workflowtriggers.TriggerId{},
+		"azurerm_logic_app_workflow":                                        &workflows.WorkflowId{},
+		"azurerm_machine_learning_compute_cluster":                          &machinelearningcomputes.ComputeId{},
+		"azurerm_machine_learning_compute_instance":                         &machinelearningcomputes.ComputeId{},
+		"azurerm_machine_learning_inference_cluster":                        &machinelearningcomputes.ComputeId{},
+		"azurerm_machine_learning_synapse_spark":                            &machinelearningcomputes.ComputeId{},
+		"azurerm_machine_learning_workspace":                                &workspaces_2024_04_01.WorkspaceId{},
+		"azurerm_managed_application":                                       &applications.ApplicationId{},
+		"azurerm_managed_application_definition":                            &applicationdefinitions.ApplicationDefinitionId{},
+		"azurerm_managed_disk_sas_token":                                    &commonids.ManagedDiskId{},
+		"azurerm_management_group_subscription_association":                 &managementgroups.SubscriptionId{},
+		"azurerm_maps_account":                                              &accounts_2023_06_01.AccountId{},
+		"azurerm_marketplace_agreement":                                     &agreements.PlanId{},
+		"azurerm_monitor_aad_diagnostic_setting":                            &diagnosticsettings.DiagnosticSettingId{},
+		"azurerm_monitor_activity_log_alert":                                &activitylogalertsapis.ActivityLogAlertId{},
+		"azurerm_monitor_autoscale_setting":                                 &autoscalesettings.AutoScaleSettingId{},
+		"azurerm_monitor_metric_alert":                                      &metricalerts.MetricAlertId{},
+		"azurerm_monitor_private_link_scope":                                &privatelinkscopesapis.PrivateLinkScopeId{},
+		"azurerm_monitor_private_link_scoped_service":                       &privatelinkscopedresources.ScopedResourceId{},
+		"azurerm_monitor_scheduled_query_rules_log":                         &scheduledqueryrules_2018_04_16.ScheduledQueryRuleId{},
+		"azurerm_monitor_smart_detector_alert_rule":                         &smartdetectoralertrules.SmartDetectorAlertRuleId{},
+		"azurerm_mssql_firewall_rule":                                       &firewallrules_2023_08_01_preview.FirewallRuleId{},
+		"azurerm_mssql_virtual_machine":                                     &sqlvirtualmachines.SqlVirtualMachineId{},
+		"azurerm_mysql_flexible_database":                                   &databases_2023_12_30.DatabaseId{},
+		"azurerm_mysql_flexible_server":                                     &servers_2023_12_30.FlexibleServerId{},
+		"azurerm_mysql_flexible_server_configuration":                       &configurations_2023_12_30.ConfigurationId{},
+		"azurerm_mysql_flexible_server_firewall_rule":                       &firewallrules_2023_12_30.FirewallRuleId{},
+		"azurerm_netapp_account":                                            &netappaccounts.NetAppAccountId{},
+		"azurerm_netapp_pool":                                               &capacitypools.CapacityPoolId{},
+		"azurerm_netapp_snapshot":                                           &snapshots_2025_01_01.SnapshotId{},
+		"azurerm_netapp_snapshot_policy":                                    &snapshotpolicy.SnapshotPolicyId{},
+		"azurerm_netapp_volume":                                             &volumes_2025_01_01.VolumeId{},
+		"azurerm_network_connection_monitor":                                &connectionmonitors.ConnectionMonitorId{},
+		"azurerm_network_ddos_protection_plan":                              &ddosprotectionplans.DdosProtectionPlanId{},
+		"azurerm_network_watcher":                                           &networkwatchers.NetworkWatcherId{},
+		"azurerm_network_watcher_flow_log":                                  &flowlogs.FlowLogId{},
+		"azurerm_notification_hub":                                          &hubs.NotificationHubId{},
+		"azurerm_notification_hub_authorization_rule":                       &hubs.NotificationHubAuthorizationRuleId{},
+		"azurerm_notification_hub_namespace":                                &namespaces_2023_09_01.NamespaceId{},
+		"azurerm_point_to_site_vpn_gateway":                                 &commonids.VirtualWANP2SVPNGatewayId{},
+		"azurerm_policy_virtual_machine_configuration_assignment":           &guestconfigurationassignments.Providers2GuestConfigurationAssignmentId{},
+		"azurerm_portal_dashboard":                                          &dashboard.DashboardId{},
+		"azurerm_postgresql_flexible_server":                                &servers_2024_08_01.FlexibleServerId{},
+		"azurerm_postgresql_flexible_server_active_directory_administrator": &administrators.AdministratorId{},
+		"azurerm_postgresql_flexible_server_configuration":                  &configurations_2024_08_01.ConfigurationId{},
+		"azurerm_postgresql_flexible_server_database":                       &databases_2024_08_01.DatabaseId{},
+		"azurerm_powerbi_embedded":                                          &capacities.CapacityId{},
+		"azurerm_public_ip":                                                 &commonids.PublicIPAddressId{},
+		"azurerm_purview_account":                                           &account_2021_12_01.AccountId{},
+		"azurerm_recovery_services_vault":                                   &vaults.VaultId{},
+		"azurerm_redis_cache":                                               &redis.RediId{},
+		"azurerm_redis_enterprise_cluster":                                  &redisenterprise.RedisEnterpriseId{},
+		"azurerm_redis_enterprise_database":                                 &redisenterprise.DatabaseId{},
+		"azurerm_redis_linked_server":                                       &redis.LinkedServerId{},
+		"azurerm_relay_hybrid_connection":                                   &hybridconnections.HybridConnectionId{},
+		"azurerm_relay_hybrid_connection_authorization_rule":                &hybridconnections.HybridConnectionAuthorizationRuleId{},
+		"azurerm_relay_namespace":                                           &namespaces_2021_11_01.NamespaceId{},
+		"azurerm_relay_namespace_authorization_rule":                        &namespaces_2021_11_01.AuthorizationRuleId{},
+		"azurerm_search_service":                                            &services.SearchServiceId{},
+		"azurerm_security_center_assessment_policy":                         &assessmentsmetadata.ProviderAssessmentMetadataId{},
+		"azurerm_service_fabric_cluster":                                    &cluster.ClusterId{},
+		"azurerm_servicebus_namespace":                                      &namespaces_2022_10_01_preview.NamespaceId{},
+		"azurerm_servicebus_namespace_authorization_rule":                   &namespacesauthorizationrule.AuthorizationRuleId{},
+		"azurerm_servicebus_namespace_disaster_recovery_config":             &disasterrecoveryconfigs_2021_06_01_preview.DisasterRecoveryConfigId{},
+		"azurerm_servicebus_queue":                                          &queues.QueueId{},
+		"azurerm_servicebus_queue_authorization_rule":                       &queuesauthorizationrule.QueueAuthorizationRuleId{},
+		"azurerm_servicebus_subscription":                                   &subscriptions.Subscriptions2Id{},
+		"azurerm_servicebus_subscription_rule":                              &rules_2021_06_01_preview.RuleId{},
+		"azurerm_servicebus_topic":                                          &topics_2021_06_01_preview.TopicId{},
+		"azurerm_servicebus_topic_authorization_rule":                       &topicsauthorizationrule.TopicAuthorizationRuleId{},
+		"azurerm_shared_image_version":                                      &galleryimageversions.ImageVersionId{},
+		"azurerm_signalr_service":                                           &signalr.SignalRId{},
+		"azurerm_signalr_service_network_acl":                               &signalr.SignalRId{},
+		"azurerm_site_recovery_network_mapping":                             &replicationnetworkmappings.ReplicationNetworkMappingId{},
+		"azurerm_site_recovery_protection_container":                        &replicationprotectioncontainers.ReplicationProtectionContainerId{},
+		"azurerm_snapshot":                                                  &snapshots_2022_03_02.SnapshotId{},
+		"azurerm_ssh_public_key":                                            &sshpublickeys.SshPublicKeyId{},
+		"azurerm_stack_hci_cluster":                                         &clusters_2024_01_01.ClusterId{},
+		"azurerm_storage_sync":                                              &storagesyncservicesresource.StorageSyncServiceId{},
+		"azurerm_storage_sync_group":                                        &syncgroupresource.SyncGroupId{},
+		"azurerm_stream_analytics_function_javascript_uda":                  &functions.FunctionId{},
+		"azurerm_stream_analytics_function_javascript_udf":                  &functions.FunctionId{},
+		"azurerm_stream_analytics_job":                                      &streamingjobs.StreamingJobId{},
+		"azurerm_stream_analytics_stream_input_blob":                        &inputs.InputId{},
+		"azurerm_stream_analytics_stream_input_eventhub":                    &inputs.InputId{},
+		"azurerm_stream_analytics_stream_input_iothub":                      &inputs.InputId{},
+		"azurerm_subnet_nat_gateway_association":                            &commonids.SubnetId{},
+		"azurerm_subnet_network_security_group_association":                 &commonids.SubnetId{},
+		"azurerm_subnet_route_table_association":                            &commonids.SubnetId{},
+		"azurerm_traffic_manager_profile":                                   &profiles_2022_04_01.TrafficManagerProfileId{},
+		"azurerm_virtual_desktop_application":                               &application_2024_04_03.ApplicationId{},
+		"azurerm_virtual_desktop_application_group":                         &applicationgroup.ApplicationGroupId{},
+		"azurerm_virtual_desktop_host_pool":                                 &hostpool.HostPoolId{},
+		"azurerm_virtual_desktop_scaling_plan":                              &scalingplan.ScalingPlanId{},
+		"azurerm_virtual_desktop_workspace":                                 &workspace_2024_04_03.WorkspaceId{},
+		"azurerm_virtual_hub_security_partner_provider":                     &securitypartnerproviders.SecurityPartnerProviderId{},
+		"azurerm_virtual_machine":                                           &virtualmachines_2024_03_01.VirtualMachineId{},
+		"azurerm_virtual_machine_packet_capture":                            &packetcaptures.PacketCaptureId{},
+		"azurerm_virtual_machine_scale_set":                                 &virtualmachinescalesets.VirtualMachineScaleSetId{},
+		"azurerm_virtual_machine_scale_set_extension":                       &virtualmachinescalesetextensions.VirtualMachineScaleSetExtensionId{},
+		"azurerm_virtual_machine_scale_set_packet_capture":                  &packetcaptures.PacketCaptureId{},
+		"azurerm_virtual_network_gateway":                                   &virtualnetworkgateways.VirtualNetworkGatewayId{},
+		"azurerm_virtual_network_gateway_connection":                        &virtualnetworkgatewayconnections.ConnectionId{},
+		"azurerm_virtual_network_gateway_nat_rule":                          &virtualnetworkgateways.VirtualNetworkGatewayNatRuleId{},
+		"azurerm_virtual_network_peering":                                   &virtualnetworkpeerings.VirtualNetworkPeeringId{},
+		"azurerm_virtual_wan":                                               &virtualwans.VirtualWANId{},
+		"azurerm_vmware_cluster":                                            &clusters_2022_05_01.ClusterId{},
+		"azurerm_vmware_express_route_authorization":                        &authorizations.AuthorizationId{},
+		"azurerm_vmware_private_cloud":                                      &privateclouds.PrivateCloudId{},
+		"azurerm_vpn_gateway":                                               &virtualwans.VpnGatewayId{},
+		"azurerm_vpn_gateway_connection":                                    &commonids.VPNConnectionId{},
+		"azurerm_vpn_gateway_nat_rule":                                      &virtualwans.NatRuleId{},
+		"azurerm_vpn_server_configuration":                                  &virtualwans.VpnServerConfigurationId{},
+		"azurerm_vpn_server_configuration_policy_group":                     &virtualwans.ConfigurationPolicyGroupId{},
+		"azurerm_vpn_site":                                                  &virtualwans.VpnSiteId{},
+		"azurerm_web_application_firewall_policy":                           &webapplicationfirewallpolicies_2024_05_01.ApplicationGatewayWebApplicationFirewallPolicyId{},
+		"azurerm_web_pubsub":                                                &webpubsub.WebPubSubId{},
+		"azurerm_web_pubsub_hub":                                            &webpubsub.HubId{},
+		"azurerm_web_pubsub_network_acl":                                    &webpubsub.WebPubSubId{},
+		"azurerm_web_pubsub_shared_private_link_resource":                   &webpubsub.SharedPrivateLinkResourceId{},
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// resourceProvidersForResource returns the Resource Providers used by the Resource within the Service - which are
+// those specified for the Resource if any, else those used by the Service
+func resourceProvidersForResource(service interface{}, resourceType string) []string {
+	var byResource map[string][]string
+	switch v := service.(type) {
+	case sdk.TypedServiceRegistrationWithResourceProvidersByResource:
+		byResource = v.ResourceProvidersByResource()
+	case sdk.UntypedServiceRegistrationWithResourceProvidersByResource:
+		byResource = v.ResourceProvidersByResource()
+	}
+	if resourceProviders, ok := byResource[resourceType]; ok {
+		return resourceProviders
+	}
+
+	switch v := service.(type) {
+	case sdk.TypedServiceRegistrationWithResourceProviders:
+		return v.ResourceProviders()
+	case sdk.UntypedServiceRegistrationWithResourceProviders:
+		return v.ResourceProviders()
+	}
+	return nil
+}
+
+// registerResourceProvidersOnDemand wraps the CRUD functions of the resource so that, when Resource Providers are
+// registered on-demand, the Resource Providers used by the resource are registered before the resource is created
+// or updated - these aren't registered when reading or deleting the resource, since a resource can only exist once
+// the Resource Providers it uses have been registered
+func registerResourceProvidersOnDemand(resource *schema.Resource, resourceProviders []string) {
+	if len(resourceProviders) == 0 {
+		return
+	}
+
+	requiredResourceProviders := make(resourceproviders.ResourceProviders)
+	requiredResourceProviders.Add(resourceProviders...)
+
+	wrapCrudFunctions(resource, func(ctx context.Context, operation string, _ *schema.ResourceData, meta interface{}, next func() error) error {
+		if operation == schema.TimeoutRead || operation == schema.TimeoutDelete {
+			return next()
+		}
+
+		if client, ok := meta.(*clients.Client); ok && client != nil && client.RegisterResourceProvidersOnDemand {
+			subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+			if err := resourceproviders.EnsureRegisteredOnDemand(ctx, client.Resource.ResourceProvidersClient, subscriptionId, requiredResourceProviders); err != nil {
+				return err
+			}
+		}
+
+		return next()
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestResourceProvidersForResource(t *testing.T) {
+	testData := map[string][]string{
+		// the Resource Providers used by only some of the Resources within the Service are only registered for these..
+		"azurerm_marketplace_agreement":                  {"Microsoft.MarketplaceOrdering"},
+		"azurerm_virtual_machine_scale_set_standby_pool": {"Microsoft.StandbyPool"},
+
+		// .. with the remaining Resources using the Resource Providers for the Service
+		"azurerm_linux_virtual_machine": {"Microsoft.Compute"},
+	}
+
+	for resourceType, expected := range testData {
+		if actual := resourceProvidersForResource(compute.Registration{}, resourceType); !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("expected the Resource Providers for %q to be %v but got %v", resourceType, expected, actual)
+		}
+	}
+}
+
+func TestRegisterResourceProvidersOnDemandNotOnReadOrDelete(t *testing.T) {
+	called := make(map[string]bool)
+	resource := &pluginsdk.Resource{
+		ReadContext: func(_ context.Context, _ *pluginsdk.ResourceData, _ interface{}) diag.Diagnostics {
+			called["read"] = true
+			return nil
+		},
+		DeleteContext: func(_ context.Context, _ *pluginsdk.ResourceData, _ interface{}) diag.Diagnostics {
+			called["delete"] = true
+			return nil
+		},
+	}
+	registerResourceProvidersOnDemand(resource, []string{"Microsoft.Compute"})
+
+	// registering the Resource Providers would require the (unset) Resource Providers client
+	meta := &clients.Client{
+		RegisterResourceProvidersOnDemand: true,
+	}
+	d := resource.TestResourceData()
+	if diags := resource.ReadContext(context.TODO(), d, meta); diags.HasError() {
+		t.Fatalf("reading: %+v", diags)
+	}
+	if diags := resource.DeleteContext(context.TODO(), d, meta); diags.HasError() {
+		t.Fatalf("deleting: %+v", diags)
+	}
+
+	if !called["read"] || !called["delete"] {
+		t.Fatalf("expected the Read and Delete functions to be called but got %+v", called)
+	}
+}
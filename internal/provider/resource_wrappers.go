@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// crudMiddleware is called in place of a CRUD function of a resource, where `operation` is the name of the timeout
+// for the CRUD function (e.g. `create`) and `next` calls the CRUD function itself
+type crudMiddleware func(ctx context.Context, operation string, d *schema.ResourceData, meta interface{}, next func() error) error
+
+// wrapCrudFunctions wraps each of the CRUD functions defined on the resource using the middleware
+func wrapCrudFunctions(resource *schema.Resource, middleware crudMiddleware) {
+	// the legacy CRUD functions don't receive a context, so (as within the CRUD functions themselves) one is built
+	// from the Stop Context using the timeout for the operation
+	wrapLegacy := func(f func(*schema.ResourceData, interface{}) error, operation string) func(*schema.ResourceData, interface{}) error {
+		return func(d *schema.ResourceData, meta interface{}) error {
+			ctx := context.Background()
+			if client, ok := meta.(*clients.Client); ok && client != nil && client.StopContext != nil {
+				ctx = client.StopContext
+			}
+			ctx, cancel := context.WithTimeout(ctx, d.Timeout(operation))
+			defer cancel()
+
+			return middleware(ctx, operation, d, meta, func() error {
+				return f(d, meta)
+			})
+		}
+	}
+
+	wrap := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, operation string) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			err := middleware(ctx, operation, d, meta, func() error {
+				diags = f(ctx, d, meta)
+				if diags.HasError() {
+					return diagnosticsError(diags)
+				}
+				return nil
+			})
+			if err != nil && !diags.HasError() {
+				return append(diags, diag.FromErr(err)...)
+			}
+			return diags
+		}
+	}
+
+	// the majority of the Untyped Resources use the (deprecated) legacy CRUD functions
+	if resource.Create != nil { //nolint:staticcheck
+		resource.Create = wrapLegacy(resource.Create, schema.TimeoutCreate) //nolint:staticcheck
+	}
+	if resource.Read != nil { //nolint:staticcheck
+		resource.Read = wrapLegacy(resource.Read, schema.TimeoutRead) //nolint:staticcheck
+	}
+	if resource.Update != nil { //nolint:staticcheck
+		resource.Update = wrapLegacy(resource.Update, schema.TimeoutUpdate) //nolint:staticcheck
+	}
+	if resource.Delete != nil { //nolint:staticcheck
+		resource.Delete = wrapLegacy(resource.Delete, schema.TimeoutDelete) //nolint:staticcheck
+	}
+
+	if resource.CreateContext != nil {
+		resource.CreateContext = wrap(resource.CreateContext, schema.TimeoutCreate)
+	}
+	if resource.ReadContext != nil {
+		resource.ReadContext = wrap(resource.ReadContext, schema.TimeoutRead)
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = wrap(resource.UpdateContext, schema.TimeoutUpdate)
+	}
+	if resource.DeleteContext != nil {
+		resource.DeleteContext = wrap(resource.DeleteContext, schema.TimeoutDelete)
+	}
+}
+
+// diagnosticsError returns an error containing the error diagnostics
+func diagnosticsError(diags diag.Diagnostics) error {
+	errs := make([]error, 0)
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		if d.Detail != "" {
+			errs = append(errs, fmt.Errorf("%s: %s", d.Summary, d.Detail))
+		} else {
+			errs = append(errs, errors.New(d.Summary))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -136,7 +136,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/workloads"
 )
 
-//go:generate go run ../tools/generator-services/main.go -path=../../
+//go:generate go run ../tools/generator-services -path=../../
+//go:generate go run ../tools/generator-tests resourceidentities -services-directory=../services
 
 func SupportedTypedServices() []sdk.TypedServiceRegistration {
 	services := []sdk.TypedServiceRegistration{
@@ -344,7 +345,13 @@ func SupportedFrameworkServices() []sdk.FrameworkTypedServiceRegistration {
 		// Services with Framework Resources, Data Sources, or Ephemeral Resources to be listed here
 		// e.g.
 		// resource.Registration{}
+		compute.Registration{},
+		containers.Registration{},
+		cosmos.Registration{},
+		eventhub.Registration{},
 		keyvault.Registration{},
+		servicebus.Registration{},
+		storage.Registration{},
 	}
 
 	return services
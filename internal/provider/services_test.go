@@ -207,3 +207,68 @@ func TestTypedResourcesUsePointersForOptionalProperties(t *testing.T) {
 		t.Fatalf("schema properties found with incorrect types - `Optional` should be pointers, `Required` should not be pointers")
 	}
 }
+
+func TestServicesWithResourcesSpecifyResourceProviders(t *testing.T) {
+	// This test confirms that each Service containing Resources specifies the Resource Providers
+	// it uses, which are registered the first time a Resource within the Service is used when
+	// Resource Providers are registered on-demand.
+	for _, service := range SupportedTypedServices() {
+		if len(service.Resources()) == 0 {
+			continue
+		}
+		v, ok := service.(sdk.TypedServiceRegistrationWithResourceProviders)
+		if !ok || len(v.ResourceProviders()) == 0 {
+			t.Errorf("the Typed Service %q contains Resources but doesn't specify the Resource Providers it uses", service.Name())
+		}
+	}
+
+	for _, service := range SupportedUntypedServices() {
+		if len(service.SupportedResources()) == 0 {
+			continue
+		}
+		v, ok := service.(sdk.UntypedServiceRegistrationWithResourceProviders)
+		if !ok || len(v.ResourceProviders()) == 0 {
+			t.Errorf("the Untyped Service %q contains Resources but doesn't specify the Resource Providers it uses", service.Name())
+		}
+	}
+}
+
+func TestServicesResourceProvidersByResource(t *testing.T) {
+	// This test confirms that the Resource Providers specified for specific Resources within a Service refer
+	// to Resources within that Service, since these would otherwise never be registered.
+	resourceTypes := make(map[string]map[string]struct{})
+	byResource := make(map[string]map[string][]string)
+	for _, service := range SupportedTypedServices() {
+		if _, ok := resourceTypes[service.Name()]; !ok {
+			resourceTypes[service.Name()] = make(map[string]struct{})
+		}
+		for _, r := range service.Resources() {
+			resourceTypes[service.Name()][r.ResourceType()] = struct{}{}
+		}
+		if v, ok := service.(sdk.TypedServiceRegistrationWithResourceProvidersByResource); ok {
+			byResource[service.Name()] = v.ResourceProvidersByResource()
+		}
+	}
+	for _, service := range SupportedUntypedServices() {
+		if _, ok := resourceTypes[service.Name()]; !ok {
+			resourceTypes[service.Name()] = make(map[string]struct{})
+		}
+		for k := range service.SupportedResources() {
+			resourceTypes[service.Name()][k] = struct{}{}
+		}
+		if v, ok := service.(sdk.UntypedServiceRegistrationWithResourceProvidersByResource); ok {
+			byResource[service.Name()] = v.ResourceProvidersByResource()
+		}
+	}
+
+	for serviceName, resourceProviders := range byResource {
+		for resourceType, v := range resourceProviders {
+			if _, ok := resourceTypes[serviceName][resourceType]; !ok {
+				t.Errorf("the Service %q specifies the Resource Providers for %q which isn't a Resource within the Service", serviceName, resourceType)
+			}
+			if len(v) == 0 {
+				t.Errorf("the Service %q specifies no Resource Providers for %q", serviceName, resourceType)
+			}
+		}
+	}
+}
@@ -14,17 +14,24 @@ import (
 )
 
 // cachedResourceProviders can be (validly) nil - as such this shouldn't be relied on
-var (
-	cachedResourceProviders       *[]string
-	registeredResourceProviders   map[string]struct{}
-	unregisteredResourceProviders map[string]struct{}
-)
+var cachedResourceProviders *[]string
+
+// registrationStates is the registration state of the Resource Providers within each Subscription, keyed by the
+// lower-cased Subscription ID - since a Provider can be configured for multiple Subscriptions using aliases
+var registrationStates = map[string]*registrationState{}
+
+// registrationState tracks which Resource Providers are (or aren't) registered within a Subscription, keyed by the
+// lower-cased namespace - since the casing of the namespaces returned by the API differs from those in use
+type registrationState struct {
+	registered   map[string]struct{}
+	unregistered map[string]struct{}
+}
 
 var cacheLock = &sync.Mutex{}
 
 // CacheSupportedProviders attempts to retrieve the supported Resource Providers from the Resource Manager API
 // and caches them, for used in enhanced validation
-func CacheSupportedProviders(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId) error {
+func CacheSupportedProviders(ctx context.Context, client ProvidersClient, subscriptionId commonids.SubscriptionId) error {
 	// already populated
 	if cachedResourceProviders != nil {
 		return nil
@@ -40,12 +47,11 @@ func CacheSupportedProviders(ctx context.Context, client *providers.ProvidersCli
 func ClearCache() {
 	cacheLock.Lock()
 	cachedResourceProviders = nil
-	registeredResourceProviders = nil
-	unregisteredResourceProviders = nil
+	registrationStates = map[string]*registrationState{}
 	cacheLock.Unlock()
 }
 
-func populateCache(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId) error {
+func populateCache(ctx context.Context, client ProvidersClient, subscriptionId commonids.SubscriptionId) error {
 	cacheLock.Lock()
 	defer cacheLock.Unlock()
 
@@ -55,8 +61,10 @@ func populateCache(ctx context.Context, client *providers.ProvidersClient, subsc
 	}
 
 	providerNames := make([]string, 0)
-	registeredResourceProviders = make(map[string]struct{})
-	unregisteredResourceProviders = make(map[string]struct{})
+	state := &registrationState{
+		registered:   make(map[string]struct{}),
+		unregistered: make(map[string]struct{}),
+	}
 	for _, provider := range providers.Items {
 		if provider.Namespace == nil {
 			continue
@@ -65,12 +73,38 @@ func populateCache(ctx context.Context, client *providers.ProvidersClient, subsc
 		providerNames = append(providerNames, *provider.Namespace)
 		registered := provider.RegistrationState != nil && strings.EqualFold(*provider.RegistrationState, "registered")
 		if registered {
-			registeredResourceProviders[*provider.Namespace] = struct{}{}
+			state.registered[strings.ToLower(*provider.Namespace)] = struct{}{}
 		} else {
-			unregisteredResourceProviders[*provider.Namespace] = struct{}{}
+			state.unregistered[strings.ToLower(*provider.Namespace)] = struct{}{}
 		}
 	}
 
 	cachedResourceProviders = &providerNames
+	registrationStates[strings.ToLower(subscriptionId.SubscriptionId)] = state
 	return nil
 }
+
+// isCached returns whether the registration state of the Resource Providers has been cached for the Subscription
+func isCached(subscriptionId commonids.SubscriptionId) bool {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	_, ok := registrationStates[strings.ToLower(subscriptionId.SubscriptionId)]
+	return cachedResourceProviders != nil && ok
+}
+
+// markAsRegistered updates the cache to reflect that the specified Resource Providers have been registered in the Subscription
+func markAsRegistered(subscriptionId commonids.SubscriptionId, providerNames []string) {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	state, ok := registrationStates[strings.ToLower(subscriptionId.SubscriptionId)]
+	if !ok {
+		return
+	}
+
+	for _, providerName := range providerNames {
+		delete(state.unregistered, strings.ToLower(providerName))
+		state.registered[strings.ToLower(providerName)] = struct{}{}
+	}
+}
@@ -15,7 +15,13 @@ import (
 
 var _ pollers.PollerType = &resourceProviderRegistrationPoller{}
 
-func NewResourceProviderRegistrationPoller(client *providers.ProvidersClient, id providers.SubscriptionProviderId) *resourceProviderRegistrationPoller {
+// ResourceProviderClient is the subset of the Providers client used to check the registration state of a Resource
+// Provider, which allows the client to be faked in tests
+type ResourceProviderClient interface {
+	Get(ctx context.Context, id providers.SubscriptionProviderId, options providers.GetOperationOptions) (providers.GetOperationResponse, error)
+}
+
+func NewResourceProviderRegistrationPoller(client ResourceProviderClient, id providers.SubscriptionProviderId) *resourceProviderRegistrationPoller {
 	return &resourceProviderRegistrationPoller{
 		client: client,
 		id:     id,
@@ -23,7 +29,7 @@ func NewResourceProviderRegistrationPoller(client *providers.ProvidersClient, id
 }
 
 type resourceProviderRegistrationPoller struct {
-	client *providers.ProvidersClient
+	client ResourceProviderClient
 	id     providers.SubscriptionProviderId
 }
 
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+// onDemandLocks ensures that the Resource Provider cache is only populated once for each Subscription, and that each
+// Resource Provider is only registered once within a Subscription, when multiple resources using them are provisioned
+// concurrently - without blocking resources using other Resource Providers (or Subscriptions) whilst registering
+var onDemandLocks = &keyedLocks{
+	locks: map[string]*sync.Mutex{},
+}
+
+type keyedLocks struct {
+	lock  sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+func (l *keyedLocks) get(key string) *sync.Mutex {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	key = strings.ToLower(key)
+	lock, ok := l.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		l.locks[key] = lock
+	}
+	return lock
+}
+
+// EnsureRegisteredOnDemand ensures that the Resource Providers used by a Service are registered, and is called prior
+// to each CRUD operation on a resource within that Service when Resource Providers are registered on-demand. The
+// registration state is tracked within the Resource Provider cache for each Subscription, so only the first call for a
+// Resource Provider which isn't registered in a Subscription will attempt to register it.
+func EnsureRegisteredOnDemand(ctx context.Context, client ProvidersClient, subscriptionId commonids.SubscriptionId, requiredRPs ResourceProviders) error {
+	if len(requiredRPs) == 0 {
+		return nil
+	}
+
+	if err := populateCacheOnDemand(ctx, client, subscriptionId); err != nil {
+		return err
+	}
+
+	providersToRegister, err := DetermineWhichRequiredResourceProvidersRequireRegistration(subscriptionId, requiredRPs)
+	if err != nil {
+		return fmt.Errorf("determining which Resource Providers require registration: %+v", err)
+	}
+
+	if len(*providersToRegister) == 0 {
+		return nil
+	}
+
+	// the locks are acquired in a consistent (sorted) order, so that Services sharing Resource Providers can't deadlock
+	for _, providerName := range *providersToRegister {
+		lock := onDemandLocks.get(fmt.Sprintf("%s/%s", subscriptionId.ID(), providerName))
+		lock.Lock()
+		defer lock.Unlock()
+	}
+
+	// these may have been registered whilst waiting for the locks
+	providersToRegister, err = DetermineWhichRequiredResourceProvidersRequireRegistration(subscriptionId, requiredRPs)
+	if err != nil {
+		return fmt.Errorf("determining which Resource Providers require registration: %+v", err)
+	}
+
+	if len(*providersToRegister) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Registering %d Resource Providers on-demand in %s", len(*providersToRegister), subscriptionId)
+	if err = registerForSubscription(ctx, client, subscriptionId, *providersToRegister); err != nil {
+		return userError(err)
+	}
+
+	markAsRegistered(subscriptionId, *providersToRegister)
+
+	return nil
+}
+
+// populateCacheOnDemand populates the Resource Provider cache for the Subscription, if it's not already populated
+func populateCacheOnDemand(ctx context.Context, client ProvidersClient, subscriptionId commonids.SubscriptionId) error {
+	lock := onDemandLocks.get(subscriptionId.ID())
+	lock.Lock()
+	defer lock.Unlock()
+
+	if isCached(subscriptionId) {
+		return nil
+	}
+
+	if err := populateCache(ctx, client, subscriptionId); err != nil {
+		return fmt.Errorf("populating Resource Provider cache: %+v", err)
+	}
+
+	return nil
+}
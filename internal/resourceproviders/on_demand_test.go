@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
+)
+
+var _ ProvidersClient = &fakeProvidersClient{}
+
+// fakeProvidersClient is an in-memory implementation of the Providers client, where Resource Providers are registered
+// immediately
+type fakeProvidersClient struct {
+	lock sync.Mutex
+
+	registrationStates map[string]string
+	listCalls          int
+	registered         []string
+	forbidden          bool
+
+	// blocked is a map of namespaces to a channel which registering that Resource Provider waits on, once
+	// signalling that it's started registering using the waiting channel
+	blocked map[string]chan struct{}
+	waiting chan struct{}
+}
+
+func (c *fakeProvidersClient) Get(_ context.Context, id providers.SubscriptionProviderId, _ providers.GetOperationOptions) (providers.GetOperationResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return providers.GetOperationResponse{
+		Model: &providers.Provider{
+			Namespace:         pointer.To(id.ProviderName),
+			RegistrationState: pointer.To(c.registrationStates[id.ProviderName]),
+		},
+	}, nil
+}
+
+func (c *fakeProvidersClient) ListComplete(_ context.Context, _ commonids.SubscriptionId, _ providers.ListOperationOptions) (providers.ListCompleteResult, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.listCalls++
+	result := providers.ListCompleteResult{}
+	for namespace, state := range c.registrationStates {
+		result.Items = append(result.Items, providers.Provider{
+			Namespace:         pointer.To(namespace),
+			RegistrationState: pointer.To(state),
+		})
+	}
+	return result, nil
+}
+
+func (c *fakeProvidersClient) Register(_ context.Context, id providers.SubscriptionProviderId, _ providers.ProviderRegistrationRequest) (providers.RegisterOperationResponse, error) {
+	if blocked, ok := c.blocked[id.ProviderName]; ok {
+		c.waiting <- struct{}{}
+		<-blocked
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.forbidden {
+		return providers.RegisterOperationResponse{
+			HttpResponse: &http.Response{StatusCode: http.StatusForbidden},
+		}, http.ErrNotSupported
+	}
+
+	c.registered = append(c.registered, id.ProviderName)
+	c.registrationStates[id.ProviderName] = "Registered"
+	return providers.RegisterOperationResponse{}, nil
+}
+
+func testEnsureRegisteredOnDemand(client *fakeProvidersClient, requiredRPs ResourceProviders) error {
+	return testEnsureRegisteredOnDemandInSubscription(client, "00000000-0000-0000-0000-000000000000", requiredRPs)
+}
+
+func testEnsureRegisteredOnDemandInSubscription(client *fakeProvidersClient, subscriptionId string, requiredRPs ResourceProviders) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 1*time.Minute)
+	defer cancel()
+
+	return EnsureRegisteredOnDemand(ctx, client, commonids.NewSubscriptionID(subscriptionId), requiredRPs)
+}
+
+func TestEnsureRegisteredOnDemand(t *testing.T) {
+	registrationPollInterval = 10 * time.Millisecond
+	defer func() {
+		registrationPollInterval = 10 * time.Second
+		ClearCache()
+	}()
+	ClearCache()
+
+	client := &fakeProvidersClient{
+		registrationStates: map[string]string{
+			"Microsoft.Compute": "Registered",
+			"Microsoft.NetApp":  "NotRegistered",
+			"Microsoft.Purview": "Unregistered",
+			"Microsoft.Storage": "NotRegistered",
+		},
+	}
+
+	// the first use of the Service registers the Resource Providers which aren't registered, and Resource Providers
+	// which aren't available (e.g. in other clouds) are skipped
+	required := ResourceProviders{}
+	required.Add("Microsoft.Compute", "Microsoft.NetApp", "Microsoft.Purview", "Microsoft.Unavailable")
+	if err := testEnsureRegisteredOnDemand(client, required); err != nil {
+		t.Fatalf("ensuring the Resource Providers are registered: %+v", err)
+	}
+
+	registered := append([]string{}, client.registered...)
+	sort.Strings(registered)
+	if expected := []string{"Microsoft.NetApp", "Microsoft.Purview"}; !reflect.DeepEqual(registered, expected) {
+		t.Fatalf("expected %v to be registered but got %v", expected, registered)
+	}
+
+	// subsequent uses of the Service use the cache rather than registering the Resource Providers again
+	if err := testEnsureRegisteredOnDemand(client, required); err != nil {
+		t.Fatalf("ensuring the Resource Providers are registered: %+v", err)
+	}
+	if len(client.registered) != 2 {
+		t.Fatalf("expected the Resource Providers to be registered once but got %v", client.registered)
+	}
+
+	// other Services only register their own Resource Providers
+	other := ResourceProviders{}
+	other.Add("Microsoft.Storage")
+	if err := testEnsureRegisteredOnDemand(client, other); err != nil {
+		t.Fatalf("ensuring the Resource Providers are registered: %+v", err)
+	}
+	if len(client.registered) != 3 || client.registered[2] != "Microsoft.Storage" {
+		t.Fatalf("expected `Microsoft.Storage` to be registered but got %v", client.registered)
+	}
+
+	if client.listCalls != 1 {
+		t.Fatalf("expected the Resource Providers to be listed once but got %d", client.listCalls)
+	}
+}
+
+func TestEnsureRegisteredOnDemandConcurrently(t *testing.T) {
+	registrationPollInterval = 10 * time.Millisecond
+	defer func() {
+		registrationPollInterval = 10 * time.Second
+		ClearCache()
+	}()
+	ClearCache()
+
+	client := &fakeProvidersClient{
+		registrationStates: map[string]string{
+			"Microsoft.NetApp": "NotRegistered",
+		},
+	}
+
+	required := ResourceProviders{}
+	required.Add("Microsoft.NetApp")
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- testEnsureRegisteredOnDemand(client, required)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("ensuring the Resource Providers are registered: %+v", err)
+		}
+	}
+	if len(client.registered) != 1 {
+		t.Fatalf("expected the Resource Provider to be registered once but got %v", client.registered)
+	}
+}
+
+func TestEnsureRegisteredOnDemandCasing(t *testing.T) {
+	registrationPollInterval = 10 * time.Millisecond
+	defer func() {
+		registrationPollInterval = 10 * time.Second
+		ClearCache()
+	}()
+	ClearCache()
+
+	// the namespaces returned by the API aren't necessarily cased the same as those used by the Services
+	client := &fakeProvidersClient{
+		registrationStates: map[string]string{
+			"Microsoft.Automanage": "NotRegistered",
+			"microsoft.compute":    "Registered",
+		},
+	}
+
+	required := ResourceProviders{}
+	required.Add("Microsoft.AutoManage", "Microsoft.Compute")
+	if err := testEnsureRegisteredOnDemand(client, required); err != nil {
+		t.Fatalf("ensuring the Resource Providers are registered: %+v", err)
+	}
+	if expected := []string{"Microsoft.AutoManage"}; !reflect.DeepEqual(client.registered, expected) {
+		t.Fatalf("expected %v to be registered but got %v", expected, client.registered)
+	}
+
+	if err := testEnsureRegisteredOnDemand(client, required); err != nil {
+		t.Fatalf("ensuring the Resource Providers are registered: %+v", err)
+	}
+	if len(client.registered) != 1 {
+		t.Fatalf("expected the Resource Provider to be registered once but got %v", client.registered)
+	}
+}
+
+func TestEnsureRegisteredOnDemandMultipleSubscriptions(t *testing.T) {
+	registrationPollInterval = 10 * time.Millisecond
+	defer func() {
+		registrationPollInterval = 10 * time.Second
+		ClearCache()
+	}()
+	ClearCache()
+
+	// when the Provider is configured for multiple Subscriptions (using aliases), each has its own client
+	first := &fakeProvidersClient{
+		registrationStates: map[string]string{
+			"Microsoft.NetApp": "NotRegistered",
+		},
+	}
+	second := &fakeProvidersClient{
+		registrationStates: map[string]string{
+			"Microsoft.NetApp": "NotRegistered",
+		},
+	}
+
+	required := ResourceProviders{}
+	required.Add("Microsoft.NetApp")
+	if err := testEnsureRegisteredOnDemandInSubscription(first, "11111111-1111-1111-1111-111111111111", required); err != nil {
+		t.Fatalf("ensuring the Resource Providers are registered in the first Subscription: %+v", err)
+	}
+	if err := testEnsureRegisteredOnDemandInSubscription(second, "22222222-2222-2222-2222-222222222222", required); err != nil {
+		t.Fatalf("ensuring the Resource Providers are registered in the second Subscription: %+v", err)
+	}
+
+	if len(first.registered) != 1 || len(second.registered) != 1 {
+		t.Fatalf("expected the Resource Provider to be registered in both Subscriptions but got %v and %v", first.registered, second.registered)
+	}
+	if first.listCalls != 1 || second.listCalls != 1 {
+		t.Fatalf("expected the Resource Providers to be listed once for each Subscription but got %d and %d", first.listCalls, second.listCalls)
+	}
+}
+
+func TestEnsureRegisteredOnDemandDoesNotBlockOtherResourceProviders(t *testing.T) {
+	registrationPollInterval = 10 * time.Millisecond
+	defer func() {
+		registrationPollInterval = 10 * time.Second
+		ClearCache()
+	}()
+	ClearCache()
+
+	blocked := make(chan struct{})
+	client := &fakeProvidersClient{
+		registrationStates: map[string]string{
+			"Microsoft.NetApp":  "NotRegistered",
+			"Microsoft.Storage": "NotRegistered",
+		},
+		blocked: map[string]chan struct{}{
+			"Microsoft.NetApp": blocked,
+		},
+		waiting: make(chan struct{}),
+	}
+
+	netApp := ResourceProviders{}
+	netApp.Add("Microsoft.NetApp")
+	errs := make(chan error, 1)
+	go func() {
+		errs <- testEnsureRegisteredOnDemand(client, netApp)
+	}()
+	<-client.waiting
+
+	// whilst `Microsoft.NetApp` is being registered, Services using other Resource Providers aren't blocked
+	storage := ResourceProviders{}
+	storage.Add("Microsoft.Storage")
+	if err := testEnsureRegisteredOnDemand(client, storage); err != nil {
+		t.Fatalf("ensuring the Resource Providers are registered: %+v", err)
+	}
+
+	close(blocked)
+	if err := <-errs; err != nil {
+		t.Fatalf("ensuring the Resource Providers are registered: %+v", err)
+	}
+
+	registered := append([]string{}, client.registered...)
+	sort.Strings(registered)
+	if expected := []string{"Microsoft.NetApp", "Microsoft.Storage"}; !reflect.DeepEqual(registered, expected) {
+		t.Fatalf("expected %v to be registered but got %v", expected, registered)
+	}
+}
+
+func TestEnsureRegisteredOnDemandForbidden(t *testing.T) {
+	defer ClearCache()
+	ClearCache()
+
+	client := &fakeProvidersClient{
+		registrationStates: map[string]string{
+			"Microsoft.NetApp": "NotRegistered",
+		},
+		forbidden: true,
+	}
+
+	required := ResourceProviders{}
+	required.Add("Microsoft.NetApp")
+
+	err := testEnsureRegisteredOnDemand(client, required)
+	if err == nil {
+		t.Fatalf("expected an error when registering the Resource Provider is forbidden")
+	}
+	if !strings.Contains(err.Error(), "Terraform does not have the necessary permissions to register Resource Providers") {
+		t.Fatalf("expected the error to explain the permissions are missing but got: %+v", err)
+	}
+}
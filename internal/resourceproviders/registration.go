@@ -19,24 +19,37 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders/custompollers"
 )
 
+// registrationPollInterval is the interval used to check whether a Resource Provider has finished registering
+var registrationPollInterval = 10 * time.Second
+
+// ProvidersClient is the subset of the Providers client used to register Resource Providers, which allows the client
+// to be faked in tests
+type ProvidersClient interface {
+	Get(ctx context.Context, id providers.SubscriptionProviderId, options providers.GetOperationOptions) (providers.GetOperationResponse, error)
+	ListComplete(ctx context.Context, id commonids.SubscriptionId, options providers.ListOperationOptions) (providers.ListCompleteResult, error)
+	Register(ctx context.Context, id providers.SubscriptionProviderId, input providers.ProviderRegistrationRequest) (providers.RegisterOperationResponse, error)
+}
+
+var _ ProvidersClient = &providers.ProvidersClient{}
+
 // EnsureRegistered tries to determine whether all requiredRPs are registered in the subscription, and attempts to
 // register them if it appears they are not. Note that this may fail if a resource provider is not available in the
 // current cloud environment (a warning message will be logged to indicate when a resource provider is not listed).
-func EnsureRegistered(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, requiredRPs ResourceProviders) error {
+func EnsureRegistered(ctx context.Context, client ProvidersClient, subscriptionId commonids.SubscriptionId, requiredRPs ResourceProviders) error {
 	// Cache supported resource providers if RP registration and enhanced validation are not both disabled
 	if len(requiredRPs) == 0 && !features.EnhancedValidationEnabled() {
 		log.Printf("[DEBUG] Skipping populating the resource provider cache, since resource provider registration and enhanced validation are both disabled")
 		return nil
 	}
 
-	if cachedResourceProviders == nil || registeredResourceProviders == nil || unregisteredResourceProviders == nil {
+	if !isCached(subscriptionId) {
 		if err := populateCache(ctx, client, subscriptionId); err != nil {
 			return fmt.Errorf("populating Resource Provider cache: %+v", err)
 		}
 	}
 
 	log.Printf("[DEBUG] Determining which Resource Providers require Registration")
-	providersToRegister, err := DetermineWhichRequiredResourceProvidersRequireRegistration(requiredRPs)
+	providersToRegister, err := DetermineWhichRequiredResourceProvidersRequireRegistration(subscriptionId, requiredRPs)
 	if err != nil {
 		return fmt.Errorf("determining which Resource Providers require registration: %+v", err)
 	}
@@ -55,7 +68,7 @@ func EnsureRegistered(ctx context.Context, client *providers.ProvidersClient, su
 }
 
 // registerForSubscription registers the specified Resource Providers in the current Subscription
-func registerForSubscription(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, providersToRegister []string) error {
+func registerForSubscription(ctx context.Context, client ProvidersClient, subscriptionId commonids.SubscriptionId, providersToRegister []string) error {
 	errs := &registrationErrors{}
 	var wg sync.WaitGroup
 	wg.Add(len(providersToRegister))
@@ -79,7 +92,7 @@ func registerForSubscription(ctx context.Context, client *providers.ProvidersCli
 	return nil
 }
 
-func registerWithSubscription(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, providerName string) error {
+func registerWithSubscription(ctx context.Context, client ProvidersClient, subscriptionId commonids.SubscriptionId, providerName string) error {
 	providerId := providers.NewSubscriptionProviderID(subscriptionId.SubscriptionId, providerName)
 	log.Printf("[DEBUG] Registering %s..", providerId)
 	if resp, err := client.Register(ctx, providerId, providers.ProviderRegistrationRequest{}); err != nil {
@@ -95,7 +108,7 @@ func registerWithSubscription(ctx context.Context, client *providers.ProvidersCl
 
 	log.Printf("[DEBUG] Waiting for %s to finish registering..", providerId)
 	pollerType := custompollers.NewResourceProviderRegistrationPoller(client, providerId)
-	poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+	poller := pollers.NewPoller(pollerType, registrationPollInterval, pollers.DefaultNumberOfDroppedConnectionsToAllow)
 	if err := poller.PollUntilDone(ctx); err != nil {
 		return fmt.Errorf("waiting for %s to be registered: %s", providerId, err)
 	}
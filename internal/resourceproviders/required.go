@@ -27,6 +27,10 @@ const (
 	ProviderRegistrationsCore     = "core"
 	ProviderRegistrationsExtended = "extended"
 	ProviderRegistrationsAll      = "all"
+
+	// ProviderRegistrationsOnDemand registers the RPs used by each service the first time a resource within that
+	// service is used, rather than registering a set of RPs up front
+	ProviderRegistrationsOnDemand = "on-demand"
 )
 
 func (r ResourceProviders) Add(providers ...string) {
@@ -214,7 +218,7 @@ func GetResourceProvidersSet(input string) (ResourceProviders, error) {
 		return All(), nil
 	case ProviderRegistrationsExtended:
 		return Extended(), nil
-	case ProviderRegistrationsNone:
+	case ProviderRegistrationsNone, ProviderRegistrationsOnDemand:
 		return empty, nil
 	}
 
@@ -6,21 +6,31 @@ package resourceproviders
 import (
 	"fmt"
 	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 )
 
 // DetermineWhichRequiredResourceProvidersRequireRegistration determines which Resource Providers require registration to be able to be used
-func DetermineWhichRequiredResourceProvidersRequireRegistration(requiredResourceProviders ResourceProviders) (*[]string, error) {
-	if registeredResourceProviders == nil || unregisteredResourceProviders == nil {
-		return nil, fmt.Errorf("internal-error: the registered/unregistered Resource Provider cache isn't populated")
+// within the specified Subscription
+func DetermineWhichRequiredResourceProvidersRequireRegistration(subscriptionId commonids.SubscriptionId, requiredResourceProviders ResourceProviders) (*[]string, error) {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	state, ok := registrationStates[strings.ToLower(subscriptionId.SubscriptionId)]
+	if !ok {
+		return nil, fmt.Errorf("internal-error: the registered/unregistered Resource Provider cache isn't populated for %s", subscriptionId)
 	}
 
 	requiringRegistration := make([]string, 0)
 	for providerName := range requiredResourceProviders {
-		if _, isRegistered := registeredResourceProviders[providerName]; isRegistered {
+		// the namespaces returned from the API aren't necessarily cased the same as those required
+		if _, isRegistered := state.registered[strings.ToLower(providerName)]; isRegistered {
 			continue
 		}
 
-		if _, isUnregistered := unregisteredResourceProviders[providerName]; !isUnregistered {
+		if _, isUnregistered := state.unregistered[strings.ToLower(providerName)]; !isUnregistered {
 			// some RPs may not exist in some non-public clouds, so we'll log a warning here instead of raising an error
 			log.Printf("[WARN] The required Resource Provider %q wasn't returned from the Azure API", providerName)
 			continue
@@ -28,6 +38,7 @@ func DetermineWhichRequiredResourceProvidersRequireRegistration(requiredResource
 
 		requiringRegistration = append(requiringRegistration, providerName)
 	}
+	sort.Strings(requiringRegistration)
 
 	return &requiringRegistration, nil
 }
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkhelpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// WrappedInt64Validator provides a wrapper for legacy SDKv2 type validations to ease migration to Framework Native
+// The provided function is tested against the value in the configuration (as an `int`, as used by the SDKv2
+// validations) and populates the diagnostics accordingly.
+type WrappedInt64Validator struct {
+	Func         func(v interface{}, k string) (warnings []string, errors []error)
+	Desc         string
+	MarkdownDesc string
+}
+
+func (w WrappedInt64Validator) Description(_ context.Context) string {
+	return w.Desc
+}
+
+func (w WrappedInt64Validator) MarkdownDescription(_ context.Context) string {
+	return w.MarkdownDesc
+}
+
+func (w WrappedInt64Validator) ValidateInt64(_ context.Context, request validator.Int64Request, response *validator.Int64Response) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := int(request.ConfigValue.ValueInt64())
+	path := request.Path.String()
+	warnings, err := w.Func(value, path)
+	if len(err) > 0 {
+		response.Diagnostics.AddError(fmt.Sprintf("invalid value for %s", path), fmt.Sprintf("%+v", err))
+		return
+	}
+
+	for _, v := range warnings {
+		response.Diagnostics.Append(diag.NewWarningDiagnostic(fmt.Sprintf("validating %s", path), v))
+	}
+}
+
+var _ validator.Int64 = &WrappedInt64Validator{}
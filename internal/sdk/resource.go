@@ -176,6 +176,8 @@ type ResourceWithCustomizeDiff interface {
 type ResourceWithConfigValidation interface {
 	Resource
 
+	// ValidateRawResourceConfig returns the functions used to validate the raw configuration for this Resource
+	// NOTE: the validation of the `_version` property paired with each `writeOnly` field is configured automatically
 	ValidateRawResourceConfig() []schema.ValidateRawResourceConfigFunc
 }
 
@@ -215,6 +217,15 @@ type ResourceMetaData struct {
 	serializationDebugLogger Logger
 }
 
+// debugLogger returns the Logger used to debug the serialization of models, which defaults to the NullLogger
+// when not specified (for example when the ResourceMetaData is constructed in a unit test)
+func (rmd ResourceMetaData) debugLogger() Logger {
+	if rmd.serializationDebugLogger == nil {
+		return NullLogger{}
+	}
+	return rmd.serializationDebugLogger
+}
+
 // MarkAsGone marks this resource as removed in the Remote API, so this is no longer available
 func (rmd ResourceMetaData) MarkAsGone(idFormatter resourceids.Id) error {
 	rmd.Logger.Infof("[DEBUG] %s was not found - removing from state", idFormatter)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,6 +16,9 @@ import (
 // NOTE: this object must be passed by value - and must contain `tfschema`
 // struct tags for all fields
 //
+// Fields with the `writeOnly` struct tag option are populated from the raw configuration, since
+// the values for these are never persisted into the state.
+//
 // Example Usage:
 //
 //	type Person struct {
@@ -27,7 +31,7 @@ func (rmd ResourceMetaData) Decode(input interface{}) error {
 	if rmd.ResourceData == nil {
 		return fmt.Errorf("ResourceData was nil")
 	}
-	return decodeReflectedType(input, rmd.ResourceData, rmd.serializationDebugLogger)
+	return decodeReflectedType(input, rmd.ResourceData, rmd.debugLogger())
 }
 
 // DecodeDiff decodes the Terraform Schema into the specified object in the
@@ -37,7 +41,7 @@ func (rmd ResourceMetaData) DecodeDiff(input interface{}) error {
 	if rmd.ResourceDiff == nil {
 		return fmt.Errorf("ResourceDiff was nil")
 	}
-	return decodeReflectedType(input, rmd.ResourceDiff, rmd.serializationDebugLogger)
+	return decodeReflectedType(input, rmd.ResourceDiff, rmd.debugLogger())
 }
 
 // stateRetriever is a convenience wrapper around the Plugin SDK to be able to test it more accurately
@@ -45,6 +49,7 @@ type stateRetriever interface {
 	Get(key string) interface{}
 	GetOk(key string) (interface{}, bool)
 	GetOkExists(key string) (interface{}, bool)
+	GetRawConfig() cty.Value
 }
 
 func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debugLogger Logger) error {
@@ -63,6 +68,13 @@ func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debug
 		}
 
 		if structTags != nil {
+			if structTags.writeOnly {
+				if err := setWriteOnlyValue(input, stateRetriever.GetRawConfig(), structTags.hclPath, i); err != nil {
+					return fmt.Errorf("while setting write-only value of model field %q: %+v", field.Name, err)
+				}
+				continue
+			}
+
 			tfschemaValue, valExists := stateRetriever.GetOkExists(structTags.hclPath)
 			if !valExists {
 				continue
@@ -8,10 +8,12 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-cty/cty"
 )
 
 type decodeTestData struct {
 	State       map[string]interface{}
+	RawConfig   cty.Value
 	Input       interface{}
 	Expected    interface{}
 	ExpectError bool
@@ -279,6 +281,90 @@ func TestDecode_TopLevelFieldsOptionalNullValues(t *testing.T) {
 	}.test(t)
 }
 
+type WriteOnly struct {
+	Name            string  `tfschema:"name"`
+	Password        string  `tfschema:"password_wo,writeOnly"`
+	PasswordVersion int64   `tfschema:"password_wo_version"`
+	Secret          *string `tfschema:"secret_wo,writeOnly"`
+	Count           *int64  `tfschema:"count_wo,writeOnly"`
+	Enabled         bool    `tfschema:"enabled_wo,writeOnly"`
+}
+
+func TestDecode_TopLevelFieldsWriteOnly(t *testing.T) {
+	decodeTestData{
+		State: map[string]interface{}{
+			"name":                "example",
+			"password_wo_version": 1,
+		},
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			"name":                cty.StringVal("example"),
+			"password_wo":         cty.StringVal("P@55w0rd1234!"),
+			"password_wo_version": cty.NumberIntVal(1),
+			"secret_wo":           cty.StringVal("s3cr3t"),
+			"count_wo":            cty.NumberIntVal(3),
+			"enabled_wo":          cty.True,
+		}),
+		Input: &WriteOnly{},
+		Expected: &WriteOnly{
+			Name:            "example",
+			Password:        "P@55w0rd1234!",
+			PasswordVersion: 1,
+			Secret:          pointer.To("s3cr3t"),
+			Count:           pointer.To(int64(3)),
+			Enabled:         true,
+		},
+		ExpectError: false,
+	}.test(t)
+}
+
+func TestDecode_TopLevelFieldsWriteOnlyNullValues(t *testing.T) {
+	decodeTestData{
+		State: map[string]interface{}{
+			"name": "example",
+		},
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			"name":                cty.StringVal("example"),
+			"password_wo":         cty.NullVal(cty.String),
+			"password_wo_version": cty.NullVal(cty.Number),
+			"secret_wo":           cty.NullVal(cty.String),
+			"count_wo":            cty.UnknownVal(cty.Number),
+			"enabled_wo":          cty.NullVal(cty.Bool),
+		}),
+		Input: &WriteOnly{},
+		Expected: &WriteOnly{
+			Name: "example",
+		},
+		ExpectError: false,
+	}.test(t)
+}
+
+func TestDecode_TopLevelFieldsWriteOnlyNoRawConfig(t *testing.T) {
+	// the raw config isn't available during a Read
+	decodeTestData{
+		State: map[string]interface{}{
+			"name":                "example",
+			"password_wo_version": 2,
+		},
+		Input: &WriteOnly{},
+		Expected: &WriteOnly{
+			Name:            "example",
+			PasswordVersion: 2,
+		},
+		ExpectError: false,
+	}.test(t)
+}
+
+func TestDecode_TopLevelFieldsWriteOnlyInvalidType(t *testing.T) {
+	decodeTestData{
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			"name":        cty.StringVal("example"),
+			"password_wo": cty.ListValEmpty(cty.String),
+		}),
+		Input:       &WriteOnly{},
+		ExpectError: true,
+	}.test(t)
+}
+
 func TestDecode_TopLevelFieldsOptionalMixedValues(t *testing.T) {
 	decodeTestData{
 		State: map[string]interface{}{
@@ -1057,12 +1143,14 @@ func (testData decodeTestData) test(t *testing.T) {
 
 func (testData decodeTestData) stateWrapper() testDataGetter {
 	return testDataGetter{
-		values: testData.State,
+		values:    testData.State,
+		rawConfig: testData.RawConfig,
 	}
 }
 
 type testDataGetter struct {
-	values map[string]interface{}
+	values    map[string]interface{}
+	rawConfig cty.Value
 }
 
 func (td testDataGetter) Get(key string) interface{} {
@@ -1079,3 +1167,8 @@ func (td testDataGetter) GetOkExists(key string) (interface{}, bool) {
 	val, ok := td.values[key]
 	return val, ok
 }
+
+func (td testDataGetter) GetRawConfig() cty.Value {
+	// when not specified this is a `cty.NilVal`, which is treated as a null value
+	return td.rawConfig
+}
@@ -382,6 +382,27 @@ func TestResourceEncode_TopLevelOmitted(t *testing.T) {
 	}.test(t)
 }
 
+func TestResourceEncode_TopLevelWriteOnly(t *testing.T) {
+	type SimpleType struct {
+		String          string  `tfschema:"string"`
+		Password        string  `tfschema:"password_wo,writeOnly"`
+		PasswordVersion int64   `tfschema:"password_wo_version"`
+		Secret          *string `tfschema:"secret_wo,writeOnly"`
+	}
+	encodeTestData{
+		Input: &SimpleType{
+			String:          "world",
+			Password:        "P@55w0rd1234!",
+			PasswordVersion: 2,
+			Secret:          pointer.To("s3cr3t"),
+		},
+		Expected: map[string]interface{}{
+			"string":              "world",
+			"password_wo_version": int64(2),
+		},
+	}.test(t)
+}
+
 func TestResourceEncode_TopLevelComputed(t *testing.T) {
 	type SimpleType struct {
 		ComputedString        string             `tfschema:"computed_string"          computed:"true"`
@@ -20,6 +20,10 @@ type decodedStructTags struct {
 	// removedInNextMajorVersion specifies whether this field is deprecated and should not
 	// be set into the state in the next major version of the Provider
 	removedInNextMajorVersion bool
+
+	// writeOnly specifies whether this field is write-only - meaning that the value is retrieved
+	// from the raw configuration when decoding and is never set into the state
+	writeOnly bool
 }
 
 // parseStructTags parses the struct tags defined in input into a decodedStructTags object
@@ -64,6 +68,10 @@ func parseStructTags(input reflect.StructTag) (*decodedStructTags, error) {
 				output.addedInNextMajorVersion = true
 				continue
 			}
+			if strings.EqualFold(item, "writeOnly") {
+				output.writeOnly = true
+				continue
+			}
 
 			return nil, fmt.Errorf("internal-error: the struct-tag %q is not implemented - struct tags are %q", item, tag)
 		}
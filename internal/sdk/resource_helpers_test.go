@@ -105,6 +105,14 @@ func TestParseStructTags_WithValue(t *testing.T) {
 			expected: nil,
 			error:    pointer.To("the struct-tags `removedInNextMajorVersion` and `addedInNextMajorVersion` cannot be set together"),
 		},
+		{
+			// valid, with writeOnly
+			input: `tfschema:"hello_wo,writeOnly"`,
+			expected: &decodedStructTags{
+				hclPath:   "hello_wo",
+				writeOnly: true,
+			},
+		},
 		{
 			// invalid, unknown struct tags
 			input:    `tfschema:"hello,world"`,
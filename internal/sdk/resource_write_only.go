@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/gocty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// writeOnlyVersionSuffix is the suffix of the property paired with each write-only property, which is
+// incremented by users to trigger an update of the write-only value
+const writeOnlyVersionSuffix = "_version"
+
+// HasWriteOnlyChange returns whether the value for the write-only property `hclPath` should be sent to the API.
+//
+// Since write-only values are never persisted into the state, changes to these can't be detected - instead
+// the paired `{hclPath}_version` property is changed by users to trigger an update.
+func (rmd ResourceMetaData) HasWriteOnlyChange(hclPath string) bool {
+	return rmd.ResourceData.HasChange(hclPath + writeOnlyVersionSuffix)
+}
+
+// ValidateRawResourceConfig returns a ValidateRawResourceConfigFunc which ensures that each of the specified
+// write-only properties is specified alongside the paired `{hclPath}_version` property (and vice versa).
+//
+// This is automatically configured for Typed Resources which define fields with the `writeOnly` struct tag,
+// but can also be used by Untyped Resources.
+func ValidateRawResourceConfig(writeOnlyProperties ...string) schema.ValidateRawResourceConfigFunc {
+	return func(_ context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+		for _, hclPath := range writeOnlyProperties {
+			versionPath := hclPath + writeOnlyVersionSuffix
+
+			value := rawConfigValue(req.RawConfig, hclPath)
+			version := rawConfigValue(req.RawConfig, versionPath)
+			if value.IsNull() == version.IsNull() {
+				continue
+			}
+
+			specified, missing := hclPath, versionPath
+			if value.IsNull() {
+				specified, missing = versionPath, hclPath
+			}
+			resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Missing required argument `%s`", missing),
+				Detail:        fmt.Sprintf("`%s` must be specified when `%s` is specified", missing, specified),
+				AttributePath: cty.GetAttrPath(missing),
+			})
+		}
+	}
+}
+
+// writeOnlyPropertiesForModel returns the HCL paths for the fields within the model which are tagged as `writeOnly`
+func writeOnlyPropertiesForModel(input interface{}) ([]string, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	output := make([]string, 0)
+	objType := reflect.TypeOf(input).Elem()
+	for i := 0; i < objType.NumField(); i++ {
+		field := objType.Field(i)
+		structTags, err := parseStructTags(field.Tag)
+		if err != nil {
+			return nil, fmt.Errorf("parsing struct tags for %q: %+v", field.Name, err)
+		}
+
+		if structTags != nil && structTags.writeOnly {
+			output = append(output, structTags.hclPath)
+		}
+	}
+
+	return output, nil
+}
+
+// rawConfigValue returns the value for the top-level property `hclPath` within the raw configuration, which is
+// null when either the raw configuration or the property isn't available
+func rawConfigValue(rawConfig cty.Value, hclPath string) cty.Value {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(hclPath) {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	return rawConfig.GetAttr(hclPath)
+}
+
+// setWriteOnlyValue sets the value for the write-only property `hclPath` from the raw configuration into
+// the field at `index` within the model
+func setWriteOnlyValue(input interface{}, rawConfig cty.Value, hclPath string, index int) error {
+	// the raw configuration isn't available during a Read or an Import, and the value may be unknown during a plan
+	value := rawConfigValue(rawConfig, hclPath)
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+
+	field := reflect.ValueOf(input).Elem().Field(index)
+	fieldType := field.Type()
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	target := reflect.New(fieldType)
+	if err := gocty.FromCtyValue(value, target.Interface()); err != nil {
+		return fmt.Errorf("converting the value for %q: %+v", hclPath, err)
+	}
+
+	if field.Kind() == reflect.Ptr {
+		field.Set(target)
+	} else {
+		field.Set(target.Elem())
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestValidateRawResourceConfig(t *testing.T) {
+	testData := []struct {
+		name      string
+		rawConfig cty.Value
+		expected  []string
+	}{
+		{
+			name: "neither specified",
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"password_wo":         cty.NullVal(cty.String),
+				"password_wo_version": cty.NullVal(cty.Number),
+			}),
+		},
+		{
+			name: "both specified",
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"password_wo":         cty.StringVal("P@55w0rd1234!"),
+				"password_wo_version": cty.NumberIntVal(1),
+			}),
+		},
+		{
+			name: "unknown values",
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"password_wo":         cty.UnknownVal(cty.String),
+				"password_wo_version": cty.UnknownVal(cty.Number),
+			}),
+		},
+		{
+			name: "missing version",
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"password_wo":         cty.StringVal("P@55w0rd1234!"),
+				"password_wo_version": cty.NullVal(cty.Number),
+			}),
+			expected: []string{"Missing required argument `password_wo_version`"},
+		},
+		{
+			name: "missing value",
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"password_wo":         cty.NullVal(cty.String),
+				"password_wo_version": cty.NumberIntVal(1),
+			}),
+			expected: []string{"Missing required argument `password_wo`"},
+		},
+		{
+			name:      "null config",
+			rawConfig: cty.NullVal(cty.DynamicPseudoType),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		resp := schema.ValidateResourceConfigFuncResponse{}
+		ValidateRawResourceConfig("password_wo")(context.Background(), schema.ValidateResourceConfigFuncRequest{RawConfig: v.rawConfig}, &resp)
+
+		if len(resp.Diagnostics) != len(v.expected) {
+			t.Fatalf("expected %d diagnostics but got %d: %+v", len(v.expected), len(resp.Diagnostics), resp.Diagnostics)
+		}
+		for i, summary := range v.expected {
+			if resp.Diagnostics[i].Summary != summary {
+				t.Fatalf("expected diagnostic %d to be %q but got %q", i, summary, resp.Diagnostics[i].Summary)
+			}
+		}
+	}
+}
+
+func TestWriteOnlyPropertiesForModel(t *testing.T) {
+	type Model struct {
+		Name            string `tfschema:"name"`
+		Password        string `tfschema:"password_wo,writeOnly"`
+		PasswordVersion int64  `tfschema:"password_wo_version"`
+		Secret          string `tfschema:"secret_wo, writeOnly"`
+	}
+
+	actual, err := writeOnlyPropertiesForModel(&Model{})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	expected := []string{"password_wo", "secret_wo"}
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("expected %+v but got %+v", expected, actual)
+		}
+	}
+}
@@ -64,3 +64,51 @@ type UntypedServiceRegistrationWithAGitHubLabel interface {
 
 	AssociatedGitHubLabel() string
 }
+
+// TypedServiceRegistrationWithResourceProviders is a superset of TypedServiceRegistration allowing
+// the Resource Providers (e.g. `Microsoft.Compute`) used by the Resources within this Service to be
+// specified, which are registered the first time a Resource within this Service is used when the
+// Resource Providers are registered on-demand.
+//
+// NOTE: this is intentionally an optional interface as not all Services use a Resource Provider
+// (e.g. where only Data Plane APIs are used)
+type TypedServiceRegistrationWithResourceProviders interface {
+	TypedServiceRegistration
+
+	ResourceProviders() []string
+}
+
+// UntypedServiceRegistrationWithResourceProviders is a superset of UntypedServiceRegistration allowing
+// the Resource Providers (e.g. `Microsoft.Compute`) used by the Resources within this Service to be
+// specified, which are registered the first time a Resource within this Service is used when the
+// Resource Providers are registered on-demand.
+//
+// NOTE: this is intentionally an optional interface as not all Services use a Resource Provider
+// (e.g. where only Data Plane APIs are used)
+type UntypedServiceRegistrationWithResourceProviders interface {
+	UntypedServiceRegistration
+
+	ResourceProviders() []string
+}
+
+// TypedServiceRegistrationWithResourceProvidersByResource is a superset of TypedServiceRegistrationWithResourceProviders
+// allowing the Resource Providers used by specific Resources within this Service to be specified (keyed by the
+// Resource Type, e.g. `azurerm_marketplace_agreement`) - which are registered for these Resources in place of the
+// Resource Providers used by the Service, so that a Resource Provider used by only some of the Resources within a
+// Service is only registered when one of these is used.
+type TypedServiceRegistrationWithResourceProvidersByResource interface {
+	TypedServiceRegistrationWithResourceProviders
+
+	ResourceProvidersByResource() map[string][]string
+}
+
+// UntypedServiceRegistrationWithResourceProvidersByResource is a superset of UntypedServiceRegistrationWithResourceProviders
+// allowing the Resource Providers used by specific Resources within this Service to be specified (keyed by the
+// Resource Type, e.g. `azurerm_marketplace_agreement`) - which are registered for these Resources in place of the
+// Resource Providers used by the Service, so that a Resource Provider used by only some of the Resources within a
+// Service is only registered when one of these is used.
+type UntypedServiceRegistrationWithResourceProvidersByResource interface {
+	UntypedServiceRegistrationWithResourceProviders
+
+	ResourceProvidersByResource() map[string][]string
+}
@@ -0,0 +1,645 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	frameworkschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var (
+	_ FrameworkResource                   = &FrameworkResourceWrapper{}
+	_ resource.ResourceWithModifyPlan     = &FrameworkResourceWrapper{}
+	_ resource.ResourceWithValidateConfig = &FrameworkResourceWrapper{}
+	_ resource.ResourceWithIdentity       = &frameworkResourceWithIdentityWrapper{}
+)
+
+// FrameworkResourceWrapper is a wrapper for converting a Resource implementation
+// into the object used by the Terraform Plugin Framework
+//
+// The Plugin SDKv2 representation of the Resource (from ResourceWrapper) is used internally, meaning
+// that the existing Create/Read/Update/Delete functions - and the Encode/Decode functions within them -
+// are used as-is, regardless of which Plugin this Resource is exposed through.
+type FrameworkResourceWrapper struct {
+	ResourceMetadata
+
+	logger   Logger
+	resource Resource
+
+	// wrappers are applied to the Plugin SDKv2 representation of this Resource, see NewFrameworkResourceWrapper
+	wrappers []func(*schema.Resource)
+
+	once              sync.Once
+	initErr           error
+	pluginSdkResource *schema.Resource
+	frameworkSchema   *frameworkschema.Schema
+
+	// frameworkIdentitySchema and identityType are only set when the Resource has a Resource Identity
+	frameworkIdentitySchema *identityschema.Schema
+	identityType            cty.Type
+}
+
+// frameworkResourceWithIdentityWrapper is used for Resources which have a Resource Identity, since the Plugin
+// Framework exposes an Identity Schema for every Resource implementing resource.ResourceWithIdentity
+type frameworkResourceWithIdentityWrapper struct {
+	*FrameworkResourceWrapper
+}
+
+// NewFrameworkResourceWrapper returns a function which builds a Plugin Framework Resource for this Resource implementation.
+//
+// Each of the specified wrappers is applied to the Plugin SDKv2 representation of the Resource before the Framework
+// Schema is built from it - which allows the same functionality to be layered onto the Resource (for example the
+// `default_tags`) as when the Resource is exposed via Plugin SDKv2.
+func NewFrameworkResourceWrapper(r Resource, wrappers ...func(*schema.Resource)) func() resource.Resource {
+	return func() resource.Resource {
+		wrapper := &FrameworkResourceWrapper{
+			logger:   &DiagnosticsLogger{},
+			resource: r,
+			wrappers: wrappers,
+		}
+
+		// any error is surfaced when the Schema is requested
+		if err := wrapper.init(); err == nil && wrapper.frameworkIdentitySchema != nil {
+			return &frameworkResourceWithIdentityWrapper{
+				FrameworkResourceWrapper: wrapper,
+			}
+		}
+
+		return wrapper
+	}
+}
+
+// init builds both the Plugin SDKv2 and Plugin Framework representations of this Resource
+func (w *FrameworkResourceWrapper) init() error {
+	w.once.Do(func() {
+		wrapper := ResourceWrapper{
+			logger:   w.logger,
+			resource: w.resource,
+		}
+		pluginSdkResource, err := wrapper.Resource()
+		if err != nil {
+			w.initErr = fmt.Errorf("building Plugin SDK Resource: %+v", err)
+			return
+		}
+		for _, wrap := range w.wrappers {
+			wrap(pluginSdkResource)
+		}
+
+		frameworkSchema, err := frameworkSchemaFromPluginSdk(pluginSdkResource.SchemaMap())
+		if err != nil {
+			w.initErr = fmt.Errorf("building Framework Schema: %+v", err)
+			return
+		}
+		frameworkSchema.DeprecationMessage = pluginSdkResource.DeprecationMessage
+		if pluginSdkResource.SchemaVersion > 0 {
+			frameworkSchema.Version = int64(pluginSdkResource.SchemaVersion)
+		}
+
+		// Plugin SDKv2 exposes the `timeouts` block outside of the Schema (see `CoreConfigSchema`)
+		if pluginSdkResource.Timeouts != nil {
+			if _, ok := frameworkSchema.Blocks[schema.TimeoutsConfigKey]; !ok {
+				frameworkSchema.Blocks[schema.TimeoutsConfigKey] = frameworkTimeoutsBlockFromPluginSdk(pluginSdkResource.Timeouts)
+			}
+		}
+
+		if pluginSdkResource.Identity != nil {
+			identitySchema, err := frameworkIdentitySchemaFromPluginSdk(pluginSdkResource.Identity)
+			if err != nil {
+				w.initErr = fmt.Errorf("building Framework Identity Schema: %+v", err)
+				return
+			}
+			coreIdentitySchema, err := pluginSdkResource.CoreIdentitySchema()
+			if err != nil {
+				w.initErr = fmt.Errorf("building Identity Schema: %+v", err)
+				return
+			}
+			w.frameworkIdentitySchema = identitySchema
+			w.identityType = coreIdentitySchema.ImpliedType()
+		}
+
+		w.pluginSdkResource = pluginSdkResource
+		w.frameworkSchema = frameworkSchema
+	})
+
+	return w.initErr
+}
+
+func (w *FrameworkResourceWrapper) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = w.resource.ResourceType()
+}
+
+func (w *FrameworkResourceWrapper) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	if err := w.init(); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("building Schema for %q", w.resource.ResourceType()), err.Error())
+		return
+	}
+
+	response.Schema = *w.frameworkSchema
+}
+
+func (w *frameworkResourceWithIdentityWrapper) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	if err := w.init(); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("building Identity Schema for %q", w.resource.ResourceType()), err.Error())
+		return
+	}
+
+	response.IdentitySchema = *w.frameworkIdentitySchema
+}
+
+func (w *FrameworkResourceWrapper) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	w.Defaults(request, response)
+}
+
+func (w *FrameworkResourceWrapper) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	if err := w.init(); err != nil {
+		response.Diagnostics.AddError("Validating Config", err.Error())
+		return
+	}
+
+	config, err := w.ctyValue(request.Config.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("Validating Config", err.Error())
+		return
+	}
+
+	// as with Plugin SDKv2, the functions validating the raw config (e.g. that write-only arguments are supported
+	// by the Terraform client) are called alongside the validation defined in the Schema
+	validateRawConfigRequest := schema.ValidateResourceConfigFuncRequest{
+		WriteOnlyAttributesAllowed: request.ClientCapabilities.WriteOnlyAttributesAllowed,
+		RawConfig:                  config,
+	}
+	for _, validateFunc := range w.pluginSdkResource.ValidateRawResourceConfigFuncs {
+		validateRawConfigResponse := &schema.ValidateResourceConfigFuncResponse{}
+		validateFunc(ctx, validateRawConfigRequest, validateRawConfigResponse)
+		response.Diagnostics.Append(frameworkDiagnosticsFromPluginSdk(validateRawConfigResponse.Diagnostics)...)
+	}
+
+	resourceConfig := terraform.NewResourceConfigShimmed(config, w.pluginSdkResource.CoreConfigSchema())
+	response.Diagnostics.Append(frameworkDiagnosticsFromPluginSdk(w.pluginSdkResource.Validate(resourceConfig))...)
+
+	if config.IsWhollyKnown() {
+		if _, err := w.timeoutsFromValue(config); err != nil {
+			response.Diagnostics.AddAttributeError(path.Root(schema.TimeoutsConfigKey), "Validating Config", err.Error())
+		}
+	}
+}
+
+// ModifyPlan delegates the planning of this resource to Plugin SDKv2, which allows Defaults, ForceNew and
+// CustomizeDiff to be handled as before.
+func (w *FrameworkResourceWrapper) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		// being destroyed, nothing to plan
+		return
+	}
+
+	if err := w.init(); err != nil {
+		response.Diagnostics.AddError("Planning Changes", err.Error())
+		return
+	}
+
+	prior, err := w.ctyValue(request.State.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("Planning Changes", fmt.Sprintf("converting prior state: %+v", err))
+		return
+	}
+	plan, err := w.ctyValue(request.Plan.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("Planning Changes", fmt.Sprintf("converting proposed plan: %+v", err))
+		return
+	}
+	config, err := w.ctyValue(request.Config.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("Planning Changes", fmt.Sprintf("converting config: %+v", err))
+		return
+	}
+	proposed := proposedValueFromPlan(plan, config, prior)
+
+	create := prior.IsNull()
+	priorState, err := w.pluginSdkResource.ShimInstanceStateFromValue(prior)
+	if err != nil {
+		response.Diagnostics.AddError("Planning Changes", fmt.Sprintf("converting prior state: %+v", err))
+		return
+	}
+	priorState.RawState = prior
+	priorState.RawPlan = proposed
+	priorState.RawConfig = config
+
+	coreSchema := w.pluginSdkResource.CoreConfigSchema()
+	resourceConfig := terraform.NewResourceConfigShimmed(proposed, coreSchema)
+	diff, err := w.pluginSdkResource.SimpleDiff(ctx, priorState, resourceConfig, w.Client)
+	if err != nil {
+		response.Diagnostics.AddError("Planning Changes", err.Error())
+		return
+	}
+
+	if diff == nil || len(diff.Attributes) == 0 {
+		if !create {
+			// the `timeouts` block isn't stored in the attributes, so is taken from the config
+			unchanged, err := terraformValueFromCtyValue(copyTimeoutsValue(prior, config), request.State.Raw.Type())
+			if err != nil {
+				response.Diagnostics.AddError("Planning Changes", fmt.Sprintf("converting plan: %+v", err))
+				return
+			}
+			response.Plan.Raw = unchanged
+		}
+		return
+	}
+
+	base := prior
+	if create {
+		base = cty.NullVal(coreSchema.ImpliedType())
+	}
+	planned, err := diff.ApplyToValue(base, coreSchema)
+	if err != nil {
+		response.Diagnostics.AddError("Planning Changes", fmt.Sprintf("applying diff: %+v", err))
+		return
+	}
+
+	// values the user has specified in the config are taken from the proposed plan, other Computed values
+	// (including any Default values) are then taken from the plan from Plugin SDKv2 where known
+	output := make(map[string]cty.Value)
+	for k := range plan.Type().AttributeTypes() {
+		value := plan.GetAttr(k)
+		if v, ok := w.pluginSdkResource.SchemaMap()[k]; (ok && (v.Computed || v.Default != nil)) || k == "id" {
+			if config.GetAttr(k).IsNull() {
+				if plannedValue := planned.GetAttr(k); !plannedValue.IsNull() {
+					value = plannedValue
+				}
+			}
+		}
+		output[k] = value
+	}
+
+	plannedValue, err := terraformValueFromCtyValue(cty.ObjectVal(output), request.Plan.Raw.Type())
+	if err != nil {
+		response.Diagnostics.AddError("Planning Changes", fmt.Sprintf("converting plan: %+v", err))
+		return
+	}
+	response.Plan.Raw = plannedValue
+
+	if !create {
+		requiresReplace := make(map[string]struct{})
+		for k, v := range diff.Attributes {
+			if v.RequiresNew {
+				requiresReplace[strings.Split(k, ".")[0]] = struct{}{}
+			}
+		}
+		for k := range requiresReplace {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root(k))
+		}
+	}
+}
+
+func (w *FrameworkResourceWrapper) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	state := tfsdk.State{
+		Schema: request.Plan.Schema,
+		Raw:    tftypes.NewValue(request.Plan.Raw.Type(), nil),
+	}
+	response.State.Raw = w.apply(ctx, state, request.Plan, request.Config, response.Identity, response.State.Raw, &response.Diagnostics)
+}
+
+func (w *FrameworkResourceWrapper) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	if err := w.init(); err != nil {
+		response.Diagnostics.AddError("Reading Resource", err.Error())
+		return
+	}
+
+	prior, err := w.ctyValue(request.State.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("Reading Resource", fmt.Sprintf("converting state: %+v", err))
+		return
+	}
+
+	priorState, err := w.pluginSdkResource.ShimInstanceStateFromValue(prior)
+	if err != nil {
+		response.Diagnostics.AddError("Reading Resource", fmt.Sprintf("converting state: %+v", err))
+		return
+	}
+	priorState.RawState = prior
+	priorState.Identity, err = w.identityMap(request.Identity)
+	if err != nil {
+		response.Diagnostics.AddError("Reading Resource", fmt.Sprintf("converting identity: %+v", err))
+		return
+	}
+	timeouts, err := w.timeoutsFromValue(prior)
+	if err != nil {
+		response.Diagnostics.AddError("Reading Resource", err.Error())
+		return
+	}
+	if err := timeouts.StateEncode(priorState); err != nil {
+		response.Diagnostics.AddError("Reading Resource", fmt.Sprintf("encoding timeouts: %+v", err))
+		return
+	}
+
+	newState, diags := w.pluginSdkResource.RefreshWithoutUpgrade(ctx, priorState, w.Client)
+	response.Diagnostics.Append(frameworkDiagnosticsFromPluginSdk(diags)...)
+	if diags.HasError() {
+		return
+	}
+
+	if newState == nil || newState.ID == "" {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	refreshed, err := schema.StateValueFromInstanceState(newState, prior.Type())
+	if err != nil {
+		response.Diagnostics.AddError("Reading Resource", fmt.Sprintf("converting state: %+v", err))
+		return
+	}
+	refreshed = normalizeRefreshedValue(refreshed, prior)
+	refreshed = copyTimeoutsValue(refreshed, prior)
+
+	value, err := terraformValueFromCtyValue(refreshed, request.State.Raw.Type())
+	if err != nil {
+		response.Diagnostics.AddError("Reading Resource", fmt.Sprintf("converting state: %+v", err))
+		return
+	}
+	response.State.Raw = value
+
+	if err := w.setIdentity(response.Identity, newState.Identity); err != nil {
+		response.Diagnostics.AddError("Reading Resource", fmt.Sprintf("converting identity: %+v", err))
+	}
+}
+
+func (w *FrameworkResourceWrapper) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	response.State.Raw = w.apply(ctx, request.State, request.Plan, request.Config, response.Identity, response.State.Raw, &response.Diagnostics)
+}
+
+func (w *FrameworkResourceWrapper) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	plan := tfsdk.Plan{
+		Schema: request.State.Schema,
+		Raw:    tftypes.NewValue(request.State.Raw.Type(), nil),
+	}
+	config := tfsdk.Config{
+		Schema: request.State.Schema,
+		Raw:    tftypes.NewValue(request.State.Raw.Type(), nil),
+	}
+	w.apply(ctx, request.State, plan, config, request.Identity, response.State.Raw, &response.Diagnostics)
+	if !response.Diagnostics.HasError() {
+		response.State.RemoveResource(ctx)
+	}
+}
+
+func (w *FrameworkResourceWrapper) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	if err := w.init(); err != nil {
+		response.Diagnostics.AddError("Importing Resource", err.Error())
+		return
+	}
+
+	if w.pluginSdkResource.Importer == nil || w.pluginSdkResource.Importer.StateContext == nil {
+		resource.ImportStatePassthroughID(ctx, IDPath, request, response)
+		return
+	}
+
+	// when importing using the `identity` within an `import` block, the Importer builds the ID from the Identity
+	identity, err := w.identityMap(request.Identity)
+	if err != nil {
+		response.Diagnostics.AddError("Importing Resource", fmt.Sprintf("converting identity: %+v", err))
+		return
+	}
+
+	d := w.pluginSdkResource.Data(&terraform.InstanceState{
+		ID:       request.ID,
+		Identity: identity,
+	})
+	imported, err := w.pluginSdkResource.Importer.StateContext(ctx, d, w.Client)
+	if err != nil {
+		response.Diagnostics.AddError("Importing Resource", err.Error())
+		return
+	}
+	if len(imported) != 1 {
+		response.Diagnostics.AddError("Importing Resource", fmt.Sprintf("expected a single resource to be imported but got %d", len(imported)))
+		return
+	}
+
+	importedState := imported[0].State()
+	if importedState == nil {
+		response.Diagnostics.AddError("Importing Resource", fmt.Sprintf("the resource %q was not found", request.ID))
+		return
+	}
+
+	value, err := schema.StateValueFromInstanceState(importedState, w.pluginSdkResource.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		response.Diagnostics.AddError("Importing Resource", fmt.Sprintf("converting state: %+v", err))
+		return
+	}
+
+	state, err := terraformValueFromCtyValue(value, response.State.Raw.Type())
+	if err != nil {
+		response.Diagnostics.AddError("Importing Resource", fmt.Sprintf("converting state: %+v", err))
+		return
+	}
+	response.State.Raw = state
+
+	if err := w.setIdentity(response.Identity, importedState.Identity); err != nil {
+		response.Diagnostics.AddError("Importing Resource", fmt.Sprintf("converting identity: %+v", err))
+	}
+}
+
+// apply runs the Create, Update or Delete function (as appropriate) for this Resource through Plugin SDKv2, returning the new State
+//
+// The Resource Identity (when the Resource has one) is passed to Plugin SDKv2 from, and then updated within, `identity`.
+func (w *FrameworkResourceWrapper) apply(ctx context.Context, state tfsdk.State, plan tfsdk.Plan, config tfsdk.Config, identity *tfsdk.ResourceIdentity, fallback tftypes.Value, diags *diag.Diagnostics) tftypes.Value {
+	if err := w.init(); err != nil {
+		diags.AddError("Applying Changes", err.Error())
+		return fallback
+	}
+
+	prior, err := w.ctyValue(state.Raw)
+	if err != nil {
+		diags.AddError("Applying Changes", fmt.Sprintf("converting prior state: %+v", err))
+		return fallback
+	}
+	planned, err := w.ctyValue(plan.Raw)
+	if err != nil {
+		diags.AddError("Applying Changes", fmt.Sprintf("converting plan: %+v", err))
+		return fallback
+	}
+	configValue, err := w.ctyValue(config.Raw)
+	if err != nil {
+		diags.AddError("Applying Changes", fmt.Sprintf("converting config: %+v", err))
+		return fallback
+	}
+
+	priorState, err := w.pluginSdkResource.ShimInstanceStateFromValue(prior)
+	if err != nil {
+		diags.AddError("Applying Changes", fmt.Sprintf("converting prior state: %+v", err))
+		return fallback
+	}
+	priorState.Identity, err = w.identityMap(identity)
+	if err != nil {
+		diags.AddError("Applying Changes", fmt.Sprintf("converting identity: %+v", err))
+		return fallback
+	}
+
+	var diff *terraform.InstanceDiff
+	destroy := planned.IsNull()
+	if destroy {
+		diff = &terraform.InstanceDiff{
+			Attributes: make(map[string]*terraform.ResourceAttrDiff),
+			Meta:       make(map[string]interface{}),
+			Destroy:    true,
+			RawPlan:    planned,
+			RawState:   prior,
+			RawConfig:  configValue,
+		}
+	} else {
+		// CustomizeDiff has already been run during the plan, so shouldn't be run again
+		withoutCustomizeDiff := *w.pluginSdkResource
+		withoutCustomizeDiff.CustomizeDiff = nil
+
+		diff, err = schema.DiffFromValues(ctx, prior, planned, configValue, &withoutCustomizeDiff)
+		if err != nil {
+			diags.AddError("Applying Changes", fmt.Sprintf("building diff: %+v", err))
+			return fallback
+		}
+		if diff == nil {
+			diff = &terraform.InstanceDiff{
+				Attributes: make(map[string]*terraform.ResourceAttrDiff),
+				Meta:       make(map[string]interface{}),
+				RawPlan:    planned,
+				RawState:   prior,
+				RawConfig:  configValue,
+			}
+		}
+	}
+
+	diff.Identity = priorState.Identity
+
+	for k, d := range diff.Attributes {
+		// the replacement of this resource is handled by Terraform, so any RequiresNew needs to be
+		// removed, else the existing state will be dropped by Plugin SDKv2
+		d.RequiresNew = false
+
+		if d.NewRemoved {
+			if _, ok := priorState.Attributes[k]; !ok {
+				delete(diff.Attributes, k)
+			}
+		}
+	}
+
+	// the timeouts are taken from the config, or from the prior state when the resource is being deleted
+	timeoutsValue := configValue
+	if destroy {
+		timeoutsValue = prior
+	}
+	timeouts, err := w.timeoutsFromValue(timeoutsValue)
+	if err != nil {
+		diags.AddError("Applying Changes", err.Error())
+		return fallback
+	}
+	if err := timeouts.DiffEncode(diff); err != nil {
+		diags.AddError("Applying Changes", fmt.Sprintf("encoding timeouts: %+v", err))
+		return fallback
+	}
+
+	newState, applyDiags := w.pluginSdkResource.Apply(ctx, priorState, diff, w.Client)
+	diags.Append(frameworkDiagnosticsFromPluginSdk(applyDiags)...)
+
+	if destroy {
+		return tftypes.NewValue(state.Raw.Type(), nil)
+	}
+
+	if newState == nil || newState.ID == "" {
+		if !applyDiags.HasError() {
+			diags.AddError("Applying Changes", fmt.Sprintf("the %q resource was not found after being applied", w.resource.ResourceType()))
+		}
+		return fallback
+	}
+
+	applied, err := schema.StateValueFromInstanceState(newState, planned.Type())
+	if err != nil {
+		diags.AddError("Applying Changes", fmt.Sprintf("converting state: %+v", err))
+		return fallback
+	}
+	if !applyDiags.HasError() {
+		applied = mergePlannedValue(planned, applied)
+	}
+	applied = copyTimeoutsValue(applied, planned)
+
+	value, err := terraformValueFromCtyValue(applied, plan.Raw.Type())
+	if err != nil {
+		diags.AddError("Applying Changes", fmt.Sprintf("converting state: %+v", err))
+		return fallback
+	}
+
+	if err := w.setIdentity(identity, newState.Identity); err != nil {
+		diags.AddError("Applying Changes", fmt.Sprintf("converting identity: %+v", err))
+		return fallback
+	}
+
+	return value
+}
+
+func (w *FrameworkResourceWrapper) ctyValue(input tftypes.Value) (cty.Value, error) {
+	return ctyValueFromTerraformValue(input, w.pluginSdkResource.CoreConfigSchema().ImpliedType())
+}
+
+// identityMap returns the flatmap used by Plugin SDKv2 for the Resource Identity, which is nil when this Resource
+// doesn't have a Resource Identity or the Identity isn't known yet
+func (w *FrameworkResourceWrapper) identityMap(input *tfsdk.ResourceIdentity) (map[string]string, error) {
+	if input == nil || w.frameworkIdentitySchema == nil {
+		return nil, nil
+	}
+
+	value, err := ctyValueFromTerraformValue(input.Raw, w.identityType)
+	if err != nil {
+		return nil, err
+	}
+
+	return identityMapFromCtyValue(value)
+}
+
+// setIdentity sets the Resource Identity returned from Plugin SDKv2 into `output`
+func (w *FrameworkResourceWrapper) setIdentity(output *tfsdk.ResourceIdentity, input map[string]string) error {
+	if output == nil || input == nil || w.frameworkIdentitySchema == nil {
+		return nil
+	}
+
+	value, err := ctyValueFromIdentityMap(input, w.identityType)
+	if err != nil {
+		return err
+	}
+
+	raw, err := terraformValueFromCtyValue(value, w.frameworkIdentitySchema.Type().TerraformType(context.Background()))
+	if err != nil {
+		return err
+	}
+	output.Raw = raw
+
+	return nil
+}
+
+// timeoutsFromValue returns the Timeouts for this Resource, overridden by any values within the `timeouts` block
+// of the (config or state) value
+func (w *FrameworkResourceWrapper) timeoutsFromValue(input cty.Value) (*schema.ResourceTimeout, error) {
+	timeouts := &schema.ResourceTimeout{}
+	if input.IsNull() || !input.IsKnown() {
+		if w.pluginSdkResource.Timeouts != nil {
+			*timeouts = *w.pluginSdkResource.Timeouts
+		}
+		return timeouts, nil
+	}
+
+	resourceConfig := terraform.NewResourceConfigShimmed(input, w.pluginSdkResource.CoreConfigSchema())
+	if err := timeouts.ConfigDecode(w.pluginSdkResource, resourceConfig); err != nil {
+		return nil, fmt.Errorf("decoding timeouts: %+v", err)
+	}
+
+	return timeouts, nil
+}
@@ -0,0 +1,824 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	frameworkschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	pluginsdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type frameworkWrapperTestModel struct {
+	Name    string                          `tfschema:"name"`
+	Enabled bool                            `tfschema:"enabled"`
+	Size    int64                           `tfschema:"size"`
+	Tags    map[string]string               `tfschema:"tags"`
+	Rule    []frameworkWrapperTestRuleModel `tfschema:"rule"`
+	Output  string                          `tfschema:"output"`
+}
+
+type frameworkWrapperTestRuleModel struct {
+	Name     string `tfschema:"name"`
+	Priority int64  `tfschema:"priority"`
+}
+
+// frameworkWrapperTestResource is a Typed Resource backed by an in-memory store, used to compare
+// the behaviour of the Plugin SDKv2 and Plugin Framework wrappers
+type frameworkWrapperTestResource struct {
+	store map[string]frameworkWrapperTestModel
+
+	// createTimeouts contains the Create timeout used for each resource
+	createTimeouts map[string]time.Duration
+}
+
+var _ ResourceWithUpdate = frameworkWrapperTestResource{}
+
+func (r frameworkWrapperTestResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"size": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+		},
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+		"rule": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"priority": {
+						Type:     pluginsdk.TypeInt,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r frameworkWrapperTestResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"output": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r frameworkWrapperTestResource) ModelObject() interface{} {
+	return &frameworkWrapperTestModel{}
+}
+
+func (r frameworkWrapperTestResource) ResourceType() string {
+	return "validator_framework_wrapper"
+}
+
+func (r frameworkWrapperTestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.StringMatch(regexp.MustCompile(`^/things/[a-z]+$`), "")
+}
+
+func (r frameworkWrapperTestResource) Create() ResourceFunc {
+	return ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			var config frameworkWrapperTestModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := frameworkWrapperTestId{Name: config.Name}
+			if _, exists := r.store[id.ID()]; exists {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			r.store[id.ID()] = config
+			r.createTimeouts[id.ID()] = metadata.ResourceData.Timeout(pluginsdk.TimeoutCreate)
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r frameworkWrapperTestResource) Read() ResourceFunc {
+	return ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			id := frameworkWrapperTestId{Name: strings.TrimPrefix(metadata.ResourceData.Id(), "/things/")}
+			existing, ok := r.store[id.ID()]
+			if !ok {
+				return metadata.MarkAsGone(id)
+			}
+
+			existing.Output = fmt.Sprintf("computed-%s", existing.Name)
+			return metadata.Encode(&existing)
+		},
+	}
+}
+
+func (r frameworkWrapperTestResource) Update() ResourceFunc {
+	return ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			existing := r.store[metadata.ResourceData.Id()]
+
+			var config frameworkWrapperTestModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("size") {
+				existing.Size = config.Size
+			}
+			if metadata.ResourceData.HasChange("enabled") {
+				existing.Enabled = config.Enabled
+			}
+			if metadata.ResourceData.HasChange("tags") {
+				existing.Tags = config.Tags
+			}
+			if metadata.ResourceData.HasChange("rule") {
+				existing.Rule = config.Rule
+			}
+
+			r.store[metadata.ResourceData.Id()] = existing
+			return nil
+		},
+	}
+}
+
+func (r frameworkWrapperTestResource) Delete() ResourceFunc {
+	return ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			delete(r.store, metadata.ResourceData.Id())
+			return nil
+		},
+	}
+}
+
+var _ resourceids.Id = frameworkWrapperTestId{}
+
+type frameworkWrapperTestId struct {
+	Name string
+}
+
+func (id frameworkWrapperTestId) ID() string {
+	return fmt.Sprintf("/things/%s", id.Name)
+}
+
+func (id frameworkWrapperTestId) String() string {
+	return fmt.Sprintf("Thing %q", id.Name)
+}
+
+func TestFrameworkResourceWrapper_Schema(t *testing.T) {
+	_, s := newFrameworkWrapperForTest(t)
+
+	if v, ok := s.Attributes["name"].(frameworkschema.StringAttribute); !ok || !v.Required {
+		t.Fatalf("expected `name` to be a Required String but got %+v", s.Attributes["name"])
+	}
+	if v, ok := s.Attributes["enabled"].(frameworkschema.BoolAttribute); !ok || !v.Optional || !v.Computed {
+		t.Fatalf("expected `enabled` to be an Optional+Computed Bool (since it has a Default) but got %+v", s.Attributes["enabled"])
+	}
+	if v, ok := s.Attributes["size"].(frameworkschema.Int64Attribute); !ok || !v.Optional || v.Computed {
+		t.Fatalf("expected `size` to be an Optional Int64 but got %+v", s.Attributes["size"])
+	}
+	if _, ok := s.Attributes["tags"].(frameworkschema.MapAttribute); !ok {
+		t.Fatalf("expected `tags` to be a Map but got %+v", s.Attributes["tags"])
+	}
+	if v, ok := s.Attributes["output"].(frameworkschema.StringAttribute); !ok || !v.Computed || v.Optional {
+		t.Fatalf("expected `output` to be a Computed String but got %+v", s.Attributes["output"])
+	}
+	if v, ok := s.Attributes["id"].(frameworkschema.StringAttribute); !ok || !v.Computed {
+		t.Fatalf("expected `id` to be a Computed String but got %+v", s.Attributes["id"])
+	}
+	if _, ok := s.Blocks["rule"].(frameworkschema.ListNestedBlock); !ok {
+		t.Fatalf("expected `rule` to be a List Block but got %+v", s.Blocks["rule"])
+	}
+}
+
+func TestFrameworkResourceWrapper_Wrappers(t *testing.T) {
+	wrapper := NewFrameworkResourceWrapper(frameworkWrapperTestResource{}, func(r *pluginsdk.Resource) {
+		r.Schema["tags_all"] = &pluginsdk.Schema{
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		}
+	})()
+
+	response := resource.SchemaResponse{}
+	wrapper.Schema(context.TODO(), resource.SchemaRequest{}, &response)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("building schema: %+v", response.Diagnostics)
+	}
+
+	if v, ok := response.Schema.Attributes["tags_all"].(frameworkschema.MapAttribute); !ok || !v.Computed {
+		t.Fatalf("expected the wrapper to add `tags_all` as a Computed Map but got %+v", response.Schema.Attributes["tags_all"])
+	}
+}
+
+func TestFrameworkResourceWrapper_ValidateConfig(t *testing.T) {
+	ctx := context.TODO()
+	wrapper, s := newFrameworkWrapperForTest(t)
+
+	request := resource.ValidateConfigRequest{
+		Config: tfsdk.Config{
+			Schema: s,
+			Raw: frameworkWrapperTestObject(t, s, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, ""),
+			}),
+		},
+	}
+	response := resource.ValidateConfigResponse{}
+	wrapper.ValidateConfig(ctx, request, &response)
+	if !response.Diagnostics.HasError() {
+		t.Fatalf("expected an error for an empty `name` but didn't get one")
+	}
+}
+
+func TestFrameworkResourceWrapper_Lifecycle(t *testing.T) {
+	ctx := context.TODO()
+	wrapper, s := newFrameworkWrapperForTest(t)
+	store := wrapper.resource.(frameworkWrapperTestResource).store
+
+	// Create
+	config := frameworkWrapperTestObject(t, s, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "example"),
+		"size": tftypes.NewValue(tftypes.Number, big.NewFloat(3)),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"env": tftypes.NewValue(tftypes.String, "test"),
+		}),
+	})
+	plan := frameworkWrapperTestPlan(t, wrapper, s, tftypes.NewValue(config.Type(), nil), config)
+	if v := frameworkWrapperTestAttribute(t, plan, "enabled"); !v.Equal(tftypes.NewValue(tftypes.Bool, true)) {
+		t.Fatalf("expected the Default for `enabled` to be planned but got %s", v)
+	}
+
+	createResponse := resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(config.Type(), nil),
+		},
+	}
+	wrapper.Create(ctx, resource.CreateRequest{
+		Config: tfsdk.Config{Schema: s, Raw: config},
+		Plan:   tfsdk.Plan{Schema: s, Raw: plan},
+	}, &createResponse)
+	if createResponse.Diagnostics.HasError() {
+		t.Fatalf("creating: %+v", createResponse.Diagnostics)
+	}
+	state := createResponse.State.Raw
+	expected := map[string]tftypes.Value{
+		"id":      tftypes.NewValue(tftypes.String, "/things/example"),
+		"output":  tftypes.NewValue(tftypes.String, "computed-example"),
+		"enabled": tftypes.NewValue(tftypes.Bool, true),
+		"size":    tftypes.NewValue(tftypes.Number, big.NewFloat(3)),
+	}
+	for k, v := range expected {
+		if actual := frameworkWrapperTestAttribute(t, state, k); !actual.Equal(v) {
+			t.Fatalf("expected %q to be %s but got %s", k, v, actual)
+		}
+	}
+	if !store["/things/example"].Enabled {
+		t.Fatalf("expected the Default for `enabled` to be passed to the Create function")
+	}
+
+	// Read
+	readResponse := resource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: state},
+	}
+	wrapper.Read(ctx, resource.ReadRequest{State: tfsdk.State{Schema: s, Raw: state}}, &readResponse)
+	if readResponse.Diagnostics.HasError() {
+		t.Fatalf("reading: %+v", readResponse.Diagnostics)
+	}
+	if !readResponse.State.Raw.Equal(state) {
+		t.Fatalf("expected the refreshed state to match the state after Create\n\nExpected: %s\n\nActual: %s", state, readResponse.State.Raw)
+	}
+
+	// Update
+	updatedConfig := frameworkWrapperTestObject(t, s, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "example"),
+		"size": tftypes.NewValue(tftypes.Number, big.NewFloat(5)),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"env": tftypes.NewValue(tftypes.String, "test"),
+		}),
+	})
+	updatedPlan := frameworkWrapperTestPlan(t, wrapper, s, state, updatedConfig)
+	if v := frameworkWrapperTestAttribute(t, updatedPlan, "id"); !v.Equal(tftypes.NewValue(tftypes.String, "/things/example")) {
+		t.Fatalf("expected `id` to be retained from the prior state but got %s", v)
+	}
+	updateResponse := resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: state},
+	}
+	wrapper.Update(ctx, resource.UpdateRequest{
+		Config: tfsdk.Config{Schema: s, Raw: updatedConfig},
+		Plan:   tfsdk.Plan{Schema: s, Raw: updatedPlan},
+		State:  tfsdk.State{Schema: s, Raw: state},
+	}, &updateResponse)
+	if updateResponse.Diagnostics.HasError() {
+		t.Fatalf("updating: %+v", updateResponse.Diagnostics)
+	}
+	if v := frameworkWrapperTestAttribute(t, updateResponse.State.Raw, "size"); !v.Equal(tftypes.NewValue(tftypes.Number, big.NewFloat(5))) {
+		t.Fatalf("expected `size` to be 5 but got %s", v)
+	}
+	if store["/things/example"].Size != 5 {
+		t.Fatalf("expected the Update function to have been called")
+	}
+
+	// changing a ForceNew field should require replacement
+	replacedConfig := frameworkWrapperTestObject(t, s, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "replaced"),
+	})
+	_, replacedPlanResponse := frameworkWrapperTestModifyPlan(t, wrapper, s, updateResponse.State.Raw, replacedConfig)
+	if len(replacedPlanResponse.RequiresReplace) != 1 || replacedPlanResponse.RequiresReplace[0].String() != "name" {
+		t.Fatalf("expected `name` to require replacement but got %+v", replacedPlanResponse.RequiresReplace)
+	}
+
+	// Delete
+	deleteResponse := resource.DeleteResponse{
+		State: tfsdk.State{Schema: s, Raw: updateResponse.State.Raw},
+	}
+	wrapper.Delete(ctx, resource.DeleteRequest{State: tfsdk.State{Schema: s, Raw: updateResponse.State.Raw}}, &deleteResponse)
+	if deleteResponse.Diagnostics.HasError() {
+		t.Fatalf("deleting: %+v", deleteResponse.Diagnostics)
+	}
+	if len(store) != 0 {
+		t.Fatalf("expected the Delete function to have been called")
+	}
+
+	// Read after Delete should remove the resource from the state
+	goneResponse := resource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: updateResponse.State.Raw},
+	}
+	wrapper.Read(ctx, resource.ReadRequest{State: tfsdk.State{Schema: s, Raw: updateResponse.State.Raw}}, &goneResponse)
+	if goneResponse.Diagnostics.HasError() {
+		t.Fatalf("reading: %+v", goneResponse.Diagnostics)
+	}
+	if !goneResponse.State.Raw.IsNull() {
+		t.Fatalf("expected the resource to be removed from the state but got %s", goneResponse.State.Raw)
+	}
+}
+
+func TestFrameworkResourceWrapper_Timeouts(t *testing.T) {
+	ctx := context.TODO()
+	wrapper, s := newFrameworkWrapperForTest(t)
+	createTimeouts := wrapper.resource.(frameworkWrapperTestResource).createTimeouts
+
+	block, ok := s.Blocks["timeouts"].(frameworkschema.SingleNestedBlock)
+	if !ok {
+		t.Fatalf("expected `timeouts` to be a Single Block but got %+v", s.Blocks["timeouts"])
+	}
+	for _, k := range []string{"create", "read", "update", "delete"} {
+		if v, ok := block.Attributes[k].(frameworkschema.StringAttribute); !ok || !v.Optional {
+			t.Fatalf("expected `timeouts.%s` to be an Optional String but got %+v", k, block.Attributes[k])
+		}
+	}
+
+	timeoutsType := s.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes["timeouts"]
+	timeouts := func(create string) tftypes.Value {
+		values := make(map[string]tftypes.Value)
+		for k, v := range timeoutsType.(tftypes.Object).AttributeTypes {
+			values[k] = tftypes.NewValue(v, nil)
+		}
+		values["create"] = tftypes.NewValue(tftypes.String, create)
+		return tftypes.NewValue(timeoutsType, values)
+	}
+
+	invalid := resource.ValidateConfigResponse{}
+	wrapper.ValidateConfig(ctx, resource.ValidateConfigRequest{
+		Config: tfsdk.Config{
+			Schema: s,
+			Raw: frameworkWrapperTestObject(t, s, map[string]tftypes.Value{
+				"name":     tftypes.NewValue(tftypes.String, "example"),
+				"timeouts": timeouts("invalid"),
+			}),
+		},
+	}, &invalid)
+	if !invalid.Diagnostics.HasError() {
+		t.Fatalf("expected an error for an invalid `timeouts.create` but didn't get one")
+	}
+
+	config := frameworkWrapperTestObject(t, s, map[string]tftypes.Value{
+		"name":     tftypes.NewValue(tftypes.String, "example"),
+		"timeouts": timeouts("10m"),
+	})
+	plan := frameworkWrapperTestPlan(t, wrapper, s, tftypes.NewValue(config.Type(), nil), config)
+
+	createResponse := resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(config.Type(), nil),
+		},
+	}
+	wrapper.Create(ctx, resource.CreateRequest{
+		Config: tfsdk.Config{Schema: s, Raw: config},
+		Plan:   tfsdk.Plan{Schema: s, Raw: plan},
+	}, &createResponse)
+	if createResponse.Diagnostics.HasError() {
+		t.Fatalf("creating: %+v", createResponse.Diagnostics)
+	}
+	if v := createTimeouts["/things/example"]; v != 10*time.Minute {
+		t.Fatalf("expected the Create timeout to be 10m but got %s", v)
+	}
+	state := createResponse.State.Raw
+	if v := frameworkWrapperTestAttribute(t, state, "timeouts"); !v.Equal(timeouts("10m")) {
+		t.Fatalf("expected `timeouts` to be retained in the state but got %s", v)
+	}
+
+	readResponse := resource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: state},
+	}
+	wrapper.Read(ctx, resource.ReadRequest{State: tfsdk.State{Schema: s, Raw: state}}, &readResponse)
+	if readResponse.Diagnostics.HasError() {
+		t.Fatalf("reading: %+v", readResponse.Diagnostics)
+	}
+	if v := frameworkWrapperTestAttribute(t, readResponse.State.Raw, "timeouts"); !v.Equal(timeouts("10m")) {
+		t.Fatalf("expected `timeouts` to be retained in the refreshed state but got %s", v)
+	}
+
+	// changing only the timeouts shouldn't result in an update, but the new timeouts need to be planned
+	updatedConfig := frameworkWrapperTestObject(t, s, map[string]tftypes.Value{
+		"name":     tftypes.NewValue(tftypes.String, "example"),
+		"timeouts": timeouts("20m"),
+	})
+	updatedPlan := frameworkWrapperTestPlan(t, wrapper, s, state, updatedConfig)
+	if v := frameworkWrapperTestAttribute(t, updatedPlan, "timeouts"); !v.Equal(timeouts("20m")) {
+		t.Fatalf("expected `timeouts` to be taken from the config but got %s", v)
+	}
+}
+
+func TestFrameworkResourceWrapper_ImportState(t *testing.T) {
+	ctx := context.TODO()
+	wrapper, s := newFrameworkWrapperForTest(t)
+
+	response := resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		},
+	}
+	wrapper.ImportState(ctx, resource.ImportStateRequest{ID: "/things/example"}, &response)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("importing: %+v", response.Diagnostics)
+	}
+	if v := frameworkWrapperTestAttribute(t, response.State.Raw, "id"); !v.Equal(tftypes.NewValue(tftypes.String, "/things/example")) {
+		t.Fatalf("expected `id` to be imported but got %s", v)
+	}
+
+	invalid := resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		},
+	}
+	wrapper.ImportState(ctx, resource.ImportStateRequest{ID: "/not/valid"}, &invalid)
+	if !invalid.Diagnostics.HasError() {
+		t.Fatalf("expected an error when importing an invalid ID but didn't get one")
+	}
+}
+
+func TestFrameworkResourceWrapper_WriteOnly(t *testing.T) {
+	ctx := context.TODO()
+
+	var password cty.Value
+	wrapper := NewFrameworkResourceWrapper(frameworkWrapperTestResource{
+		store:          map[string]frameworkWrapperTestModel{},
+		createTimeouts: map[string]time.Duration{},
+	}, func(r *pluginsdk.Resource) {
+		r.Schema["password_wo"] = &pluginsdk.Schema{
+			Type:      pluginsdk.TypeString,
+			Optional:  true,
+			Sensitive: true,
+			WriteOnly: true,
+		}
+		r.Schema["password_wo_version"] = &pluginsdk.Schema{
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+		}
+		r.ValidateRawResourceConfigFuncs = append(r.ValidateRawResourceConfigFuncs, ValidateRawResourceConfig("password_wo"))
+
+		create := r.CreateContext
+		r.CreateContext = func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdkdiag.Diagnostics {
+			password = d.GetRawConfig().GetAttr("password_wo")
+			return create(ctx, d, meta)
+		}
+	})().(*FrameworkResourceWrapper)
+	wrapper.Client = &clients.Client{}
+
+	schemaResponse := resource.SchemaResponse{}
+	wrapper.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("building schema: %+v", schemaResponse.Diagnostics)
+	}
+	s := schemaResponse.Schema
+
+	if v, ok := s.Attributes["password_wo"].(frameworkschema.StringAttribute); !ok || !v.WriteOnly || !v.Sensitive {
+		t.Fatalf("expected `password_wo` to be a write-only Sensitive String but got %+v", s.Attributes["password_wo"])
+	}
+
+	// the Framework nulls out write-only values in the plan, but they remain available in the config
+	config := frameworkWrapperTestObject(t, s, map[string]tftypes.Value{
+		"name":        tftypes.NewValue(tftypes.String, "example"),
+		"password_wo": tftypes.NewValue(tftypes.String, "s3cr3t"),
+	})
+	plan := frameworkWrapperTestObject(t, s, map[string]tftypes.Value{
+		"name":    tftypes.NewValue(tftypes.String, "example"),
+		"enabled": tftypes.NewValue(tftypes.Bool, true),
+		"id":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"output":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+
+	createResponse := resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(config.Type(), nil),
+		},
+	}
+	wrapper.Create(ctx, resource.CreateRequest{
+		Config: tfsdk.Config{Schema: s, Raw: config},
+		Plan:   tfsdk.Plan{Schema: s, Raw: plan},
+	}, &createResponse)
+	if createResponse.Diagnostics.HasError() {
+		t.Fatalf("creating: %+v", createResponse.Diagnostics)
+	}
+	if password.IsNull() || password.AsString() != "s3cr3t" {
+		t.Fatalf("expected the write-only value to be available from the raw config but got %#v", password)
+	}
+	if v := frameworkWrapperTestAttribute(t, createResponse.State.Raw, "password_wo"); !v.IsNull() {
+		t.Fatalf("expected `password_wo` not to be stored in the state but got %s", v)
+	}
+
+	// the functions validating the raw config are called, which require the version alongside the write-only value
+	missingVersion := resource.ValidateConfigResponse{}
+	wrapper.ValidateConfig(ctx, resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: s, Raw: config},
+	}, &missingVersion)
+	if !missingVersion.Diagnostics.HasError() {
+		t.Fatalf("expected an error when `password_wo_version` isn't specified but didn't get one")
+	}
+
+	withVersion := resource.ValidateConfigResponse{}
+	wrapper.ValidateConfig(ctx, resource.ValidateConfigRequest{
+		Config: tfsdk.Config{
+			Schema: s,
+			Raw: frameworkWrapperTestObject(t, s, map[string]tftypes.Value{
+				"name":                tftypes.NewValue(tftypes.String, "example"),
+				"password_wo":         tftypes.NewValue(tftypes.String, "s3cr3t"),
+				"password_wo_version": tftypes.NewValue(tftypes.Number, big.NewFloat(1)),
+			}),
+		},
+	}, &withVersion)
+	if withVersion.Diagnostics.HasError() {
+		t.Fatalf("validating: %+v", withVersion.Diagnostics)
+	}
+}
+
+func TestFrameworkResourceWrapper_Identity(t *testing.T) {
+	ctx := context.TODO()
+
+	setIdentity := func(d *pluginsdk.ResourceData) pluginsdkdiag.Diagnostics {
+		identity, err := d.Identity()
+		if err != nil {
+			return pluginsdkdiag.FromErr(err)
+		}
+		if err := identity.Set("name", strings.TrimPrefix(d.Id(), "/things/")); err != nil {
+			return pluginsdkdiag.FromErr(err)
+		}
+		return nil
+	}
+
+	r := NewFrameworkResourceWrapper(frameworkWrapperTestResource{
+		store:          map[string]frameworkWrapperTestModel{},
+		createTimeouts: map[string]time.Duration{},
+	}, func(r *pluginsdk.Resource) {
+		r.Identity = &pluginsdk.ResourceIdentity{
+			SchemaFunc: func() map[string]*pluginsdk.Schema {
+				return map[string]*pluginsdk.Schema{
+					"name": {
+						Type:              pluginsdk.TypeString,
+						RequiredForImport: true,
+					},
+				}
+			},
+		}
+
+		create := r.CreateContext
+		r.CreateContext = func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdkdiag.Diagnostics {
+			if diags := create(ctx, d, meta); diags.HasError() {
+				return diags
+			}
+			return setIdentity(d)
+		}
+		read := r.ReadContext
+		r.ReadContext = func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdkdiag.Diagnostics {
+			if diags := read(ctx, d, meta); diags.HasError() || d.Id() == "" {
+				return diags
+			}
+			return setIdentity(d)
+		}
+	})()
+
+	wrapper, ok := r.(*frameworkResourceWithIdentityWrapper)
+	if !ok {
+		t.Fatalf("expected a Resource with an Identity to implement resource.ResourceWithIdentity but got %T", r)
+	}
+	wrapper.Client = &clients.Client{}
+
+	identitySchemaResponse := resource.IdentitySchemaResponse{}
+	wrapper.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResponse)
+	if identitySchemaResponse.Diagnostics.HasError() {
+		t.Fatalf("building identity schema: %+v", identitySchemaResponse.Diagnostics)
+	}
+	identitySchema := identitySchemaResponse.IdentitySchema
+	if v, ok := identitySchema.Attributes["name"].(identityschema.StringAttribute); !ok || !v.RequiredForImport {
+		t.Fatalf("expected the identity to contain `name` as a String Required for Import but got %+v", identitySchema.Attributes["name"])
+	}
+	identityType := identitySchema.Type().TerraformType(ctx)
+
+	schemaResponse := resource.SchemaResponse{}
+	wrapper.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("building schema: %+v", schemaResponse.Diagnostics)
+	}
+	s := schemaResponse.Schema
+
+	config := frameworkWrapperTestObject(t, s, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "example"),
+	})
+	plan := frameworkWrapperTestPlan(t, wrapper.FrameworkResourceWrapper, s, tftypes.NewValue(config.Type(), nil), config)
+
+	createResponse := resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(config.Type(), nil),
+		},
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identitySchema,
+			Raw:    tftypes.NewValue(identityType, nil),
+		},
+	}
+	wrapper.Create(ctx, resource.CreateRequest{
+		Config: tfsdk.Config{Schema: s, Raw: config},
+		Plan:   tfsdk.Plan{Schema: s, Raw: plan},
+	}, &createResponse)
+	if createResponse.Diagnostics.HasError() {
+		t.Fatalf("creating: %+v", createResponse.Diagnostics)
+	}
+	expected := tftypes.NewValue(identityType, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "example"),
+	})
+	if !createResponse.Identity.Raw.Equal(expected) {
+		t.Fatalf("expected the identity to be %s after Create but got %s", expected, createResponse.Identity.Raw)
+	}
+
+	readResponse := resource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: createResponse.State.Raw},
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identitySchema,
+			Raw:    createResponse.Identity.Raw,
+		},
+	}
+	wrapper.Read(ctx, resource.ReadRequest{
+		State: tfsdk.State{Schema: s, Raw: createResponse.State.Raw},
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identitySchema,
+			Raw:    createResponse.Identity.Raw,
+		},
+	}, &readResponse)
+	if readResponse.Diagnostics.HasError() {
+		t.Fatalf("reading: %+v", readResponse.Diagnostics)
+	}
+	if !readResponse.Identity.Raw.Equal(expected) {
+		t.Fatalf("expected the identity to be %s after Read but got %s", expected, readResponse.Identity.Raw)
+	}
+
+	// Resources without an Identity don't expose an Identity Schema
+	if _, ok := NewFrameworkResourceWrapper(frameworkWrapperTestResource{})().(resource.ResourceWithIdentity); ok {
+		t.Fatalf("expected a Resource without an Identity not to implement resource.ResourceWithIdentity")
+	}
+}
+
+func newFrameworkWrapperForTest(t *testing.T) (*FrameworkResourceWrapper, frameworkschema.Schema) {
+	ctx := context.TODO()
+
+	wrapper := NewFrameworkResourceWrapper(frameworkWrapperTestResource{
+		store:          map[string]frameworkWrapperTestModel{},
+		createTimeouts: map[string]time.Duration{},
+	})().(*FrameworkResourceWrapper)
+	wrapper.Client = &clients.Client{}
+
+	schemaResponse := resource.SchemaResponse{}
+	wrapper.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("building schema: %+v", schemaResponse.Diagnostics)
+	}
+
+	return wrapper, schemaResponse.Schema
+}
+
+// frameworkWrapperTestObject returns an object conforming to the Schema with the specified values, where all
+// other values are null
+func frameworkWrapperTestObject(t *testing.T, s frameworkschema.Schema, values map[string]tftypes.Value) tftypes.Value {
+	ty, ok := s.Type().TerraformType(context.TODO()).(tftypes.Object)
+	if !ok {
+		t.Fatalf("expected the Schema to be an Object")
+	}
+
+	output := make(map[string]tftypes.Value)
+	for k, v := range ty.AttributeTypes {
+		output[k] = tftypes.NewValue(v, nil)
+		if value, ok := values[k]; ok {
+			output[k] = value
+		}
+	}
+
+	return tftypes.NewValue(ty, output)
+}
+
+// frameworkWrapperTestPlan mimics the plan produced by the Framework prior to ModifyPlan being called - where
+// Computed values which aren't specified in the config are unknown - and then returns the modified plan
+func frameworkWrapperTestPlan(t *testing.T, wrapper *FrameworkResourceWrapper, s frameworkschema.Schema, state, config tftypes.Value) tftypes.Value {
+	_, response := frameworkWrapperTestModifyPlan(t, wrapper, s, state, config)
+	return response.Plan.Raw
+}
+
+func frameworkWrapperTestModifyPlan(t *testing.T, wrapper *FrameworkResourceWrapper, s frameworkschema.Schema, state, config tftypes.Value) (resource.ModifyPlanRequest, resource.ModifyPlanResponse) {
+	var configValues map[string]tftypes.Value
+	if err := config.As(&configValues); err != nil {
+		t.Fatalf("converting config: %+v", err)
+	}
+
+	proposed := make(map[string]tftypes.Value)
+	for k, v := range configValues {
+		proposed[k] = v
+		if !v.IsNull() {
+			continue
+		}
+
+		if attribute, ok := s.Attributes[k]; ok && attribute.IsComputed() {
+			proposed[k] = tftypes.NewValue(v.Type(), tftypes.UnknownValue)
+		}
+	}
+	plan := tftypes.NewValue(config.Type(), proposed)
+
+	request := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: s, Raw: config},
+		Plan:   tfsdk.Plan{Schema: s, Raw: plan},
+		State:  tfsdk.State{Schema: s, Raw: state},
+	}
+	response := resource.ModifyPlanResponse{
+		Plan: request.Plan,
+	}
+	wrapper.ModifyPlan(context.TODO(), request, &response)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("planning: %+v", response.Diagnostics)
+	}
+
+	return request, response
+}
+
+func frameworkWrapperTestAttribute(t *testing.T, input tftypes.Value, key string) tftypes.Value {
+	var values map[string]tftypes.Value
+	if err := input.As(&values); err != nil {
+		t.Fatalf("converting value: %+v", err)
+	}
+
+	return values[key]
+}
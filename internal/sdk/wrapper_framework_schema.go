@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// frameworkSchemaFromPluginSdk converts the (combined) Plugin SDKv2 Schema for a Typed Resource into the
+// equivalent Plugin Framework Schema.
+//
+// The shape of the output intentionally matches the Schema that Plugin SDKv2 exposes to Terraform (see
+// `CoreConfigSchema` within Plugin SDKv2) - such that values can be passed between the two interchangeably,
+// which allows the existing Create/Read/Update/Delete functions to be reused as-is.
+//
+// Write-only fields are exposed as Framework `WriteOnly` attributes, meaning that the Framework nulls these values
+// out in the plan and the State - whilst the raw config (which Encode/Decode read write-only values from) is
+// still passed through to Plugin SDKv2.
+//
+// NOTE: Validation, Defaults, ForceNew and CustomizeDiff aren't mapped onto Framework Validators/Plan Modifiers,
+// instead these are delegated to Plugin SDKv2 by the FrameworkResourceWrapper to retain the existing behaviours.
+func frameworkSchemaFromPluginSdk(input map[string]*pluginsdk.Schema) (*schema.Schema, error) {
+	attributes, blocks, err := frameworkAttributesAndBlocksFromPluginSdk(input)
+	if err != nil {
+		return nil, err
+	}
+
+	// Plugin SDKv2 injects the `id` field when it's not defined in the Schema, so we need to do the same
+	if _, ok := attributes["id"]; !ok {
+		attributes["id"] = schema.StringAttribute{
+			Computed: true,
+		}
+	}
+
+	return &schema.Schema{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}, nil
+}
+
+// frameworkTimeoutsBlockFromPluginSdk returns the `timeouts` block for the Timeouts configured for a Resource, which
+// (as with Plugin SDKv2) contains an Optional String for each of the operations which a Timeout is configured for
+func frameworkTimeoutsBlockFromPluginSdk(input *pluginsdk.ResourceTimeout) schema.Block {
+	attributes := make(map[string]schema.Attribute)
+	timeouts := map[string]*time.Duration{
+		pluginsdk.TimeoutCreate:  input.Create,
+		pluginsdk.TimeoutRead:    input.Read,
+		pluginsdk.TimeoutUpdate:  input.Update,
+		pluginsdk.TimeoutDelete:  input.Delete,
+		pluginsdk.TimeoutDefault: input.Default,
+	}
+	for k, v := range timeouts {
+		if v != nil {
+			attributes[k] = schema.StringAttribute{
+				Optional: true,
+			}
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Attributes: attributes,
+	}
+}
+
+// frameworkIdentitySchemaFromPluginSdk converts the Plugin SDKv2 Resource Identity for a Resource into the
+// equivalent Plugin Framework Identity Schema.
+//
+// Resource Identities only contain primitive values, which Plugin SDKv2 stores as a flatmap in the State - as
+// such only the primitive types are supported here.
+func frameworkIdentitySchemaFromPluginSdk(input *pluginsdk.ResourceIdentity) (*identityschema.Schema, error) {
+	attributes := make(map[string]identityschema.Attribute)
+
+	for k, v := range input.SchemaMap() {
+		switch v.Type {
+		case pluginsdk.TypeBool:
+			attributes[k] = identityschema.BoolAttribute{
+				RequiredForImport: v.RequiredForImport,
+				OptionalForImport: v.OptionalForImport,
+				Description:       v.Description,
+			}
+
+		case pluginsdk.TypeInt:
+			attributes[k] = identityschema.Int64Attribute{
+				RequiredForImport: v.RequiredForImport,
+				OptionalForImport: v.OptionalForImport,
+				Description:       v.Description,
+			}
+
+		case pluginsdk.TypeFloat:
+			attributes[k] = identityschema.Float64Attribute{
+				RequiredForImport: v.RequiredForImport,
+				OptionalForImport: v.OptionalForImport,
+				Description:       v.Description,
+			}
+
+		case pluginsdk.TypeString:
+			attributes[k] = identityschema.StringAttribute{
+				RequiredForImport: v.RequiredForImport,
+				OptionalForImport: v.OptionalForImport,
+				Description:       v.Description,
+			}
+
+		default:
+			return nil, fmt.Errorf("converting identity attribute %q: unsupported type %q", k, v.Type.String())
+		}
+	}
+
+	return &identityschema.Schema{
+		Attributes: attributes,
+		Version:    input.Version,
+	}, nil
+}
+
+func frameworkAttributesAndBlocksFromPluginSdk(input map[string]*pluginsdk.Schema) (map[string]schema.Attribute, map[string]schema.Block, error) {
+	attributes := make(map[string]schema.Attribute)
+	blocks := make(map[string]schema.Block)
+
+	for k, v := range input {
+		if isPluginSdkBlock(v) {
+			block, err := frameworkBlockFromPluginSdk(v)
+			if err != nil {
+				return nil, nil, fmt.Errorf("converting block %q: %+v", k, err)
+			}
+			blocks[k] = block
+			continue
+		}
+
+		attribute, err := frameworkAttributeFromPluginSdk(v)
+		if err != nil {
+			return nil, nil, fmt.Errorf("converting attribute %q: %+v", k, err)
+		}
+		attributes[k] = attribute
+	}
+
+	return attributes, blocks, nil
+}
+
+// isPluginSdkBlock returns whether the Plugin SDKv2 field is exposed as a Block, rather than an Attribute
+func isPluginSdkBlock(input *pluginsdk.Schema) bool {
+	if input.Type != pluginsdk.TypeList && input.Type != pluginsdk.TypeSet {
+		return false
+	}
+	if _, ok := input.Elem.(*pluginsdk.Resource); !ok {
+		return false
+	}
+
+	switch input.ConfigMode {
+	case pluginsdk.SchemaConfigModeAttr:
+		return false
+	case pluginsdk.SchemaConfigModeBlock:
+		return true
+	}
+
+	// Computed-only nested fields are always exposed as Attributes
+	return !input.Computed || input.Optional
+}
+
+func frameworkBlockFromPluginSdk(input *pluginsdk.Schema) (schema.Block, error) {
+	if input.WriteOnly {
+		return nil, fmt.Errorf("write-only Blocks are not supported by the Plugin Framework")
+	}
+
+	nested := input.Elem.(*pluginsdk.Resource)
+
+	attributes, blocks, err := frameworkAttributesAndBlocksFromPluginSdk(nested.SchemaMap())
+	if err != nil {
+		return nil, err
+	}
+	nestedObject := schema.NestedBlockObject{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+
+	if input.Type == pluginsdk.TypeSet {
+		return schema.SetNestedBlock{
+			NestedObject:       nestedObject,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}, nil
+	}
+
+	return schema.ListNestedBlock{
+		NestedObject:       nestedObject,
+		Description:        input.Description,
+		DeprecationMessage: input.Deprecated,
+	}, nil
+}
+
+func frameworkAttributeFromPluginSdk(input *pluginsdk.Schema) (schema.Attribute, error) {
+	// Fields with a Default value need to be Computed in the Framework, since the value
+	// is populated during the plan when it's omitted from the config
+	computed := input.Computed || input.Default != nil
+
+	switch input.Type {
+	case pluginsdk.TypeBool:
+		return schema.BoolAttribute{
+			Required:           input.Required,
+			Optional:           input.Optional,
+			Computed:           computed,
+			Sensitive:          input.Sensitive,
+			WriteOnly:          input.WriteOnly,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}, nil
+
+	case pluginsdk.TypeInt:
+		return schema.Int64Attribute{
+			Required:           input.Required,
+			Optional:           input.Optional,
+			Computed:           computed,
+			Sensitive:          input.Sensitive,
+			WriteOnly:          input.WriteOnly,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}, nil
+
+	case pluginsdk.TypeFloat:
+		return schema.Float64Attribute{
+			Required:           input.Required,
+			Optional:           input.Optional,
+			Computed:           computed,
+			Sensitive:          input.Sensitive,
+			WriteOnly:          input.WriteOnly,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}, nil
+
+	case pluginsdk.TypeString:
+		return schema.StringAttribute{
+			Required:           input.Required,
+			Optional:           input.Optional,
+			Computed:           computed,
+			Sensitive:          input.Sensitive,
+			WriteOnly:          input.WriteOnly,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}, nil
+
+	case pluginsdk.TypeList:
+		elementType, err := frameworkElementTypeFromPluginSdk(input.Elem)
+		if err != nil {
+			return nil, err
+		}
+		return schema.ListAttribute{
+			ElementType:        elementType,
+			Required:           input.Required,
+			Optional:           input.Optional,
+			Computed:           computed,
+			Sensitive:          input.Sensitive,
+			WriteOnly:          input.WriteOnly,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}, nil
+
+	case pluginsdk.TypeSet:
+		if input.WriteOnly {
+			return nil, fmt.Errorf("write-only Sets are not supported by the Plugin Framework")
+		}
+		elementType, err := frameworkElementTypeFromPluginSdk(input.Elem)
+		if err != nil {
+			return nil, err
+		}
+		return schema.SetAttribute{
+			ElementType:        elementType,
+			Required:           input.Required,
+			Optional:           input.Optional,
+			Computed:           computed,
+			Sensitive:          input.Sensitive,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}, nil
+
+	case pluginsdk.TypeMap:
+		elementType, err := frameworkMapElementTypeFromPluginSdk(input.Elem)
+		if err != nil {
+			return nil, err
+		}
+		return schema.MapAttribute{
+			ElementType:        elementType,
+			Required:           input.Required,
+			Optional:           input.Optional,
+			Computed:           computed,
+			Sensitive:          input.Sensitive,
+			WriteOnly:          input.WriteOnly,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %q", input.Type.String())
+}
+
+// frameworkTypeFromPluginSdk returns the Framework type for the Plugin SDKv2 field
+func frameworkTypeFromPluginSdk(input *pluginsdk.Schema) (attr.Type, error) {
+	switch input.Type {
+	case pluginsdk.TypeBool:
+		return types.BoolType, nil
+
+	case pluginsdk.TypeInt:
+		return types.Int64Type, nil
+
+	case pluginsdk.TypeFloat:
+		return types.Float64Type, nil
+
+	case pluginsdk.TypeString:
+		return types.StringType, nil
+
+	case pluginsdk.TypeList:
+		elementType, err := frameworkElementTypeFromPluginSdk(input.Elem)
+		if err != nil {
+			return nil, err
+		}
+		return types.ListType{ElemType: elementType}, nil
+
+	case pluginsdk.TypeSet:
+		elementType, err := frameworkElementTypeFromPluginSdk(input.Elem)
+		if err != nil {
+			return nil, err
+		}
+		return types.SetType{ElemType: elementType}, nil
+
+	case pluginsdk.TypeMap:
+		elementType, err := frameworkMapElementTypeFromPluginSdk(input.Elem)
+		if err != nil {
+			return nil, err
+		}
+		return types.MapType{ElemType: elementType}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %q", input.Type.String())
+}
+
+// frameworkElementTypeFromPluginSdk returns the Framework element type for the `Elem` of a List or Set
+func frameworkElementTypeFromPluginSdk(input interface{}) (attr.Type, error) {
+	switch v := input.(type) {
+	case *pluginsdk.Schema:
+		return frameworkTypeFromPluginSdk(v)
+
+	case *pluginsdk.Resource:
+		attributeTypes := make(map[string]attr.Type)
+		for k, nested := range v.SchemaMap() {
+			attributeType, err := frameworkTypeFromPluginSdk(nested)
+			if err != nil {
+				return nil, fmt.Errorf("converting nested field %q: %+v", k, err)
+			}
+			attributeTypes[k] = attributeType
+		}
+		return types.ObjectType{AttrTypes: attributeTypes}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported element type %T", input)
+}
+
+// frameworkMapElementTypeFromPluginSdk returns the Framework element type for the `Elem` of a Map - which
+// Plugin SDKv2 treats as a String when the `Elem` is omitted or is a Resource
+func frameworkMapElementTypeFromPluginSdk(input interface{}) (attr.Type, error) {
+	if v, ok := input.(*pluginsdk.Schema); ok {
+		return frameworkTypeFromPluginSdk(v)
+	}
+
+	return types.StringType, nil
+}
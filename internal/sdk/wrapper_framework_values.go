@@ -0,0 +1,313 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/convert"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	pluginsdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ctyValueFromTerraformValue converts a value from the Plugin Framework into the equivalent value used by Plugin SDKv2
+//
+// This uses the same msgpack encoding used on the wire between Terraform and Plugin SDKv2, and as such
+// the specified type must be the type implied by the Plugin SDKv2 Schema for this Resource.
+func ctyValueFromTerraformValue(input tftypes.Value, ty cty.Type) (cty.Value, error) {
+	dynamicValue, err := tfprotov5.NewDynamicValue(input.Type(), input)
+	if err != nil {
+		return cty.NilVal, fmt.Errorf("encoding value: %+v", err)
+	}
+
+	val, err := msgpack.Unmarshal(dynamicValue.MsgPack, ty)
+	if err != nil {
+		return cty.NilVal, fmt.Errorf("decoding value: %+v", err)
+	}
+
+	return val, nil
+}
+
+// terraformValueFromCtyValue converts a value from Plugin SDKv2 into the equivalent value used by the Plugin Framework
+func terraformValueFromCtyValue(input cty.Value, ty tftypes.Type) (tftypes.Value, error) {
+	encoded, err := msgpack.Marshal(input, input.Type())
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("encoding value: %+v", err)
+	}
+
+	dynamicValue := tfprotov5.DynamicValue{
+		MsgPack: encoded,
+	}
+	val, err := dynamicValue.Unmarshal(ty)
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("decoding value: %+v", err)
+	}
+
+	return val, nil
+}
+
+// identityMapFromCtyValue converts a Resource Identity value into the flatmap used by Plugin SDKv2 to store
+// the Resource Identity within the InstanceState
+//
+// Resource Identities only contain primitive values (see frameworkIdentitySchemaFromPluginSdk), so each
+// value is converted to a String.
+func identityMapFromCtyValue(input cty.Value) (map[string]string, error) {
+	if input.IsNull() || !input.IsKnown() {
+		return nil, nil
+	}
+
+	output := make(map[string]string)
+	for k, v := range input.AsValueMap() {
+		if v.IsNull() || !v.IsKnown() {
+			continue
+		}
+
+		value, err := convert.Convert(v, cty.String)
+		if err != nil {
+			return nil, fmt.Errorf("converting identity attribute %q: %+v", k, err)
+		}
+		output[k] = value.AsString()
+	}
+
+	return output, nil
+}
+
+// ctyValueFromIdentityMap converts the flatmap used by Plugin SDKv2 to store the Resource Identity into the
+// equivalent value, where the specified type is the type implied by the Resource Identity Schema.
+func ctyValueFromIdentityMap(input map[string]string, ty cty.Type) (cty.Value, error) {
+	if input == nil {
+		return cty.NullVal(ty), nil
+	}
+
+	output := make(map[string]cty.Value)
+	for k, attributeType := range ty.AttributeTypes() {
+		v, ok := input[k]
+		if !ok {
+			output[k] = cty.NullVal(attributeType)
+			continue
+		}
+
+		value, err := convert.Convert(cty.StringVal(v), attributeType)
+		if err != nil {
+			return cty.NilVal, fmt.Errorf("converting identity attribute %q: %+v", k, err)
+		}
+		output[k] = value
+	}
+
+	return cty.ObjectVal(output), nil
+}
+
+// mergePlannedValue returns the value which should be persisted into the State after an Apply.
+//
+// Terraform requires that any value which was known at plan time is returned unchanged - as such the
+// planned value is used where known, with any unknown values being populated from the applied value.
+func mergePlannedValue(planned, applied cty.Value) cty.Value {
+	if !planned.IsKnown() {
+		return applied
+	}
+	if planned.IsWhollyKnown() || applied.IsNull() || !applied.IsKnown() {
+		return planned
+	}
+
+	ty := planned.Type()
+	switch {
+	case ty.IsObjectType():
+		output := make(map[string]cty.Value)
+		for k := range ty.AttributeTypes() {
+			output[k] = mergePlannedValue(planned.GetAttr(k), applied.GetAttr(k))
+		}
+		return cty.ObjectVal(output)
+
+	case ty.IsListType():
+		if planned.LengthInt() != applied.LengthInt() || planned.LengthInt() == 0 {
+			return applied
+		}
+		plannedItems := planned.AsValueSlice()
+		appliedItems := applied.AsValueSlice()
+		output := make([]cty.Value, 0)
+		for i := range plannedItems {
+			output = append(output, mergePlannedValue(plannedItems[i], appliedItems[i]))
+		}
+		return cty.ListVal(output)
+
+	case ty.IsMapType():
+		if planned.LengthInt() == 0 {
+			return applied
+		}
+		appliedItems := applied.AsValueMap()
+		output := make(map[string]cty.Value)
+		for k, v := range planned.AsValueMap() {
+			appliedItem, ok := appliedItems[k]
+			if !ok {
+				appliedItem = cty.NullVal(v.Type())
+			}
+			output[k] = mergePlannedValue(v, appliedItem)
+		}
+		return cty.MapVal(output)
+	}
+
+	// elements within a Set can't be correlated, so the applied value is used as-is
+	return applied
+}
+
+// proposedValueFromPlan reverts values which the Plugin Framework has marked as unknown (since they're
+// Computed and not specified in the config) to the value from the prior State - matching the proposed
+// new state which Terraform sends to Plugin SDKv2, such that Defaults and Computed values are planned
+// by Plugin SDKv2 in the same manner.
+func proposedValueFromPlan(planned, config, prior cty.Value) cty.Value {
+	if !planned.IsKnown() && config.IsNull() {
+		if prior.IsKnown() && !prior.IsNull() {
+			return prior
+		}
+		return cty.NullVal(planned.Type())
+	}
+	if !planned.IsKnown() || planned.IsNull() || config.IsNull() || !config.IsKnown() {
+		return planned
+	}
+
+	ty := planned.Type()
+	switch {
+	case ty.IsObjectType():
+		output := make(map[string]cty.Value)
+		for k, v := range ty.AttributeTypes() {
+			priorAttr := cty.NullVal(v)
+			if !prior.IsNull() && prior.IsKnown() {
+				priorAttr = prior.GetAttr(k)
+			}
+			output[k] = proposedValueFromPlan(planned.GetAttr(k), config.GetAttr(k), priorAttr)
+		}
+		return cty.ObjectVal(output)
+
+	case ty.IsListType():
+		if planned.LengthInt() != config.LengthInt() || planned.LengthInt() == 0 {
+			return planned
+		}
+		var priorItems []cty.Value
+		if !prior.IsNull() && prior.IsKnown() {
+			priorItems = prior.AsValueSlice()
+		}
+		plannedItems := planned.AsValueSlice()
+		configItems := config.AsValueSlice()
+		output := make([]cty.Value, 0)
+		for i := range plannedItems {
+			priorItem := cty.NullVal(ty.ElementType())
+			if i < len(priorItems) {
+				priorItem = priorItems[i]
+			}
+			output = append(output, proposedValueFromPlan(plannedItems[i], configItems[i], priorItem))
+		}
+		return cty.ListVal(output)
+	}
+
+	return planned
+}
+
+// normalizeRefreshedValue ensures that an empty collection returned from the Read function doesn't
+// replace a null value in the prior State, which would otherwise result in a perpetual diff.
+func normalizeRefreshedValue(refreshed, prior cty.Value) cty.Value {
+	if !refreshed.IsKnown() || !prior.IsKnown() {
+		return refreshed
+	}
+
+	ty := refreshed.Type()
+	if prior.IsNull() {
+		if !refreshed.IsNull() && (ty.IsListType() || ty.IsSetType() || ty.IsMapType()) && refreshed.LengthInt() == 0 {
+			return prior
+		}
+		return refreshed
+	}
+
+	if ty.IsObjectType() && !refreshed.IsNull() {
+		output := make(map[string]cty.Value)
+		for k := range ty.AttributeTypes() {
+			output[k] = normalizeRefreshedValue(refreshed.GetAttr(k), prior.GetAttr(k))
+		}
+		return cty.ObjectVal(output)
+	}
+
+	return refreshed
+}
+
+// copyTimeoutsValue copies the `timeouts` block from one value to another, since the timeouts aren't stored in the
+// attributes returned from Plugin SDKv2 - which would otherwise result in a perpetual diff
+func copyTimeoutsValue(to, from cty.Value) cty.Value {
+	if to.IsNull() || !to.IsKnown() || !to.Type().IsObjectType() || !to.Type().HasAttribute(schema.TimeoutsConfigKey) {
+		return to
+	}
+
+	timeouts := cty.NullVal(to.Type().AttributeType(schema.TimeoutsConfigKey))
+	if !from.IsNull() && from.IsKnown() && from.Type().HasAttribute(schema.TimeoutsConfigKey) {
+		if v := from.GetAttr(schema.TimeoutsConfigKey); v.IsWhollyKnown() {
+			timeouts = v
+		}
+	}
+
+	output := to.AsValueMap()
+	output[schema.TimeoutsConfigKey] = timeouts
+	return cty.ObjectVal(output)
+}
+
+// frameworkDiagnosticsFromPluginSdk converts the Diagnostics returned from Plugin SDKv2 into Framework Diagnostics
+func frameworkDiagnosticsFromPluginSdk(input pluginsdkdiag.Diagnostics) diag.Diagnostics {
+	output := make(diag.Diagnostics, 0)
+	for _, v := range input {
+		attributePath, hasPath := frameworkPathFromCtyPath(v.AttributePath)
+
+		switch {
+		case v.Severity == pluginsdkdiag.Warning && hasPath:
+			output.AddAttributeWarning(attributePath, v.Summary, v.Detail)
+		case v.Severity == pluginsdkdiag.Warning:
+			output.AddWarning(v.Summary, v.Detail)
+		case hasPath:
+			output.AddAttributeError(attributePath, v.Summary, v.Detail)
+		default:
+			output.AddError(v.Summary, v.Detail)
+		}
+	}
+
+	return output
+}
+
+func frameworkPathFromCtyPath(input cty.Path) (path.Path, bool) {
+	if len(input) == 0 {
+		return path.Empty(), false
+	}
+
+	output := path.Empty()
+	for i, step := range input {
+		switch v := step.(type) {
+		case cty.GetAttrStep:
+			if i == 0 {
+				output = path.Root(v.Name)
+				continue
+			}
+			output = output.AtName(v.Name)
+
+		case cty.IndexStep:
+			if i == 0 {
+				return path.Empty(), false
+			}
+			switch v.Key.Type() {
+			case cty.String:
+				output = output.AtMapKey(v.Key.AsString())
+			case cty.Number:
+				index, _ := v.Key.AsBigFloat().Int64()
+				output = output.AtListIndex(int(index))
+			default:
+				return output, true
+			}
+
+		default:
+			return path.Empty(), false
+		}
+	}
+
+	return output, true
+}
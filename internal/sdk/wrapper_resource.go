@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -169,6 +170,18 @@ and we recommend using the %[2]q resource instead.
 `, rw.resource.ResourceType(), replacementResourceType)
 	}
 
+	// the write-only properties defined in the model must be specified alongside the paired `_version` property
+	writeOnlyProperties, err := writeOnlyPropertiesForModel(modelObj)
+	if err != nil {
+		return nil, fmt.Errorf("determining the write-only properties for %q: %+v", rw.resource.ResourceType(), err)
+	}
+	if len(writeOnlyProperties) > 0 {
+		resource.ValidateRawResourceConfigFuncs = append(resource.ValidateRawResourceConfigFuncs, ValidateRawResourceConfig(writeOnlyProperties...))
+	}
+	if v, ok := rw.resource.(ResourceWithConfigValidation); ok {
+		resource.ValidateRawResourceConfigFuncs = append(resource.ValidateRawResourceConfigFuncs, v.ValidateRawResourceConfig()...)
+	}
+
 	if v, ok := rw.resource.(ResourceWithStateMigration); ok {
 		stateUpgradeData := v.StateUpgraders()
 		resource.SchemaVersion = stateUpgradeData.SchemaVersion
@@ -203,6 +216,9 @@ and we recommend using the %[2]q resource instead.
 
 			return schema.ImportStatePassthroughContext(ctx, d, meta)
 		}, idType)
+	} else if resourceId, ok := pluginsdk.ResourceIdentityForValidateFunc(rw.resource.IDValidationFunc()); ok {
+		// Resources which don't define a Resource Identity are given one when the type of Resource ID is known
+		pluginsdk.WithResourceIdentity(&resource, resourceId)
 	}
 
 	return &resource, nil
@@ -214,8 +230,15 @@ func (rw *ResourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *sc
 
 func diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error, logger Logger) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		// the operation is cancelled should a lock it holds be lost, rather than continuing without holding it
+		ctx, cancel := locks.WithCancelOnLostLock(ctx)
+		defer cancel()
+
 		out := make([]diag.Diagnostic, 0)
 		if err := in(ctx, d, meta); err != nil {
+			if lost := locks.LostLockError(ctx); lost != nil {
+				err = fmt.Errorf("%+v: %+v", lost, err)
+			}
 			out = append(out, diag.Diagnostic{
 				Severity:      diag.Error,
 				Summary:       err.Error(),
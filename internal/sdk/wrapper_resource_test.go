@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type automaticIdentityTestModel struct {
+	Name string `tfschema:"name"`
+}
+
+type automaticIdentityTestResource struct {
+	validateFunc pluginsdk.SchemaValidateFunc
+}
+
+var _ Resource = automaticIdentityTestResource{}
+
+func (r automaticIdentityTestResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+	}
+}
+
+func (r automaticIdentityTestResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r automaticIdentityTestResource) ModelObject() interface{} {
+	return &automaticIdentityTestModel{}
+}
+
+func (r automaticIdentityTestResource) ResourceType() string {
+	return "validator_automatic_identity"
+}
+
+func (r automaticIdentityTestResource) Create() ResourceFunc {
+	return ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			return nil
+		},
+	}
+}
+
+func (r automaticIdentityTestResource) Read() ResourceFunc {
+	return ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			return nil
+		},
+	}
+}
+
+func (r automaticIdentityTestResource) Delete() ResourceFunc {
+	return ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			return nil
+		},
+	}
+}
+
+func (r automaticIdentityTestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return r.validateFunc
+}
+
+func TestResourceWrapper_AutomaticIdentity(t *testing.T) {
+	pluginsdk.RegisterResourceIdentity(commonids.ValidateVirtualNetworkID, &commonids.VirtualNetworkId{})
+
+	wrapper := NewResourceWrapper(automaticIdentityTestResource{
+		validateFunc: commonids.ValidateVirtualNetworkID,
+	})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	if resource.Identity == nil {
+		t.Fatalf("expected the Resource to have an Identity but it didn't")
+	}
+
+	identitySchema := resource.Identity.SchemaMap()
+	d := schema.TestResourceDataWithIdentityRaw(t, resource.Schema, identitySchema, nil)
+	d.SetId("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1")
+
+	if diags := resource.ReadContext(context.TODO(), d, &clients.Client{}); diags.HasError() {
+		t.Fatalf("reading: %+v", diags)
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		t.Fatalf("retrieving Identity: %+v", err)
+	}
+
+	expected := map[string]string{
+		"subscription_id":     "12345678-1234-9876-4563-123456789012",
+		"resource_group_name": "group1",
+		"name":                "network1",
+	}
+	actual := make(map[string]string)
+	for k := range identitySchema {
+		actual[k] = identity.Get(k).(string)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected the Identity %+v but got %+v", expected, actual)
+	}
+}
+
+func TestResourceWrapper_AutomaticIdentityUnknownResourceId(t *testing.T) {
+	wrapper := NewResourceWrapper(automaticIdentityTestResource{
+		validateFunc: func(input interface{}, key string) (warnings []string, errors []error) {
+			return
+		},
+	})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	if resource.Identity != nil {
+		t.Fatalf("expected the Resource not to have an Identity but it did")
+	}
+}
+
+func TestResourceWrapper_AutomaticIdentityUnsupportedResourceId(t *testing.T) {
+	// Scoped Resource IDs can't be rebuilt from the Identity, so no Identity is generated
+	pluginsdk.RegisterResourceIdentity(commonids.ValidateScopeID, &commonids.ScopeId{})
+
+	wrapper := NewResourceWrapper(automaticIdentityTestResource{
+		validateFunc: commonids.ValidateScopeID,
+	})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	if resource.Identity != nil {
+		t.Fatalf("expected the Resource not to have an Identity but it did")
+	}
+}
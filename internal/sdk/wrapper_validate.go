@@ -6,11 +6,16 @@ package sdk
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 // ValidateModelObject validates that the object contains the specified `tfschema` tags
-// required to be used with the Encode and Decode functions
+// required to be used with the Encode and Decode functions - ValidateModelObjectAgainstSchema
+// additionally validates these tags against the schema
 func ValidateModelObject(input interface{}) error {
 	if input == nil {
 		// model not used for this resource
@@ -21,8 +26,6 @@ func ValidateModelObject(input interface{}) error {
 		return fmt.Errorf("need a pointer to the model object")
 	}
 
-	// TODO: could we also validate that each `tfschema` tag exists in the schema?
-
 	objType := reflect.TypeOf(input).Elem()
 	objVal := reflect.ValueOf(input).Elem()
 
@@ -71,3 +74,174 @@ func validateModelObjectRecursively(prefix string, objType reflect.Type, objVal
 
 	return nil
 }
+
+// ValidateModelObjectAgainstSchema validates that each `tfschema` tag in the object exists in the schema, that
+// each property in the schema has a corresponding field in the object, and that the Go type of each field is
+// compatible with the type of the property in the schema - including any nested blocks.
+//
+// Fields tagged with either `addedInNextMajorVersion` or `removedInNextMajorVersion` may be absent from the
+// schema, since these are conditionally defined depending on the major version. Fields tagged with `writeOnly`
+// must be top-level write-only properties in the schema, paired with a `{hclPath}_version` property.
+//
+// Properties which are intentionally not mapped between the model and the schema (for example those which are
+// set using the ResourceData directly), or whose type is known not to match, can be excluded by specifying their
+// path (e.g. `site_config.linux_fx_version`) in `ignoredProperties`.
+func ValidateModelObjectAgainstSchema(input interface{}, properties map[string]*pluginsdk.Schema, ignoredProperties ...string) []error {
+	if input == nil {
+		// model not used for this resource
+		return nil
+	}
+
+	objType := reflect.TypeOf(input)
+	if objType.Kind() != reflect.Ptr || objType.Elem().Kind() != reflect.Struct {
+		return []error{fmt.Errorf("need a pointer to the model object")}
+	}
+
+	ignored := make(map[string]struct{}, len(ignoredProperties))
+	for _, v := range ignoredProperties {
+		ignored[v] = struct{}{}
+	}
+
+	return validateModelObjectAgainstSchemaRecursively("", "", objType.Elem(), properties, ignored)
+}
+
+func validateModelObjectAgainstSchemaRecursively(prefix, hclPrefix string, objType reflect.Type, properties map[string]*pluginsdk.Schema, ignored map[string]struct{}) (errors []error) {
+	fieldsForSchemaKeys := make(map[string]struct{})
+
+	for i := 0; i < objType.NumField(); i++ {
+		field := objType.Field(i)
+		fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
+
+		structTags, err := parseStructTags(field.Tag)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("parsing struct tags for %q: %+v", fieldName, err))
+			continue
+		}
+		if structTags == nil {
+			errors = append(errors, fmt.Errorf("field %q is missing a struct tag for `tfschema`", fieldName))
+			continue
+		}
+		fieldsForSchemaKeys[structTags.hclPath] = struct{}{}
+		hclPath := strings.TrimPrefix(fmt.Sprintf("%s.%s", hclPrefix, structTags.hclPath), ".")
+
+		if _, isIgnored := ignored[hclPath]; isIgnored {
+			continue
+		}
+
+		property, ok := properties[structTags.hclPath]
+		if !ok {
+			if !structTags.addedInNextMajorVersion && !structTags.removedInNextMajorVersion {
+				errors = append(errors, fmt.Errorf("field %q has the `tfschema` tag %q which doesn't exist in the schema", fieldName, structTags.hclPath))
+			}
+			continue
+		}
+
+		errors = append(errors, validateModelFieldType(fieldName, hclPath, field.Type, property, ignored)...)
+		errors = append(errors, validateModelFieldWriteOnly(prefix, fieldName, structTags, property, properties)...)
+	}
+
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if _, ok := fieldsForSchemaKeys[key]; !ok {
+			if _, isIgnored := ignored[strings.TrimPrefix(fmt.Sprintf("%s.%s", hclPrefix, key), ".")]; isIgnored {
+				continue
+			}
+			errors = append(errors, fmt.Errorf("the schema property %q has no corresponding `tfschema` tag in the model %q", strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, key), "."), objType.Name()))
+		}
+	}
+
+	return errors
+}
+
+// validateModelFieldWriteOnly validates that the `writeOnly` struct tag is used for (only) the write-only
+// properties in the schema, and that each of these is paired with a `{hclPath}_version` property
+func validateModelFieldWriteOnly(prefix, fieldName string, structTags *decodedStructTags, property *pluginsdk.Schema, properties map[string]*pluginsdk.Schema) []error {
+	if !structTags.writeOnly {
+		if property.WriteOnly {
+			return []error{fmt.Errorf("field %q is missing the `writeOnly` struct tag but the schema property %q is write-only", fieldName, structTags.hclPath)}
+		}
+		return nil
+	}
+
+	if prefix != "" {
+		return []error{fmt.Errorf("field %q has the `writeOnly` struct tag but write-only fields are only supported at the top-level of the model", fieldName)}
+	}
+	if !property.WriteOnly {
+		return []error{fmt.Errorf("field %q has the `writeOnly` struct tag but the schema property %q isn't write-only", fieldName, structTags.hclPath)}
+	}
+	if _, ok := properties[structTags.hclPath+writeOnlyVersionSuffix]; !ok {
+		return []error{fmt.Errorf("field %q has the `writeOnly` struct tag but the schema property %q has no corresponding %q property", fieldName, structTags.hclPath, structTags.hclPath+writeOnlyVersionSuffix)}
+	}
+
+	return nil
+}
+
+// validateModelFieldType validates that the Go type of the field is compatible with the type of the schema property
+func validateModelFieldType(fieldName, hclPath string, fieldType reflect.Type, property *pluginsdk.Schema, ignored map[string]struct{}) []error {
+	// optional values can be represented using a pointer
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	switch property.Type {
+	case pluginsdk.TypeBool, pluginsdk.TypeFloat, pluginsdk.TypeInt, pluginsdk.TypeString:
+		if expected := goKindForValueType(property.Type); fieldType.Kind() != expected {
+			return []error{fmt.Errorf("field %q is of type `%s` but the schema property is a %s, which requires a `%s`", fieldName, fieldType, property.Type, expected)}
+		}
+
+	case pluginsdk.TypeMap:
+		if fieldType.Kind() != reflect.Map || fieldType.Key().Kind() != reflect.String {
+			return []error{fmt.Errorf("field %q is of type `%s` but the schema property is a %s, which requires a `map[string]..`", fieldName, fieldType, property.Type)}
+		}
+
+		// the elements of a map default to strings when not specified
+		elemType := pluginsdk.TypeString
+		if elem, ok := property.Elem.(*pluginsdk.Schema); ok {
+			elemType = elem.Type
+		}
+		// the Decode function also supports `map[string]interface{}`, since the values are assigned as-is
+		if expected := goKindForValueType(elemType); fieldType.Elem().Kind() != expected && fieldType.Elem().Kind() != reflect.Interface {
+			return []error{fmt.Errorf("field %q is of type `%s` but the schema property is a %s of %s, which requires a `map[string]%s`", fieldName, fieldType, property.Type, elemType, expected)}
+		}
+
+	case pluginsdk.TypeList, pluginsdk.TypeSet:
+		if fieldType.Kind() != reflect.Slice {
+			return []error{fmt.Errorf("field %q is of type `%s` but the schema property is a %s, which requires a slice", fieldName, fieldType, property.Type)}
+		}
+
+		switch elem := property.Elem.(type) {
+		case *pluginsdk.Resource:
+			if fieldType.Elem().Kind() != reflect.Struct {
+				return []error{fmt.Errorf("field %q is of type `%s` but the schema property is a %s of blocks, which requires a slice of structs", fieldName, fieldType, property.Type)}
+			}
+			return validateModelObjectAgainstSchemaRecursively(fieldName, hclPath, fieldType.Elem(), elem.Schema, ignored)
+
+		case *pluginsdk.Schema:
+			if expected := goKindForValueType(elem.Type); fieldType.Elem().Kind() != expected {
+				return []error{fmt.Errorf("field %q is of type `%s` but the schema property is a %s of %s, which requires a `[]%s`", fieldName, fieldType, property.Type, elem.Type, expected)}
+			}
+		}
+	}
+
+	return nil
+}
+
+// goKindForValueType returns the Go Kind which the Encode and Decode functions use for the primitive ValueType
+func goKindForValueType(input schema.ValueType) reflect.Kind {
+	switch input {
+	case pluginsdk.TypeBool:
+		return reflect.Bool
+	case pluginsdk.TypeFloat:
+		return reflect.Float64
+	case pluginsdk.TypeInt:
+		return reflect.Int64
+	case pluginsdk.TypeString:
+		return reflect.String
+	}
+	return reflect.Invalid
+}
@@ -3,7 +3,12 @@
 
 package sdk
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
 
 func TestValidateTopLevelObjectValid(t *testing.T) {
 	type Person struct {
@@ -69,3 +74,197 @@ func TestValidateNestedObjectInvalid(t *testing.T) {
 		t.Fatalf("expected an error but didn't get one")
 	}
 }
+
+func TestValidateModelObjectAgainstSchema(t *testing.T) {
+	type Pet struct {
+		Name string   `tfschema:"name"`
+		Age  *int64   `tfschema:"age"`
+		Tags []string `tfschema:"tags"`
+	}
+	type Person struct {
+		Name     string                 `tfschema:"name"`
+		Height   float64                `tfschema:"height"`
+		Enabled  bool                   `tfschema:"enabled"`
+		Labels   map[string]string      `tfschema:"labels"`
+		Scores   map[string]int64       `tfschema:"scores"`
+		Tags     map[string]interface{} `tfschema:"tags"`
+		Pets     []Pet                  `tfschema:"pet"`
+		Legacy   string                 `tfschema:"legacy,removedInNextMajorVersion"`
+		Upcoming string                 `tfschema:"upcoming,addedInNextMajorVersion"`
+	}
+
+	schema := map[string]*pluginsdk.Schema{
+		"name":    {Type: pluginsdk.TypeString},
+		"height":  {Type: pluginsdk.TypeFloat},
+		"enabled": {Type: pluginsdk.TypeBool},
+		"labels":  {Type: pluginsdk.TypeMap, Elem: &pluginsdk.Schema{Type: pluginsdk.TypeString}},
+		"scores":  {Type: pluginsdk.TypeMap, Elem: &pluginsdk.Schema{Type: pluginsdk.TypeInt}},
+		"tags":    {Type: pluginsdk.TypeMap, Elem: &pluginsdk.Schema{Type: pluginsdk.TypeString}},
+		"pet": {
+			Type: pluginsdk.TypeList,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {Type: pluginsdk.TypeString},
+					"age":  {Type: pluginsdk.TypeInt},
+					"tags": {Type: pluginsdk.TypeSet, Elem: &pluginsdk.Schema{Type: pluginsdk.TypeString}},
+				},
+			},
+		},
+		"legacy": {Type: pluginsdk.TypeString},
+	}
+
+	if errs := ValidateModelObjectAgainstSchema(&Person{}, schema); len(errs) > 0 {
+		t.Fatalf("expected no errors but got: %+v", errs)
+	}
+}
+
+func TestValidateModelObjectAgainstSchemaInvalid(t *testing.T) {
+	type Pet struct {
+		Name string `tfschema:"name"`
+		Age  int    `tfschema:"age"`
+	}
+	type Person struct {
+		Name     string         `tfschema:"name"`
+		Nickname string         `tfschema:"nickname"`
+		Enabled  string         `tfschema:"enabled"`
+		Labels   map[string]int `tfschema:"labels"`
+		Pets     []Pet          `tfschema:"pet"`
+		Zones    string         `tfschema:"zones"`
+	}
+
+	schema := map[string]*pluginsdk.Schema{
+		"name":    {Type: pluginsdk.TypeString},
+		"enabled": {Type: pluginsdk.TypeBool},
+		"labels":  {Type: pluginsdk.TypeMap},
+		"pet": {
+			Type: pluginsdk.TypeList,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name":    {Type: pluginsdk.TypeString},
+					"age":     {Type: pluginsdk.TypeInt},
+					"species": {Type: pluginsdk.TypeString},
+				},
+			},
+		},
+		"zones":    {Type: pluginsdk.TypeList, Elem: &pluginsdk.Schema{Type: pluginsdk.TypeString}},
+		"location": {Type: pluginsdk.TypeString},
+	}
+
+	expected := []string{
+		`field "Nickname" has the ` + "`tfschema`" + ` tag "nickname" which doesn't exist in the schema`,
+		`field "Enabled" is of type ` + "`string`" + ` but the schema property is a TypeBool`,
+		`field "Labels" is of type ` + "`map[string]int`" + ` but the schema property is a TypeMap of TypeString`,
+		`field "Pets.Age" is of type ` + "`int`" + ` but the schema property is a TypeInt`,
+		`the schema property "Pets.species" has no corresponding`,
+		`field "Zones" is of type ` + "`string`" + ` but the schema property is a TypeList`,
+		`the schema property "location" has no corresponding`,
+	}
+
+	errs := ValidateModelObjectAgainstSchema(&Person{}, schema)
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors but got %d: %+v", len(expected), len(errs), errs)
+	}
+	for i, v := range expected {
+		if !strings.Contains(errs[i].Error(), v) {
+			t.Fatalf("expected error %d to contain %q but got %q", i, v, errs[i].Error())
+		}
+	}
+}
+
+func TestValidateModelObjectAgainstSchemaIgnoredProperties(t *testing.T) {
+	type Pet struct {
+		Name string `tfschema:"name"`
+	}
+	type Person struct {
+		Name     string `tfschema:"name"`
+		Nickname string `tfschema:"nickname"`
+		Pets     []Pet  `tfschema:"pet"`
+	}
+
+	schema := map[string]*pluginsdk.Schema{
+		"name":     {Type: pluginsdk.TypeString},
+		"identity": {Type: pluginsdk.TypeList, Elem: &pluginsdk.Resource{}},
+		"pet": {
+			Type: pluginsdk.TypeList,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name":    {Type: pluginsdk.TypeString},
+					"species": {Type: pluginsdk.TypeString},
+				},
+			},
+		},
+	}
+
+	if errs := ValidateModelObjectAgainstSchema(&Person{}, schema, "identity", "nickname", "pet.species"); len(errs) > 0 {
+		t.Fatalf("expected no errors but got: %+v", errs)
+	}
+
+	// ignoring a nested property must not ignore a top-level property with the same name
+	errs := ValidateModelObjectAgainstSchema(&Person{}, schema, "identity", "pet.nickname", "species")
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors but got %d: %+v", len(errs), errs)
+	}
+}
+
+func TestValidateModelObjectAgainstSchemaWriteOnly(t *testing.T) {
+	type Person struct {
+		Name            string `tfschema:"name"`
+		Password        string `tfschema:"password_wo,writeOnly"`
+		PasswordVersion int64  `tfschema:"password_wo_version"`
+	}
+
+	schema := map[string]*pluginsdk.Schema{
+		"name":                {Type: pluginsdk.TypeString},
+		"password_wo":         {Type: pluginsdk.TypeString, WriteOnly: true},
+		"password_wo_version": {Type: pluginsdk.TypeInt},
+	}
+
+	if errs := ValidateModelObjectAgainstSchema(&Person{}, schema); len(errs) > 0 {
+		t.Fatalf("expected no errors but got: %+v", errs)
+	}
+}
+
+func TestValidateModelObjectAgainstSchemaWriteOnlyInvalid(t *testing.T) {
+	type Pet struct {
+		Secret string `tfschema:"secret_wo,writeOnly"`
+	}
+	type Person struct {
+		Password      string `tfschema:"password_wo"`
+		Secret        string `tfschema:"secret_wo,writeOnly"`
+		SecretVersion int64  `tfschema:"secret_wo_version"`
+		Token         string `tfschema:"token_wo,writeOnly"`
+		Pets          []Pet  `tfschema:"pet"`
+	}
+
+	schema := map[string]*pluginsdk.Schema{
+		"password_wo":       {Type: pluginsdk.TypeString, WriteOnly: true},
+		"secret_wo":         {Type: pluginsdk.TypeString},
+		"secret_wo_version": {Type: pluginsdk.TypeInt},
+		"token_wo":          {Type: pluginsdk.TypeString, WriteOnly: true},
+		"pet": {
+			Type: pluginsdk.TypeList,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"secret_wo": {Type: pluginsdk.TypeString, WriteOnly: true},
+				},
+			},
+		},
+	}
+
+	expected := []string{
+		`field "Password" is missing the ` + "`writeOnly`" + ` struct tag`,
+		`field "Secret" has the ` + "`writeOnly`" + ` struct tag but the schema property "secret_wo" isn't write-only`,
+		`field "Token" has the ` + "`writeOnly`" + ` struct tag but the schema property "token_wo" has no corresponding "token_wo_version" property`,
+		`field "Pets.Secret" has the ` + "`writeOnly`" + ` struct tag but write-only fields are only supported at the top-level`,
+	}
+
+	errs := ValidateModelObjectAgainstSchema(&Person{}, schema)
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors but got %d: %+v", len(expected), len(errs), errs)
+	}
+	for i, v := range expected {
+		if !strings.Contains(errs[i].Error(), v) {
+			t.Fatalf("expected error %d to contain %q but got %q", i, v, errs[i].Error())
+		}
+	}
+}
@@ -31,6 +31,12 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.AzureActiveDirectory",
+	}
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccApiManagementApiOperationTag_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api_operation_tag", "test")
+	r := ApiManagementApiOperationTagResource{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.12.0-rc2"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data),
+				ConfigStateChecks: []statecheck.StateCheck{
+					customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_api_operation_tag.test", tfjsonpath.New("api_id"), tfjsonpath.New("api_operation_id")),
+					customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_api_operation_tag.test", tfjsonpath.New("operation_id"), tfjsonpath.New("api_operation_id")),
+					customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_api_operation_tag.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("api_operation_id")),
+					customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_api_operation_tag.test", tfjsonpath.New("service_name"), tfjsonpath.New("api_operation_id")),
+					customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_api_operation_tag.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("api_operation_id")),
+					customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_api_operation_tag.test", tfjsonpath.New("tag_id"), tfjsonpath.New("api_operation_id")),
+				},
+			},
+			data.ImportBlockWithResourceIdentityStep(),
+			data.ImportBlockWithIDStep(),
+		},
+	})
+}
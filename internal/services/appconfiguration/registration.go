@@ -45,6 +45,12 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.AppConfiguration",
+	}
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
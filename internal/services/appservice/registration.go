@@ -19,6 +19,12 @@ func (r Registration) WebsiteCategories() []string {
 	return nil
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Web",
+	}
+}
+
 func (r Registration) Name() string {
 	return "AppService"
 }
@@ -17,6 +17,12 @@ import (
 
 type AppServiceSourceControlTokenDataSource struct{}
 
+type AppServiceSourceControlTokenDataSourceModel struct {
+	Token       string `tfschema:"token"`
+	TokenSecret string `tfschema:"token_secret"`
+	Type        string `tfschema:"type"`
+}
+
 var _ sdk.DataSource = AppServiceSourceControlTokenDataSource{}
 
 func (d AppServiceSourceControlTokenDataSource) Arguments() map[string]*pluginsdk.Schema {
@@ -51,7 +57,7 @@ func (d AppServiceSourceControlTokenDataSource) Attributes() map[string]*plugins
 }
 
 func (d AppServiceSourceControlTokenDataSource) ModelObject() interface{} {
-	return &AppServiceSourceControlTokenModel{}
+	return &AppServiceSourceControlTokenDataSourceModel{}
 }
 
 func (d AppServiceSourceControlTokenDataSource) ResourceType() string {
@@ -64,7 +70,7 @@ func (d AppServiceSourceControlTokenDataSource) Read() sdk.ResourceFunc {
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.AppService.ResourceProvidersClient
 
-			var sourceControlToken AppServiceSourceControlTokenModel
+			var sourceControlToken AppServiceSourceControlTokenDataSourceModel
 			if err := metadata.Decode(&sourceControlToken); err != nil {
 				return fmt.Errorf("decoding %+v", err)
 			}
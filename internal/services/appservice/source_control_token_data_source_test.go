@@ -8,8 +8,11 @@ import (
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice"
 )
 
 type AppServiceGithubTokenDataSource struct{}
@@ -33,6 +36,36 @@ func TestAccSourceControlGitHubTokenDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestSourceControlTokenDataSource_encode(t *testing.T) {
+	wrapper := sdk.NewDataSourceWrapper(appservice.AppServiceSourceControlTokenDataSource{})
+	dataSource, err := wrapper.DataSource()
+	if err != nil {
+		t.Fatalf("building Data Source: %+v", err)
+	}
+
+	metadata := sdk.ResourceMetaData{
+		Logger:       sdk.NullLogger{},
+		ResourceData: schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"type": "GitHub"}),
+	}
+
+	var model appservice.AppServiceSourceControlTokenDataSourceModel
+	if err := metadata.Decode(&model); err != nil {
+		t.Fatalf("decoding: %+v", err)
+	}
+
+	model.Token = "token"
+	model.TokenSecret = "secret"
+	if err := metadata.Encode(&model); err != nil {
+		t.Fatalf("encoding: %+v", err)
+	}
+
+	for key, expected := range map[string]string{"type": "GitHub", "token": "token", "token_secret": "secret"} {
+		if actual := metadata.ResourceData.Get(key).(string); actual != expected {
+			t.Fatalf("expected %q to be %q but got %q", key, expected, actual)
+		}
+	}
+}
+
 func (AppServiceGithubTokenDataSource) basic(token string) string {
 	return fmt.Sprintf(`
 
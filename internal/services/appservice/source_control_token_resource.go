@@ -20,9 +20,13 @@ import (
 type AppServiceSourceControlTokenResource struct{}
 
 type AppServiceSourceControlTokenModel struct {
-	Token       string `tfschema:"token"`
-	TokenSecret string `tfschema:"token_secret"`
-	Type        string `tfschema:"type"`
+	Token                string `tfschema:"token"`
+	TokenWo              string `tfschema:"token_wo,writeOnly"`
+	TokenWoVersion       int64  `tfschema:"token_wo_version"`
+	TokenSecret          string `tfschema:"token_secret"`
+	TokenSecretWo        string `tfschema:"token_secret_wo,writeOnly"`
+	TokenSecretWoVersion int64  `tfschema:"token_secret_wo_version"`
+	Type                 string `tfschema:"type"`
 }
 
 var (
@@ -44,17 +48,49 @@ func (r AppServiceSourceControlTokenResource) Arguments() map[string]*pluginsdk.
 		},
 
 		"token": {
-			Type:         pluginsdk.TypeString,
-			Required:     true,
-			Sensitive:    true,
-			ValidateFunc: validation.StringIsNotEmpty,
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"token_wo"},
+			ExactlyOneOf:  []string{"token", "token_wo"},
+		},
+
+		"token_wo": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			WriteOnly:     true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"token"},
+			ExactlyOneOf:  []string{"token_wo", "token"},
+		},
+
+		"token_wo_version": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
 		},
 
 		"token_secret": {
-			Type:         pluginsdk.TypeString,
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"token_secret_wo"},
+		},
+
+		"token_secret_wo": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			WriteOnly:     true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"token_secret"},
+		},
+
+		"token_secret_wo_version": {
+			Type:         pluginsdk.TypeInt,
 			Optional:     true,
-			Sensitive:    true,
-			ValidateFunc: validation.StringIsNotEmpty,
+			ValidateFunc: validation.IntAtLeast(1),
 		},
 	}
 }
@@ -96,10 +132,19 @@ func (r AppServiceSourceControlTokenResource) Create() sdk.ResourceFunc {
 				return metadata.ResourceRequiresImport(r.ResourceType(), id)
 			}
 
+			token := sourceControlToken.Token
+			if sourceControlToken.TokenWo != "" {
+				token = sourceControlToken.TokenWo
+			}
+			tokenSecret := sourceControlToken.TokenSecret
+			if sourceControlToken.TokenSecretWo != "" {
+				tokenSecret = sourceControlToken.TokenSecretWo
+			}
+
 			sourceControlOAuth := resourceproviders.SourceControl{
 				Properties: &resourceproviders.SourceControlProperties{
-					Token:       pointer.To(sourceControlToken.Token),
-					TokenSecret: pointer.To(sourceControlToken.TokenSecret),
+					Token:       pointer.To(token),
+					TokenSecret: pointer.To(tokenSecret),
 				},
 			}
 
@@ -133,14 +178,21 @@ func (r AppServiceSourceControlTokenResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("reading %s: %+v", *id, err)
 			}
 
-			state := AppServiceSourceControlTokenModel{}
-
-			state.Type = id.SourceControlName
+			state := AppServiceSourceControlTokenModel{
+				Type: id.SourceControlName,
+				// the write-only values aren't persisted into the state, so only the versions are retained
+				TokenWoVersion:       int64(metadata.ResourceData.Get("token_wo_version").(int)),
+				TokenSecretWoVersion: int64(metadata.ResourceData.Get("token_secret_wo_version").(int)),
+			}
 
 			if model := resp.Model; model != nil {
 				if props := model.Properties; props != nil {
-					state.Token = pointer.From(props.Token)
-					state.TokenSecret = pointer.From(props.TokenSecret)
+					if state.TokenWoVersion == 0 {
+						state.Token = pointer.From(props.Token)
+					}
+					if state.TokenSecretWoVersion == 0 {
+						state.TokenSecret = pointer.From(props.TokenSecret)
+					}
 				}
 			}
 
@@ -197,13 +249,36 @@ func (r AppServiceSourceControlTokenResource) Update() sdk.ResourceFunc {
 
 			client := metadata.Client.AppService.ResourceProvidersClient
 
+			existing, err := client.GetSourceControl(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `model.Properties` was nil", *id)
+			}
+			props := existing.Model.Properties
+
 			sourceControlOAuth := resourceproviders.SourceControl{
 				Properties: &resourceproviders.SourceControlProperties{
-					Token:       pointer.To(sourceControlToken.Token),
-					TokenSecret: pointer.To(sourceControlToken.TokenSecret),
+					Token:       props.Token,
+					TokenSecret: props.TokenSecret,
 				},
 			}
 
+			if metadata.ResourceData.HasChange("token") {
+				sourceControlOAuth.Properties.Token = pointer.To(sourceControlToken.Token)
+			}
+			if metadata.HasWriteOnlyChange("token_wo") && sourceControlToken.TokenWo != "" {
+				sourceControlOAuth.Properties.Token = pointer.To(sourceControlToken.TokenWo)
+			}
+
+			if metadata.ResourceData.HasChange("token_secret") {
+				sourceControlOAuth.Properties.TokenSecret = pointer.To(sourceControlToken.TokenSecret)
+			}
+			if metadata.HasWriteOnlyChange("token_secret_wo") && sourceControlToken.TokenSecretWo != "" {
+				sourceControlOAuth.Properties.TokenSecret = pointer.To(sourceControlToken.TokenSecretWo)
+			}
+
 			if _, err := client.UpdateSourceControl(ctx, *id, sourceControlOAuth); err != nil {
 				return fmt.Errorf("deleting %s: %+v", id, err)
 			}
@@ -12,9 +12,13 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -66,6 +70,72 @@ func TestAccSourceControlGitHubToken_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccSourceControlGitHubToken_writeOnlyToken(t *testing.T) {
+	token := os.Getenv("ARM_GITHUB_ACCESS_TOKEN")
+	if token == "" {
+		t.Skip("Skipping as `ARM_GITHUB_ACCESS_TOKEN` is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_source_control_token", "test")
+	r := AppServiceGitHubTokenResource{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.writeOnlyToken(token, 1),
+				Check: acceptance.ComposeTestCheckFunc(
+					check.That(data.ResourceName).ExistsInAzure(r),
+					check.That(data.ResourceName).Key("token").IsEmpty(),
+				),
+			},
+			data.ImportStep("token", "token_wo_version"),
+			{
+				Config: r.writeOnlyToken(token, 2),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("token", "token_wo_version"),
+		},
+	})
+}
+
+func TestAccSourceControlGitHubToken_updateToWriteOnlyToken(t *testing.T) {
+	token := os.Getenv("ARM_GITHUB_ACCESS_TOKEN")
+	if token == "" {
+		t.Skip("Skipping as `ARM_GITHUB_ACCESS_TOKEN` is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_source_control_token", "test")
+	r := AppServiceGitHubTokenResource{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(token),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep(),
+			{
+				Config: r.writeOnlyToken(token, 1),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("token", "token_wo_version"),
+			{
+				Config: r.basic(token),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
 func (r AppServiceGitHubTokenResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	resp, err := client.AppService.ResourceProvidersClient.GetSourceControl(ctx, resourceproviders.NewSourceControlID("GitHub"))
 	if err != nil || resp.Model == nil {
@@ -103,3 +173,17 @@ resource azurerm_source_control_token import {
 }
 `, r.basic(token))
 }
+
+func (r AppServiceGitHubTokenResource) writeOnlyToken(token string, version int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource azurerm_source_control_token test {
+  type             = "GitHub"
+  token_wo         = "%s"
+  token_wo_version = %d
+}
+`, token, version)
+}
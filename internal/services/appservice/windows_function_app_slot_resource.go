@@ -904,9 +904,13 @@ func (r WindowsFunctionAppSlotResource) Update() sdk.ResourceFunc {
 				if err != nil {
 					return err
 				}
-				locks.ByID(oldPlan.ID())
+				if err := locks.ByIDWithContext(ctx, oldPlan.ID()); err != nil {
+					return err
+				}
 				defer locks.UnlockByID(oldPlan.ID())
-				locks.ByID(newPlan.ID())
+				if err := locks.ByIDWithContext(ctx, newPlan.ID()); err != nil {
+					return err
+				}
 				defer locks.UnlockByID(newPlan.ID())
 				if model.Properties == nil {
 					return fmt.Errorf("updating Service Plan for Windows %s: Slot SiteProperties was nil", *id)
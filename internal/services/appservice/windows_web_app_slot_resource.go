@@ -787,9 +787,13 @@ func (r WindowsWebAppSlotResource) Update() sdk.ResourceFunc {
 				if strings.EqualFold(newPlan.ID(), parentServicePlanId.ID()) {
 					return fmt.Errorf("`service_plan_id` should only be specified when it differs from the `service_plan_id` of the associated Web App")
 				}
-				locks.ByID(oldPlan.ID())
+				if err := locks.ByIDWithContext(ctx, oldPlan.ID()); err != nil {
+					return err
+				}
 				defer locks.UnlockByID(oldPlan.ID())
-				locks.ByID(newPlan.ID())
+				if err := locks.ByIDWithContext(ctx, newPlan.ID()); err != nil {
+					return err
+				}
 				defer locks.UnlockByID(newPlan.ID())
 				if model.Properties == nil {
 					return fmt.Errorf("updating Service Plan for Windows %s: Slot SiteProperties was nil", *id)
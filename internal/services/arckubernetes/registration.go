@@ -27,6 +27,19 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Kubernetes",
+	}
+}
+
+func (r Registration) ResourceProvidersByResource() map[string][]string {
+	return map[string][]string{
+		"azurerm_arc_kubernetes_cluster_extension":  {"Microsoft.KubernetesConfiguration"},
+		"azurerm_arc_kubernetes_flux_configuration": {"Microsoft.KubernetesConfiguration"},
+	}
+}
+
 func (r Registration) AssociatedGitHubLabel() string {
 	return "service/arc-kubernetes"
 }
@@ -36,3 +36,9 @@ func (r Registration) WebsiteCategories() []string {
 		"Arc Resource Bridge",
 	}
 }
+
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.ResourceConnector",
+	}
+}
@@ -41,6 +41,18 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.BotService",
+	}
+}
+
+func (r Registration) ResourceProvidersByResource() map[string][]string {
+	return map[string][]string{
+		"azurerm_healthbot": {"Microsoft.HealthBot"},
+	}
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{}
@@ -342,10 +342,15 @@ func getRouteProperties(d *pluginsdk.ResourceData, meta interface{}, id *parse.F
 }
 
 func removeCustomDomainAssociationFromRoutes(d *pluginsdk.ResourceData, meta interface{}, routes *[]parse.FrontDoorRouteId, customDomainID *parse.FrontDoorCustomDomainId) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
 	if len(*routes) != 0 && routes != nil {
 		for _, route := range *routes {
 			// lock the route resource for update...
-			locks.ByName(route.RouteName, cdnFrontDoorRouteResourceName)
+			if err := locks.ByNameWithContext(ctx, route.RouteName, cdnFrontDoorRouteResourceName); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(route.RouteName, cdnFrontDoorRouteResourceName)
 
 			// Check to see if the route still exists and grab its properties...
@@ -21,6 +21,12 @@ func (r Registration) WebsiteCategories() []string {
 	return r.autoRegistration.WebsiteCategories()
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Chaos",
+	}
+}
+
 // DataSources returns a list of Data Sources supported by this Service
 func (r Registration) DataSources() []sdk.DataSource {
 	dataSources := []sdk.DataSource{}
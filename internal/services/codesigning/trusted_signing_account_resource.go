@@ -136,6 +136,9 @@ func (m TrustedSigningAccountResource) Read() sdk.ResourceFunc {
 
 			result, err := client.Get(ctx, *id)
 			if err != nil {
+				if response.WasNotFound(result.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
 				return fmt.Errorf("retrieving %s: %+v", id, err)
 			}
 
@@ -209,7 +212,7 @@ func (m TrustedSigningAccountResource) Delete() sdk.ResourceFunc {
 
 			meta.Logger.Infof("deleting %s", id)
 
-			if _, err = client.Delete(ctx, *id); err != nil {
+			if err = client.DeleteThenPoll(ctx, *id); err != nil {
 				return fmt.Errorf("deleting %s: %v", id, err)
 			}
 			return nil
@@ -339,7 +339,9 @@ func (AIServices) Create() sdk.ResourceFunc {
 				}
 			}
 
-			locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+			if err := locks.MultipleByNameWithContext(ctx, &virtualNetworkNames, network.VirtualNetworkResourceName); err != nil {
+				return err
+			}
 			defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
 
 			props := cognitiveservicesaccounts.Account{
@@ -497,7 +499,9 @@ func (AIServices) Update() sdk.ResourceFunc {
 			props := resp.Model
 			if metadata.ResourceData.HasChange("network_acls") {
 				networkACLs, subnetIds := expandNetworkACLs(model.NetworkACLs)
-				locks.MultipleByName(&subnetIds, network.VirtualNetworkResourceName)
+				if err := locks.MultipleByNameWithContext(ctx, &subnetIds, network.VirtualNetworkResourceName); err != nil {
+					return err
+				}
 				defer locks.UnlockMultipleByName(&subnetIds, network.VirtualNetworkResourceName)
 
 				// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
@@ -512,7 +516,9 @@ func (AIServices) Update() sdk.ResourceFunc {
 					}
 				}
 
-				locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+				if err := locks.MultipleByNameWithContext(ctx, &virtualNetworkNames, network.VirtualNetworkResourceName); err != nil {
+					return err
+				}
 				defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
 
 				props.Properties.NetworkAcls = networkACLs
@@ -71,7 +71,9 @@ func (c CognitiveRaiBlocklistResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(accountId.ID())
+			if err := locks.ByIDWithContext(ctx, accountId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(accountId.ID())
 
 			id := raiblocklists.NewRaiBlocklistID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.AccountName, model.Name)
@@ -118,7 +120,9 @@ func (c CognitiveRaiBlocklistResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(accountId.ID())
+			if err := locks.ByIDWithContext(ctx, accountId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(accountId.ID())
 
 			id, err := raiblocklists.ParseRaiBlocklistID(metadata.ResourceData.Id())
@@ -166,7 +170,9 @@ func (c CognitiveRaiBlocklistResource) Delete() sdk.ResourceFunc {
 			}
 			accountId := raiblocklists.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName)
 
-			locks.ByID(accountId.ID())
+			if err := locks.ByIDWithContext(ctx, accountId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(accountId.ID())
 
 			if err := client.DeleteThenPoll(ctx, *id); err != nil {
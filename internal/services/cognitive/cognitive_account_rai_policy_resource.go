@@ -150,7 +150,9 @@ func (r CognitiveAccountRaiPolicyResource) Create() sdk.ResourceFunc {
 				return metadata.ResourceRequiresImport(r.ResourceType(), id)
 			}
 
-			locks.ByID(cognitiveAccountId.ID())
+			if err := locks.ByIDWithContext(ctx, cognitiveAccountId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(cognitiveAccountId.ID())
 
 			raiPolicy := raipolicies.RaiPolicy{
@@ -249,7 +251,9 @@ func (r CognitiveAccountRaiPolicyResource) Update() sdk.ResourceFunc {
 
 			cognitiveAccountId := raipolicies.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName)
 
-			locks.ByID(cognitiveAccountId.ID())
+			if err := locks.ByIDWithContext(ctx, cognitiveAccountId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(cognitiveAccountId.ID())
 
 			payload := existing.Model
@@ -288,7 +292,9 @@ func (r CognitiveAccountRaiPolicyResource) Delete() sdk.ResourceFunc {
 
 			cognitiveAccountId := raipolicies.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName)
 
-			locks.ByID(cognitiveAccountId.ID())
+			if err := locks.ByIDWithContext(ctx, cognitiveAccountId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(cognitiveAccountId.ID())
 
 			if err := client.DeleteThenPoll(ctx, *id); err != nil {
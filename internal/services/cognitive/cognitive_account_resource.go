@@ -370,7 +370,9 @@ func resourceCognitiveAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, &virtualNetworkNames, network.VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
 
 	publicNetworkAccess := cognitiveservicesaccounts.PublicNetworkAccessEnabled
@@ -459,7 +461,9 @@ func resourceCognitiveAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, &virtualNetworkNames, network.VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
 
 	publicNetworkAccess := cognitiveservicesaccounts.PublicNetworkAccessEnabled
@@ -207,7 +207,9 @@ func (r CognitiveDeploymentResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(accountId.ID())
+			if err := locks.ByIDWithContext(ctx, accountId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(accountId.ID())
 
 			id := deployments.NewDeploymentID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.AccountName, model.Name)
@@ -266,7 +268,9 @@ func (r CognitiveDeploymentResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(accountId.ID())
+			if err := locks.ByIDWithContext(ctx, accountId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(accountId.ID())
 
 			id, err := deployments.ParseDeploymentID(metadata.ResourceData.Id())
@@ -365,7 +369,9 @@ func (r CognitiveDeploymentResource) Delete() sdk.ResourceFunc {
 			}
 			accountId := cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName)
 
-			locks.ByID(accountId.ID())
+			if err := locks.ByIDWithContext(ctx, accountId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(accountId.ID())
 
 			if err := client.DeleteThenPoll(ctx, *id); err != nil {
@@ -81,10 +81,14 @@ func (r EmailDomainAssociationResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByName(communicationServiceId.CommunicationServiceName, "azurerm_communication_service")
+			if err := locks.ByNameWithContext(ctx, communicationServiceId.CommunicationServiceName, "azurerm_communication_service"); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(communicationServiceId.CommunicationServiceName, "azurerm_communication_service")
 
-			locks.ByName(eMailServiceDomainId.DomainName, "azurerm_email_communication_service_domain")
+			if err := locks.ByNameWithContext(ctx, eMailServiceDomainId.DomainName, "azurerm_email_communication_service_domain"); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(eMailServiceDomainId.DomainName, "azurerm_email_communication_service_domain")
 
 			existingEMailServiceDomain, err := domainClient.Get(ctx, *eMailServiceDomainId)
@@ -176,10 +180,14 @@ func (EmailDomainAssociationResource) Read() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByName(communicationServiceId.CommunicationServiceName, "azurerm_communication_service")
+			if err := locks.ByNameWithContext(ctx, communicationServiceId.CommunicationServiceName, "azurerm_communication_service"); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(communicationServiceId.CommunicationServiceName, "azurerm_communication_service")
 
-			locks.ByName(eMailServiceDomainId.DomainName, "azurerm_email_communication_service_domain")
+			if err := locks.ByNameWithContext(ctx, eMailServiceDomainId.DomainName, "azurerm_email_communication_service_domain"); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(eMailServiceDomainId.DomainName, "azurerm_email_communication_service_domain")
 
 			existingEMailServiceDomain, err := domainClient.Get(ctx, *eMailServiceDomainId)
@@ -258,10 +266,14 @@ func (EmailDomainAssociationResource) Delete() sdk.ResourceFunc {
 			communicationServiceId := id.First
 			eMailServiceDomainId := id.Second
 
-			locks.ByName(communicationServiceId.CommunicationServiceName, "azurerm_communication_service")
+			if err := locks.ByNameWithContext(ctx, communicationServiceId.CommunicationServiceName, "azurerm_communication_service"); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(communicationServiceId.CommunicationServiceName, "azurerm_communication_service")
 
-			locks.ByName(eMailServiceDomainId.DomainName, "azurerm_email_communication_service_domain")
+			if err := locks.ByNameWithContext(ctx, eMailServiceDomainId.DomainName, "azurerm_email_communication_service_domain"); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(eMailServiceDomainId.DomainName, "azurerm_email_communication_service_domain")
 
 			existingEMailServiceDomain, err := domainClient.Get(ctx, *eMailServiceDomainId)
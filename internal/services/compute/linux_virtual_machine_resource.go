@@ -439,7 +439,9 @@ func resourceLinuxVirtualMachineCreate(d *pluginsdk.ResourceData, meta interface
 
 	id := virtualmachines.NewVirtualMachineID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	locks.ByName(id.VirtualMachineName, VirtualMachineResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VirtualMachineName, VirtualMachineResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualMachineName, VirtualMachineResourceName)
 
 	resp, err := client.Get(ctx, id, virtualmachines.DefaultGetOperationOptions())
@@ -1061,7 +1063,9 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 		return err
 	}
 
-	locks.ByName(id.VirtualMachineName, VirtualMachineResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VirtualMachineName, VirtualMachineResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualMachineName, VirtualMachineResourceName)
 
 	log.Printf("[DEBUG] Retrieving Linux %s", id)
@@ -1672,7 +1676,9 @@ func resourceLinuxVirtualMachineDelete(d *pluginsdk.ResourceData, meta interface
 		return err
 	}
 
-	locks.ByName(id.VirtualMachineName, VirtualMachineResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VirtualMachineName, VirtualMachineResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualMachineName, VirtualMachineResourceName)
 
 	log.Printf("[DEBUG] Retrieving Linux %s", id)
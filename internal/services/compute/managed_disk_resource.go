@@ -818,7 +818,9 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 		// check instanceView State
 
-		locks.ByName(virtualMachineId.VirtualMachineName, VirtualMachineResourceName)
+		if err := locks.ByNameWithContext(ctx, virtualMachineId.VirtualMachineName, VirtualMachineResourceName); err != nil {
+			return err
+		}
 		defer locks.UnlockByName(virtualMachineId.VirtualMachineName, VirtualMachineResourceName)
 
 		err = resourceManagedDiskUpdateWithVmShutDown(ctx, meta.(*clients.Client), id, virtualMachineId, diskUpdate, shouldDetach)
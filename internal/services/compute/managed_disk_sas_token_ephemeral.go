@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/disks"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.EphemeralResourceWithClose = &ManagedDiskSasTokenEphemeralResource{}
+
+// managedDiskSasTokenPrivateKey is the key within the private data used to pass the ID of the Managed Disk to Close
+const managedDiskSasTokenPrivateKey = "managed_disk_id"
+
+func NewManagedDiskSasTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &ManagedDiskSasTokenEphemeralResource{}
+}
+
+// ManagedDiskSasTokenEphemeralResource grants access to a Managed Disk (as the `azurerm_managed_disk_sas_token`
+// Resource does) without persisting the SAS URL into the state - access to the Managed Disk is revoked once the
+// Ephemeral Resource is closed.
+type ManagedDiskSasTokenEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type ManagedDiskSasTokenEphemeralResourceModel struct {
+	ManagedDiskId     types.String `tfsdk:"managed_disk_id"`
+	DurationInSeconds types.Int64  `tfsdk:"duration_in_seconds"`
+	AccessLevel       types.String `tfsdk:"access_level"`
+	SasUrl            types.String `tfsdk:"sas_url"`
+}
+
+func (e *ManagedDiskSasTokenEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_managed_disk_sas_token"
+}
+
+func (e *ManagedDiskSasTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *ManagedDiskSasTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"managed_disk_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: commonids.ValidateManagedDiskID,
+					},
+				},
+			},
+
+			"duration_in_seconds": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					frameworkhelpers.WrappedInt64Validator{
+						Func: validation.IntAtLeast(30),
+					},
+				},
+			},
+
+			"access_level": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validation.StringInSlice([]string{
+							string(disks.AccessLevelRead),
+							string(disks.AccessLevelWrite),
+						}, false),
+					},
+				},
+			},
+
+			"sas_url": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *ManagedDiskSasTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.Compute.DisksClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*30)
+	defer cancel()
+
+	var data ManagedDiskSasTokenEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	diskId, err := commonids.ParseManagedDiskID(data.ManagedDiskId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	sasUrl, err := grantManagedDiskAccess(ctx, client, *diskId, disks.AccessLevel(data.AccessLevel.ValueString()), data.DurationInSeconds.ValueInt64())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("granting access to %s", diskId), err)
+		return
+	}
+
+	// the ID of the Managed Disk is required to revoke access once the Ephemeral Resource is closed
+	privateData, err := json.Marshal(diskId.ID())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", fmt.Errorf("marshalling the ID of %s: %+v", diskId, err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, managedDiskSasTokenPrivateKey, privateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.SasUrl = types.StringValue(sasUrl)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (e *ManagedDiskSasTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	client := e.Client.Compute.DisksClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*30)
+	defer cancel()
+
+	privateData, diags := req.Private.GetKey(ctx, managedDiskSasTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateData == nil {
+		return
+	}
+
+	var rawId string
+	if err := json.Unmarshal(privateData, &rawId); err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", fmt.Errorf("unmarshalling the ID of the Managed Disk: %+v", err))
+		return
+	}
+
+	diskId, err := commonids.ParseManagedDiskID(rawId)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	if err := client.RevokeAccessThenPoll(ctx, *diskId); err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("revoking access to %s", diskId), err)
+		return
+	}
+}
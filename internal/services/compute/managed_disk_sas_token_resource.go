@@ -4,6 +4,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -94,52 +95,61 @@ func resourceManagedDiskSasTokenCreate(d *pluginsdk.ResourceData, meta interface
 		return err
 	}
 
+	sasToken, err := grantManagedDiskAccess(ctx, client, *diskId, access, durationInSeconds)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(diskId.ID())
+	d.Set("sas_url", sasToken)
+
+	return resourceManagedDiskSasTokenRead(d, meta)
+}
+
+// grantManagedDiskAccess grants access to the Managed Disk, returning the SAS URL which can be used to access it
+func grantManagedDiskAccess(ctx context.Context, client *disks.DisksClient, diskId commonids.ManagedDiskId, access disks.AccessLevel, durationInSeconds int64) (string, error) {
 	grantAccessData := disks.GrantAccessData{
 		Access:            access,
 		DurationInSeconds: durationInSeconds,
 	}
 
-	resp, err := client.Get(ctx, *diskId)
+	resp, err := client.Get(ctx, diskId)
 	if err != nil {
-		return fmt.Errorf("error retrieving Disk %s: %+v", *diskId, err)
+		return "", fmt.Errorf("error retrieving Disk %s: %+v", diskId, err)
 	}
 
 	if resp.Model == nil {
-		return fmt.Errorf("retrieving %s: `model` was nil", *diskId)
+		return "", fmt.Errorf("retrieving %s: `model` was nil", diskId)
 	}
 	if resp.Model.Properties == nil {
-		return fmt.Errorf("retrieving %s: `model.Properties` was nil", *diskId)
+		return "", fmt.Errorf("retrieving %s: `model.Properties` was nil", diskId)
 	}
 	props := *resp.Model.Properties
 
 	// checking whether disk export SAS URL is active already before creating. If yes, we raise an error
 	if *props.DiskState == disks.DiskStateActiveSAS {
-		return fmt.Errorf("active SAS Token for Disk Export already exists, cannot create another one %s: %+v", *diskId, err)
+		return "", fmt.Errorf("active SAS Token for Disk Export already exists, cannot create another one %s: %+v", diskId, err)
 	}
 
-	future, err := client.GrantAccess(ctx, *diskId, grantAccessData)
+	future, err := client.GrantAccess(ctx, diskId, grantAccessData)
 	if err != nil {
-		return fmt.Errorf("granting access to %s: %+v", *diskId, err)
+		return "", fmt.Errorf("granting access to %s: %+v", diskId, err)
 	}
 	if err := future.Poller.PollUntilDone(ctx); err != nil {
-		return fmt.Errorf("waiting for access to be granted to %s: %+v", *diskId, err)
+		return "", fmt.Errorf("waiting for access to be granted to %s: %+v", diskId, err)
 	}
 
 	lastResponse := future.Poller.LatestResponse()
 	if lastResponse == nil {
-		return fmt.Errorf("waiting for access to be granted to %s: last response was nil", *diskId)
+		return "", fmt.Errorf("waiting for access to be granted to %s: last response was nil", diskId)
 	}
 
 	var result Result
 	if err := lastResponse.Unmarshal(&result); err != nil {
-		return fmt.Errorf("retrieving SAS Token for Disk Access %s: %+v", *diskId, err)
+		return "", fmt.Errorf("retrieving SAS Token for Disk Access %s: %+v", diskId, err)
 	}
 
-	d.SetId(diskId.ID())
-	sasToken := result.Properties.Output.AccessSAS
-	d.Set("sas_url", sasToken)
-
-	return resourceManagedDiskSasTokenRead(d, meta)
+	return result.Properties.Output.AccessSAS, nil
 }
 
 func resourceManagedDiskSasTokenRead(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -4,12 +4,17 @@
 package compute
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 type Registration struct{}
 
+var _ sdk.FrameworkTypedServiceRegistration = Registration{}
+
 // Name is the name of this Service
 func (r Registration) Name() string {
 	return "Compute"
@@ -22,6 +27,19 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Compute",
+	}
+}
+
+func (r Registration) ResourceProvidersByResource() map[string][]string {
+	return map[string][]string{
+		"azurerm_marketplace_agreement":                  {"Microsoft.MarketplaceOrdering"},
+		"azurerm_virtual_machine_scale_set_standby_pool": {"Microsoft.StandbyPool"},
+	}
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
@@ -99,3 +117,20 @@ func (r Registration) Resources() []sdk.Resource {
 		VirtualMachineScaleSetStandbyPoolResource{},
 	}
 }
+
+// FrameworkResources returns a list of Framework Resources supported by this Service
+func (r Registration) FrameworkResources() []func() resource.Resource {
+	return []func() resource.Resource{}
+}
+
+// FrameworkDataSources returns a list of Framework Data Sources supported by this Service
+func (r Registration) FrameworkDataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+// EphemeralResources returns a list of Ephemeral Resources supported by this Service
+func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewManagedDiskSasTokenEphemeralResource,
+	}
+}
@@ -103,7 +103,9 @@ func (r VirtualMachineGalleryApplicationAssignmentResource) Create() sdk.Resourc
 				return fmt.Errorf("parsing `virtual_machine_id`, %+v", err)
 			}
 
-			locks.ByID(virtualMachineID.ID())
+			if err := locks.ByIDWithContext(ctx, virtualMachineID.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(virtualMachineID.ID())
 
 			resp, err := client.Get(ctx, *virtualMachineID, virtualmachines.GetOperationOptions{Expand: pointer.To(virtualmachines.InstanceViewTypesUserData)})
@@ -224,7 +226,9 @@ func (r VirtualMachineGalleryApplicationAssignmentResource) Update() sdk.Resourc
 				return err
 			}
 
-			locks.ByID(id.VirtualMachineId.ID())
+			if err := locks.ByIDWithContext(ctx, id.VirtualMachineId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.VirtualMachineId.ID())
 
 			resp, err := client.Get(ctx, id.VirtualMachineId, virtualmachines.GetOperationOptions{Expand: pointer.To(virtualmachines.InstanceViewTypesUserData)})
@@ -281,7 +285,9 @@ func (r VirtualMachineGalleryApplicationAssignmentResource) Delete() sdk.Resourc
 				return err
 			}
 
-			locks.ByID(id.VirtualMachineId.ID())
+			if err := locks.ByIDWithContext(ctx, id.VirtualMachineId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.VirtualMachineId.ID())
 
 			resp, err := client.Get(ctx, id.VirtualMachineId, virtualmachines.GetOperationOptions{})
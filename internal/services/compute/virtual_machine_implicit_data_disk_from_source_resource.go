@@ -152,7 +152,9 @@ func (r VirtualMachineImplicitDataDiskFromSourceResource) Create() sdk.ResourceF
 				return err
 			}
 
-			locks.ByName(virtualMachineId.VirtualMachineName, VirtualMachineResourceName)
+			if err := locks.ByNameWithContext(ctx, virtualMachineId.VirtualMachineName, VirtualMachineResourceName); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(virtualMachineId.VirtualMachineName, VirtualMachineResourceName)
 
 			id := parse.NewDataDiskID(subscriptionId, virtualMachineId.ResourceGroupName, virtualMachineId.VirtualMachineName, config.Name)
@@ -295,7 +297,9 @@ func (r VirtualMachineImplicitDataDiskFromSourceResource) Delete() sdk.ResourceF
 				return err
 			}
 
-			locks.ByName(id.VirtualMachineName, VirtualMachineResourceName)
+			if err := locks.ByNameWithContext(ctx, id.VirtualMachineName, VirtualMachineResourceName); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(id.VirtualMachineName, VirtualMachineResourceName)
 
 			virtualMachineId := virtualmachines.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName)
@@ -373,7 +377,9 @@ func (r VirtualMachineImplicitDataDiskFromSourceResource) Update() sdk.ResourceF
 				return err
 			}
 
-			locks.ByName(id.VirtualMachineName, VirtualMachineResourceName)
+			if err := locks.ByNameWithContext(ctx, id.VirtualMachineName, VirtualMachineResourceName); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(id.VirtualMachineName, VirtualMachineResourceName)
 
 			virtualMachineId := virtualmachines.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName)
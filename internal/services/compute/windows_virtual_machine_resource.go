@@ -455,7 +455,9 @@ func resourceWindowsVirtualMachineCreate(d *pluginsdk.ResourceData, meta interfa
 
 	id := virtualmachines.NewVirtualMachineID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	locks.ByName(id.VirtualMachineName, VirtualMachineResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VirtualMachineName, VirtualMachineResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualMachineName, VirtualMachineResourceName)
 
 	resp, err := client.Get(ctx, id, virtualmachines.DefaultGetOperationOptions())
@@ -1092,7 +1094,9 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
-	locks.ByName(id.VirtualMachineName, VirtualMachineResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VirtualMachineName, VirtualMachineResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualMachineName, VirtualMachineResourceName)
 
 	log.Printf("[DEBUG] Retrieving Windows %s", id)
@@ -1710,7 +1714,9 @@ func resourceWindowsVirtualMachineDelete(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
-	locks.ByName(id.VirtualMachineName, VirtualMachineResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VirtualMachineName, VirtualMachineResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualMachineName, VirtualMachineResourceName)
 
 	log.Printf("[DEBUG] Retrieving Windows %s", id)
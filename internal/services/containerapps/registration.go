@@ -21,6 +21,12 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.App",
+	}
+}
+
 func (r Registration) Name() string {
 	return "Container Apps"
 }
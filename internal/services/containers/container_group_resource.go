@@ -756,7 +756,9 @@ func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) e
 				return fmt.Errorf(`parsing subnet id %q: %v`, item.Id, err)
 			}
 
-			locks.ByID(subnet.ID())
+			if err := locks.ByIDWithContext(ctx, subnet.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(subnet.ID())
 		}
 	}
@@ -1030,7 +1032,9 @@ func resourceContainerGroupDelete(d *pluginsdk.ResourceData, meta interface{}) e
 					return fmt.Errorf(`parsing subnet id %q: %v`, item.Id, err)
 				}
 
-				locks.ByID(subnet.ID())
+				if err := locks.ByIDWithContext(ctx, subnet.ID()); err != nil {
+					return err
+				}
 				defer locks.UnlockByID(subnet.ID())
 			}
 		}
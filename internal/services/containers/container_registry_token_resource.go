@@ -80,7 +80,9 @@ func resourceContainerRegistryTokenCreate(d *pluginsdk.ResourceData, meta interf
 
 	id := tokens.NewTokenID(subscriptionId, d.Get("resource_group_name").(string), d.Get("container_registry_name").(string), d.Get("name").(string))
 
-	locks.ByID(id.ID())
+	if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(id.ID())
 
 	if d.IsNewResource() {
@@ -131,7 +133,9 @@ func resourceContainerRegistryTokenUpdate(d *pluginsdk.ResourceData, meta interf
 		return err
 	}
 
-	locks.ByID(id.ID())
+	if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(id.ID())
 
 	scopeMapID := d.Get("scope_map_id").(string)
@@ -212,7 +216,9 @@ func resourceContainerRegistryTokenDelete(d *pluginsdk.ResourceData, meta interf
 		return err
 	}
 
-	locks.ByID(id.ID())
+	if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(id.ID())
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
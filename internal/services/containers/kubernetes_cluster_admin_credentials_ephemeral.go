@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-02-01/managedclusters"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+)
+
+var _ sdk.EphemeralResource = &KubernetesClusterAdminCredentialsEphemeralResource{}
+
+func NewKubernetesClusterAdminCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &KubernetesClusterAdminCredentialsEphemeralResource{}
+}
+
+// KubernetesClusterAdminCredentialsEphemeralResource exposes the Admin Kube Config for a Kubernetes Cluster, without
+// persisting this into the state
+type KubernetesClusterAdminCredentialsEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type KubernetesClusterAdminCredentialsEphemeralResourceModel struct {
+	KubernetesClusterId types.String                       `tfsdk:"kubernetes_cluster_id"`
+	KubeAdminConfig     []KubernetesClusterKubeConfigModel `tfsdk:"kube_admin_config"`
+	KubeAdminConfigRaw  types.String                       `tfsdk:"kube_admin_config_raw"`
+}
+
+type KubernetesClusterKubeConfigModel struct {
+	Host                 types.String `tfsdk:"host"`
+	Username             types.String `tfsdk:"username"`
+	Password             types.String `tfsdk:"password"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+	ClusterCaCertificate types.String `tfsdk:"cluster_ca_certificate"`
+}
+
+func (e *KubernetesClusterAdminCredentialsEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_kubernetes_cluster_admin_credentials"
+}
+
+func (e *KubernetesClusterAdminCredentialsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *KubernetesClusterAdminCredentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"kubernetes_cluster_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: commonids.ValidateKubernetesClusterID,
+					},
+				},
+			},
+
+			"kube_admin_config": schema.ListNestedAttribute{
+				Computed:  true,
+				Sensitive: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"host": schema.StringAttribute{
+							Computed:  true,
+							Sensitive: true,
+						},
+
+						"username": schema.StringAttribute{
+							Computed:  true,
+							Sensitive: true,
+						},
+
+						"password": schema.StringAttribute{
+							Computed:  true,
+							Sensitive: true,
+						},
+
+						"client_certificate": schema.StringAttribute{
+							Computed:  true,
+							Sensitive: true,
+						},
+
+						"client_key": schema.StringAttribute{
+							Computed:  true,
+							Sensitive: true,
+						},
+
+						"cluster_ca_certificate": schema.StringAttribute{
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
+			"kube_admin_config_raw": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *KubernetesClusterAdminCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.Containers.KubernetesClustersClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data KubernetesClusterAdminCredentialsEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := commonids.ParseKubernetesClusterID(data.KubernetesClusterId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	// the Admin Credentials are only available for clusters where local accounts haven't been disabled, in which case
+	// the API returns an error explaining this
+	credentials, err := client.ListClusterAdminCredentials(ctx, *id, managedclusters.ListClusterAdminCredentialsOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(credentials.HttpResponse) {
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("%s was not found", id), err)
+			return
+		}
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving Admin Credentials for %s", id), err)
+		return
+	}
+
+	kubeConfigRaw, kubeConfig := flattenKubernetesClusterCredentials(credentials.Model, "clusterAdmin")
+	if kubeConfigRaw == nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving Admin Credentials for %s", id), "the Admin Kube Config was not returned")
+		return
+	}
+
+	data.KubeAdminConfigRaw = types.StringValue(pointer.From(kubeConfigRaw))
+	data.KubeAdminConfig = make([]KubernetesClusterKubeConfigModel, 0)
+	for _, item := range kubeConfig {
+		v := item.(map[string]interface{})
+		data.KubeAdminConfig = append(data.KubeAdminConfig, KubernetesClusterKubeConfigModel{
+			Host:                 types.StringValue(v["host"].(string)),
+			Username:             types.StringValue(v["username"].(string)),
+			Password:             types.StringValue(v["password"].(string)),
+			ClientCertificate:    types.StringValue(v["client_certificate"].(string)),
+			ClientKey:            types.StringValue(v["client_key"].(string)),
+			ClusterCaCertificate: types.StringValue(v["cluster_ca_certificate"].(string)),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type KubernetesClusterAdminCredentialsEphemeral struct{}
+
+func TestAccEphemeralKubernetesClusterAdminCredentials_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_kubernetes_cluster_admin_credentials", "test")
+	r := KubernetesClusterAdminCredentialsEphemeral{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0-rc1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: framework.ProtoV6ProviderFactoriesInit(context.Background(), "azurerm", "echo"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("kube_admin_config_raw"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("kube_admin_config"), knownvalue.ListSizeExact(1)),
+				},
+			},
+		},
+	})
+}
+
+func (KubernetesClusterAdminCredentialsEphemeral) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_kubernetes_cluster_admin_credentials" "test" {
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_kubernetes_cluster_admin_credentials.test
+}
+
+resource "echo" "test" {}
+`, KubernetesClusterResource{}.basic(data))
+}
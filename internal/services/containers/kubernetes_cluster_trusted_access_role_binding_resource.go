@@ -105,7 +105,7 @@ func (r KubernetesClusterTrustedAccessRoleBindingResource) Create() sdk.Resource
 			var payload trustedaccess.TrustedAccessRoleBinding
 			r.mapKubernetesClusterTrustedAccessRoleBindingResourceSchemaToTrustedAccessRoleBinding(config, &payload)
 
-			if _, err := client.RoleBindingsCreateOrUpdate(ctx, id, payload); err != nil {
+			if err := client.RoleBindingsCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
@@ -159,7 +159,7 @@ func (r KubernetesClusterTrustedAccessRoleBindingResource) Delete() sdk.Resource
 				return err
 			}
 
-			if _, err := client.RoleBindingsDelete(ctx, *id); err != nil {
+			if err := client.RoleBindingsDeleteThenPoll(ctx, *id); err != nil {
 				return fmt.Errorf("deleting %s: %+v", *id, err)
 			}
 
@@ -195,7 +195,7 @@ func (r KubernetesClusterTrustedAccessRoleBindingResource) Update() sdk.Resource
 
 			r.mapKubernetesClusterTrustedAccessRoleBindingResourceSchemaToTrustedAccessRoleBinding(config, &payload)
 
-			if _, err := client.RoleBindingsCreateOrUpdate(ctx, *id, payload); err != nil {
+			if err := client.RoleBindingsCreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
 
@@ -4,6 +4,9 @@
 package containers
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -13,8 +16,9 @@ type Registration struct {
 }
 
 var (
-	_ sdk.TypedServiceRegistration   = Registration{}
-	_ sdk.UntypedServiceRegistration = Registration{}
+	_ sdk.TypedServiceRegistration          = Registration{}
+	_ sdk.UntypedServiceRegistration        = Registration{}
+	_ sdk.FrameworkTypedServiceRegistration = Registration{}
 )
 
 // Name is the name of this Service
@@ -31,6 +35,15 @@ func (r Registration) WebsiteCategories() []string {
 	return categories
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.ContainerInstance",
+		"Microsoft.ContainerRegistry",
+		"Microsoft.ContainerService",
+		"Microsoft.KubernetesConfiguration",
+	}
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
@@ -85,3 +98,20 @@ func (r Registration) Resources() []sdk.Resource {
 	resources = append(resources, r.autoRegistration.Resources()...)
 	return resources
 }
+
+// FrameworkResources returns a list of Framework Resources supported by this Service
+func (r Registration) FrameworkResources() []func() resource.Resource {
+	return []func() resource.Resource{}
+}
+
+// FrameworkDataSources returns a list of Framework Data Sources supported by this Service
+func (r Registration) FrameworkDataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+// EphemeralResources returns a list of Ephemeral Resources supported by this Service
+func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewKubernetesClusterAdminCredentialsEphemeralResource,
+	}
+}
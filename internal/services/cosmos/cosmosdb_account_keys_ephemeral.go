@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/cosmosdb"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+)
+
+var _ sdk.EphemeralResource = &CosmosDbAccountKeysEphemeralResource{}
+
+func NewCosmosDbAccountKeysEphemeralResource() ephemeral.EphemeralResource {
+	return &CosmosDbAccountKeysEphemeralResource{}
+}
+
+// CosmosDbAccountKeysEphemeralResource exposes the Keys (and the Connection Strings using these) for a CosmosDB
+// Account, without persisting these into the state
+type CosmosDbAccountKeysEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type CosmosDbAccountKeysEphemeralResourceModel struct {
+	CosmosDbAccountId                        types.String `tfsdk:"cosmosdb_account_id"`
+	PrimaryKey                               types.String `tfsdk:"primary_key"`
+	SecondaryKey                             types.String `tfsdk:"secondary_key"`
+	PrimaryReadonlyKey                       types.String `tfsdk:"primary_readonly_key"`
+	SecondaryReadonlyKey                     types.String `tfsdk:"secondary_readonly_key"`
+	PrimarySqlConnectionString               types.String `tfsdk:"primary_sql_connection_string"`
+	SecondarySqlConnectionString             types.String `tfsdk:"secondary_sql_connection_string"`
+	PrimaryReadonlySqlConnectionString       types.String `tfsdk:"primary_readonly_sql_connection_string"`
+	SecondaryReadonlySqlConnectionString     types.String `tfsdk:"secondary_readonly_sql_connection_string"`
+	PrimaryMongoDbConnectionString           types.String `tfsdk:"primary_mongodb_connection_string"`
+	SecondaryMongoDbConnectionString         types.String `tfsdk:"secondary_mongodb_connection_string"`
+	PrimaryReadonlyMongoDbConnectionString   types.String `tfsdk:"primary_readonly_mongodb_connection_string"`
+	SecondaryReadonlyMongoDbConnectionString types.String `tfsdk:"secondary_readonly_mongodb_connection_string"`
+}
+
+func (e *CosmosDbAccountKeysEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_cosmosdb_account_keys"
+}
+
+func (e *CosmosDbAccountKeysEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *CosmosDbAccountKeysEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"cosmosdb_account_id": schema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				frameworkhelpers.WrappedStringValidator{
+					Func: cosmosdb.ValidateDatabaseAccountID,
+				},
+			},
+		},
+
+		"primary_key": schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"secondary_key": schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"primary_readonly_key": schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"secondary_readonly_key": schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+
+	// the Connection Strings returned depend on the kind of CosmosDB Account, so are exposed using the same names as
+	// the `azurerm_cosmosdb_account` Resource / Data Source
+	for _, propertyName := range connStringPropertyMap {
+		attributes[propertyName] = schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (e *CosmosDbAccountKeysEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.Cosmos.CosmosDBClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data CosmosDbAccountKeysEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := cosmosdb.ParseDatabaseAccountID(data.CosmosDbAccountId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	keys, err := client.DatabaseAccountsListKeys(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(keys.HttpResponse) {
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("%s was not found", id), err)
+			return
+		}
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing Keys for %s", id), err)
+		return
+	}
+	if model := keys.Model; model != nil {
+		data.PrimaryKey = types.StringValue(pointer.From(model.PrimaryMasterKey))
+		data.SecondaryKey = types.StringValue(pointer.From(model.SecondaryMasterKey))
+		data.PrimaryReadonlyKey = types.StringValue(pointer.From(model.PrimaryReadonlyMasterKey))
+		data.SecondaryReadonlyKey = types.StringValue(pointer.From(model.SecondaryReadonlyMasterKey))
+	}
+
+	connStringResp, err := client.DatabaseAccountsListConnectionStrings(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing Connection Strings for %s", id), err)
+		return
+	}
+
+	connStrings := make(map[string]string)
+	if model := connStringResp.Model; model != nil && model.ConnectionStrings != nil {
+		for _, v := range *model.ConnectionStrings {
+			if propertyName, propertyExists := connStringPropertyMap[pointer.From(v.Description)]; propertyExists {
+				connStrings[propertyName] = pointer.From(v.ConnectionString)
+			}
+		}
+	}
+	connString := func(propertyName string) types.String {
+		if v, ok := connStrings[propertyName]; ok {
+			return types.StringValue(v)
+		}
+		return types.StringNull()
+	}
+
+	data.PrimarySqlConnectionString = connString("primary_sql_connection_string")
+	data.SecondarySqlConnectionString = connString("secondary_sql_connection_string")
+	data.PrimaryReadonlySqlConnectionString = connString("primary_readonly_sql_connection_string")
+	data.SecondaryReadonlySqlConnectionString = connString("secondary_readonly_sql_connection_string")
+	data.PrimaryMongoDbConnectionString = connString("primary_mongodb_connection_string")
+	data.SecondaryMongoDbConnectionString = connString("secondary_mongodb_connection_string")
+	data.PrimaryReadonlyMongoDbConnectionString = connString("primary_readonly_mongodb_connection_string")
+	data.SecondaryReadonlyMongoDbConnectionString = connString("secondary_readonly_mongodb_connection_string")
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
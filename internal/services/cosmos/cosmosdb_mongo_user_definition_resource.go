@@ -98,7 +98,9 @@ func (r CosmosDbMongoUserDefinitionResource) Create() sdk.ResourceFunc {
 			mongoUserDefinitionId := fmt.Sprintf("%s.%s", databaseId.Name, model.Username)
 			id := mongorbacs.NewMongodbUserDefinitionID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.DatabaseAccountName, mongoUserDefinitionId)
 
-			locks.ByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+			if err := locks.ByNameWithContext(ctx, id.DatabaseAccountName, CosmosDbAccountResourceName); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
 
 			existing, err := client.MongoDBResourcesGetMongoUserDefinition(ctx, id)
@@ -141,7 +143,9 @@ func (r CosmosDbMongoUserDefinitionResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+			if err := locks.ByNameWithContext(ctx, id.DatabaseAccountName, CosmosDbAccountResourceName); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
 
 			var model CosmosDbMongoUserDefinitionResourceModel
@@ -234,7 +238,9 @@ func (r CosmosDbMongoUserDefinitionResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+			if err := locks.ByNameWithContext(ctx, id.DatabaseAccountName, CosmosDbAccountResourceName); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
 
 			if err := client.MongoDBResourcesDeleteMongoUserDefinitionThenPoll(ctx, *id); err != nil {
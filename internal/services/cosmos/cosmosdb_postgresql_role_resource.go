@@ -16,9 +16,11 @@ import (
 )
 
 type CosmosDbPostgreSQLRoleResourceModel struct {
-	Name      string `tfschema:"name"`
-	ClusterId string `tfschema:"cluster_id"`
-	Password  string `tfschema:"password"`
+	Name              string `tfschema:"name"`
+	ClusterId         string `tfschema:"cluster_id"`
+	Password          string `tfschema:"password"`
+	PasswordWo        string `tfschema:"password_wo,writeOnly"`
+	PasswordWoVersion int64  `tfschema:"password_wo_version"`
 }
 
 type CosmosDbPostgreSQLRoleResource struct{}
@@ -54,11 +56,28 @@ func (r CosmosDbPostgreSQLRoleResource) Arguments() map[string]*pluginsdk.Schema
 		},
 
 		"password": {
-			Type:         pluginsdk.TypeString,
-			Required:     true,
-			ForceNew:     true,
-			Sensitive:    true,
-			ValidateFunc: validate.RolePassword,
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			Sensitive:     true,
+			ValidateFunc:  validate.RolePassword,
+			ConflictsWith: []string{"password_wo"},
+			ExactlyOneOf:  []string{"password", "password_wo"},
+		},
+
+		"password_wo": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			WriteOnly:     true,
+			ValidateFunc:  validate.RolePassword,
+			ConflictsWith: []string{"password"},
+			ExactlyOneOf:  []string{"password_wo", "password"},
+		},
+
+		"password_wo_version": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			ForceNew: true,
 		},
 	}
 }
@@ -92,9 +111,14 @@ func (r CosmosDbPostgreSQLRoleResource) Create() sdk.ResourceFunc {
 				return metadata.ResourceRequiresImport(r.ResourceType(), id)
 			}
 
+			password := model.Password
+			if model.PasswordWo != "" {
+				password = model.PasswordWo
+			}
+
 			parameters := roles.Role{
 				Properties: roles.RoleProperties{
-					Password: model.Password,
+					Password: password,
 				},
 			}
 
@@ -137,6 +161,8 @@ func (r CosmosDbPostgreSQLRoleResource) Read() sdk.ResourceFunc {
 				Name:      id.RoleName,
 				ClusterId: roles.NewServerGroupsv2ID(id.SubscriptionId, id.ResourceGroupName, id.ServerGroupsv2Name).ID(),
 				Password:  metadata.ResourceData.Get("password").(string),
+				// the write-only value isn't persisted into the state, so only the version is retained
+				PasswordWoVersion: int64(metadata.ResourceData.Get("password_wo_version").(int)),
 			}
 
 			return metadata.Encode(&state)
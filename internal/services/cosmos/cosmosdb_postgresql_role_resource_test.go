@@ -10,9 +10,13 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/roles"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -49,6 +53,30 @@ func TestCosmosDbPostgreSQLRole_requiresImport(t *testing.T) {
 	})
 }
 
+func TestCosmosDbPostgreSQLRole_writeOnlyPassword(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_postgresql_role", "test")
+	r := CosmosDbPostgreSQLRoleResource{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.writeOnlyPassword(data, "H@Sh1CoR3!", 1),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("password_wo_version"),
+			{
+				Config: r.writeOnlyPassword(data, "H@Sh1CoR4!", 2),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("password_wo_version"),
+		},
+	})
+}
+
 func (r CosmosDbPostgreSQLRoleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := roles.ParseRoleID(state.ID)
 	if err != nil {
@@ -112,3 +140,18 @@ resource "azurerm_cosmosdb_postgresql_role" "import" {
 }
 `, r.basic(data))
 }
+
+func (r CosmosDbPostgreSQLRoleResource) writeOnlyPassword(data acceptance.TestData, secret string, version int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+%[2]s
+
+resource "azurerm_cosmosdb_postgresql_role" "test" {
+  name                = "acctestpshscr%[3]d"
+  cluster_id          = azurerm_cosmosdb_postgresql_cluster.test.id
+  password_wo         = ephemeral.azurerm_key_vault_secret.test.value
+  password_wo_version = %[4]d
+}
+`, r.template(data), acceptance.WriteOnlyKeyVaultSecretTemplate(data, secret), data.RandomInteger, version)
+}
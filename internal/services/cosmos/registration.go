@@ -4,6 +4,9 @@
 package cosmos
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -13,6 +16,7 @@ type Registration struct{}
 var (
 	_ sdk.TypedServiceRegistration                   = Registration{}
 	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+	_ sdk.FrameworkTypedServiceRegistration          = Registration{}
 )
 
 func (r Registration) AssociatedGitHubLabel() string {
@@ -48,6 +52,22 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.DocumentDB",
+	}
+}
+
+func (r Registration) ResourceProvidersByResource() map[string][]string {
+	return map[string][]string{
+		"azurerm_cosmosdb_postgresql_cluster":                   {"Microsoft.DBforPostgreSQL"},
+		"azurerm_cosmosdb_postgresql_coordinator_configuration": {"Microsoft.DBforPostgreSQL"},
+		"azurerm_cosmosdb_postgresql_firewall_rule":             {"Microsoft.DBforPostgreSQL"},
+		"azurerm_cosmosdb_postgresql_node_configuration":        {"Microsoft.DBforPostgreSQL"},
+		"azurerm_cosmosdb_postgresql_role":                      {"Microsoft.DBforPostgreSQL"},
+	}
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
@@ -83,3 +103,20 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 
 	return resources
 }
+
+// FrameworkResources returns a list of Framework Resources supported by this Service
+func (r Registration) FrameworkResources() []func() resource.Resource {
+	return []func() resource.Resource{}
+}
+
+// FrameworkDataSources returns a list of Framework Data Sources supported by this Service
+func (r Registration) FrameworkDataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+// EphemeralResources returns a list of Ephemeral Resources supported by this Service
+func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewCosmosDbAccountKeysEphemeralResource,
+	}
+}
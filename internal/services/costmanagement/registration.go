@@ -42,3 +42,9 @@ func (r Registration) WebsiteCategories() []string {
 		"Cost Management",
 	}
 }
+
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.CostManagement",
+	}
+}
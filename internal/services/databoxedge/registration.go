@@ -31,6 +31,12 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.DataBoxEdge",
+	}
+}
+
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		EdgeDeviceDataSource{},
@@ -137,7 +137,9 @@ func resourceDatabricksVirtualNetworkPeeringCreate(d *pluginsdk.ResourceData, me
 
 	id = vnetpeering.NewVirtualNetworkPeeringID(subscriptionId, d.Get("resource_group_name").(string), workspaceId.WorkspaceName, d.Get("name").(string))
 
-	locks.ByID(databricksVnetPeeringsResourceType)
+	if err := locks.ByIDWithContext(ctx, databricksVnetPeeringsResourceType); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(databricksVnetPeeringsResourceType)
 
 	existing, err := client.Get(ctx, id)
@@ -264,7 +266,9 @@ func resourceDatabricksVirtualNetworkPeeringUpdate(d *pluginsdk.ResourceData, me
 		return err
 	}
 
-	locks.ByID(databricksVnetPeeringsResourceType)
+	if err := locks.ByIDWithContext(ctx, databricksVnetPeeringsResourceType); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(databricksVnetPeeringsResourceType)
 
 	existing, err := client.Get(ctx, *id)
@@ -307,7 +311,9 @@ func resourceDatabricksVirtualNetworkPeeringDelete(d *pluginsdk.ResourceData, me
 	}
 
 	// Block all changes to any resource of this type...
-	locks.ByID(databricksVnetPeeringsResourceType)
+	if err := locks.ByIDWithContext(ctx, databricksVnetPeeringsResourceType); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(databricksVnetPeeringsResourceType)
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
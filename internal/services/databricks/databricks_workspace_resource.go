@@ -497,10 +497,14 @@ func resourceDatabricksWorkspaceCreate(d *pluginsdk.ResourceData, meta interface
 		backendPoolName = backendPoolId.BackendAddressPoolName
 		loadBalancerId = lbId.ID()
 
-		locks.ByID(backendPoolId.ID())
+		if err := locks.ByIDWithContext(ctx, backendPoolId.ID()); err != nil {
+			return err
+		}
 		defer locks.UnlockByID(backendPoolId.ID())
 
-		locks.ByID(lbId.ID())
+		if err := locks.ByIDWithContext(ctx, lbId.ID()); err != nil {
+			return err
+		}
 		defer locks.UnlockByID(lbId.ID())
 
 		// check to make sure the load balancer exists as referred to by the Backend Address Pool...
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datafactory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccDataFactoryCredentialServicePrincipal_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_credential_service_principal", "test")
+	r := CredentialServicePrincipalResource{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.12.0-rc2"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_data_factory_credential_service_principal.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+					customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_data_factory_credential_service_principal.test", tfjsonpath.New("factory_name"), tfjsonpath.New("data_factory_id")),
+					customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_data_factory_credential_service_principal.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("data_factory_id")),
+					customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_data_factory_credential_service_principal.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("data_factory_id")),
+				},
+			},
+			data.ImportBlockWithResourceIdentityStep(),
+			data.ImportBlockWithIDStep(),
+		},
+	})
+}
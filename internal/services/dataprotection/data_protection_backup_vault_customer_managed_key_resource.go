@@ -77,7 +77,9 @@ func (r DataProtectionBackupVaultCustomerManagedKeyResource) Create() sdk.Resour
 				return err
 			}
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			resp, err := client.Get(ctx, *id)
@@ -187,7 +189,9 @@ func (r DataProtectionBackupVaultCustomerManagedKeyResource) Update() sdk.Resour
 				return err
 			}
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			resp, err := client.Get(ctx, *id)
@@ -291,12 +291,16 @@ func resourceDataProtectionBackupVaultDelete(d *pluginsdk.ResourceData, meta int
 		return err
 	}
 
-	if resp, err := client.Delete(ctx, *id); err != nil {
+	resp, err := client.Delete(ctx, *id)
+	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			return nil
 		}
 		return fmt.Errorf("deleting DataProtection BackupVault (%q): %+v", id, err)
 	}
+	if err := resp.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("waiting for the deletion of DataProtection BackupVault (%q): %+v", id, err)
+	}
 	return nil
 }
 
@@ -114,12 +114,14 @@ func resourceVirtualDesktopApplicationGroupCreateUpdate(d *pluginsdk.ResourceDat
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(name, applicationGroupType)
-	defer locks.UnlockByName(name, applicationGroupType)
-
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
+	if err := locks.ByNameWithContext(ctx, name, applicationGroupType); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(name, applicationGroupType)
+
 	id := applicationgroup.NewApplicationGroupID(subscriptionId, resourceGroup, name)
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id)
@@ -251,11 +253,13 @@ func resourceVirtualDesktopApplicationGroupDelete(d *pluginsdk.ResourceData, met
 		return err
 	}
 
-	locks.ByName(id.ApplicationGroupName, applicationGroupType)
-	defer locks.UnlockByName(id.ApplicationGroupName, applicationGroupType)
-
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+
+	if err := locks.ByNameWithContext(ctx, id.ApplicationGroupName, applicationGroupType); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(id.ApplicationGroupName, applicationGroupType)
 	if _, err = client.Delete(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
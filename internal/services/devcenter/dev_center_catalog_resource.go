@@ -112,7 +112,7 @@ func (r DevCenterCatalogsResource) Create() sdk.ResourceFunc {
 				},
 			}
 
-			if _, err := client.CreateOrUpdate(ctx, id, catalogProperties); err != nil {
+			if err := client.CreateOrUpdateThenPoll(ctx, id, catalogProperties); err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
@@ -155,7 +155,7 @@ func (r DevCenterCatalogsResource) Update() sdk.ResourceFunc {
 				properties.Properties.AdoGit = expandCatalogProperties(model.CatalogAdoGit)
 			}
 
-			if _, err := client.CreateOrUpdate(ctx, *id, *properties); err != nil {
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *properties); err != nil {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
 
@@ -303,10 +303,15 @@ func (r MonitorsResource) Delete() sdk.ResourceFunc {
 
 			metadata.Logger.Infof("deleting %s", *id)
 
-			if resp, err := client.Delete(ctx, *id); err != nil {
-				if !response.WasNotFound(resp.HttpResponse) {
-					return fmt.Errorf("deleting %s: %+v", *id, err)
+			resp, err := client.Delete(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil
 				}
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			if err := resp.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
 			}
 			return nil
 		},
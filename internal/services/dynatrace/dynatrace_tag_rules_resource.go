@@ -213,7 +213,7 @@ func (r TagRulesResource) Create() sdk.ResourceFunc {
 				Properties: tagRulesProps,
 			}
 
-			if _, err := client.CreateOrUpdate(ctx, id, tagRules); err != nil {
+			if err := client.CreateOrUpdateThenPoll(ctx, id, tagRules); err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
@@ -272,7 +272,7 @@ func (r TagRulesResource) Delete() sdk.ResourceFunc {
 
 			metadata.Logger.Infof("deleting %s", *id)
 
-			if _, err := client.Delete(ctx, *id); err != nil {
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
 				return fmt.Errorf("deleting %s: %+v", *id, err)
 			}
 			return nil
@@ -310,7 +310,7 @@ func (r TagRulesResource) Update() sdk.ResourceFunc {
 				model.Properties.LogRules = ExpandLogRule(state.LogRules)
 			}
 
-			if _, err := client.CreateOrUpdate(ctx, *id, *model); err != nil {
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *model); err != nil {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
 
@@ -35,3 +35,9 @@ func (r Registration) WebsiteCategories() []string {
 		"Dynatrace",
 	}
 }
+
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Dynatrace.Observability",
+	}
+}
@@ -38,3 +38,9 @@ func (Registration) WebsiteCategories() []string {
 		"Elastic SAN",
 	}
 }
+
+func (Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.ElasticSan",
+	}
+}
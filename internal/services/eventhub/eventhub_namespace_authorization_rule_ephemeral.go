@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventhub
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/authorizationrulesnamespaces"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
+)
+
+var _ sdk.EphemeralResource = &EventHubNamespaceAuthorizationRuleEphemeralResource{}
+
+func NewEventHubNamespaceAuthorizationRuleEphemeralResource() ephemeral.EphemeralResource {
+	return &EventHubNamespaceAuthorizationRuleEphemeralResource{}
+}
+
+// EventHubNamespaceAuthorizationRuleEphemeralResource exposes the Keys and Connection Strings for an EventHub
+// Namespace Authorization Rule, without persisting these into the state
+type EventHubNamespaceAuthorizationRuleEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type EventHubNamespaceAuthorizationRuleEphemeralResourceModel struct {
+	Name                           types.String `tfsdk:"name"`
+	NamespaceId                    types.String `tfsdk:"namespace_id"`
+	PrimaryKey                     types.String `tfsdk:"primary_key"`
+	SecondaryKey                   types.String `tfsdk:"secondary_key"`
+	PrimaryConnectionString        types.String `tfsdk:"primary_connection_string"`
+	SecondaryConnectionString      types.String `tfsdk:"secondary_connection_string"`
+	PrimaryConnectionStringAlias   types.String `tfsdk:"primary_connection_string_alias"`
+	SecondaryConnectionStringAlias types.String `tfsdk:"secondary_connection_string_alias"`
+}
+
+func (e *EventHubNamespaceAuthorizationRuleEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_eventhub_namespace_authorization_rule"
+}
+
+func (e *EventHubNamespaceAuthorizationRuleEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *EventHubNamespaceAuthorizationRuleEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validate.ValidateEventHubAuthorizationRuleName(),
+					},
+				},
+			},
+
+			"namespace_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: authorizationrulesnamespaces.ValidateNamespaceID,
+					},
+				},
+			},
+
+			"primary_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_connection_string_alias": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string_alias": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *EventHubNamespaceAuthorizationRuleEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.Eventhub.NamespaceAuthorizationRulesClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data EventHubNamespaceAuthorizationRuleEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	namespaceId, err := authorizationrulesnamespaces.ParseNamespaceID(data.NamespaceId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	id := authorizationrulesnamespaces.NewAuthorizationRuleID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, data.Name.ValueString())
+
+	keys, err := client.NamespacesListKeys(ctx, id)
+	if err != nil {
+		if response.WasNotFound(keys.HttpResponse) {
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("%s was not found", id), err)
+			return
+		}
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing keys for %s", id), err)
+		return
+	}
+
+	if model := keys.Model; model != nil {
+		data.PrimaryKey = types.StringValue(pointer.From(model.PrimaryKey))
+		data.SecondaryKey = types.StringValue(pointer.From(model.SecondaryKey))
+		data.PrimaryConnectionString = types.StringValue(pointer.From(model.PrimaryConnectionString))
+		data.SecondaryConnectionString = types.StringValue(pointer.From(model.SecondaryConnectionString))
+		data.PrimaryConnectionStringAlias = types.StringValue(pointer.From(model.AliasPrimaryConnectionString))
+		data.SecondaryConnectionStringAlias = types.StringValue(pointer.From(model.AliasSecondaryConnectionString))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
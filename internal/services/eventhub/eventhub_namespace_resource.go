@@ -295,7 +295,9 @@ func resourceEventHubNamespaceCreate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
-	locks.ByName(id.NamespaceName, eventHubNamespaceResourceName)
+	if err := locks.ByNameWithContext(ctx, id.NamespaceName, eventHubNamespaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.NamespaceName, eventHubNamespaceResourceName)
 
 	if existing.Model != nil {
@@ -395,7 +397,9 @@ func resourceEventHubNamespaceUpdate(d *pluginsdk.ResourceData, meta interface{}
 
 	id := namespaces.NewNamespaceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	locks.ByName(id.NamespaceName, eventHubNamespaceResourceName)
+	if err := locks.ByNameWithContext(ctx, id.NamespaceName, eventHubNamespaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.NamespaceName, eventHubNamespaceResourceName)
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
@@ -609,7 +613,9 @@ func resourceEventHubNamespaceDelete(d *pluginsdk.ResourceData, meta interface{}
 		return err
 	}
 
-	locks.ByName(id.NamespaceName, eventHubNamespaceResourceName)
+	if err := locks.ByNameWithContext(ctx, id.NamespaceName, eventHubNamespaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.NamespaceName, eventHubNamespaceResourceName)
 
 	if err = client.DeleteThenPoll(ctx, *id); err != nil {
@@ -38,3 +38,9 @@ func (r Registration) WebsiteCategories() []string {
 		"Fabric",
 	}
 }
+
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Fabric",
+	}
+}
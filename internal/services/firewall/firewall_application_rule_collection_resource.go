@@ -153,7 +153,9 @@ func resourceFirewallApplicationRuleCollectionCreateUpdate(d *pluginsdk.Resource
 		return fmt.Errorf("expanding Firewall Application Rules: %+v", err)
 	}
 
-	locks.ByName(firewallName, AzureFirewallResourceName)
+	if err := locks.ByNameWithContext(ctx, firewallName, AzureFirewallResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(firewallName, AzureFirewallResourceName)
 
 	firewallId := azurefirewalls.NewAzureFirewallID(subscriptionId, resourceGroup, firewallName)
@@ -341,7 +343,9 @@ func resourceFirewallApplicationRuleCollectionDelete(d *pluginsdk.ResourceData,
 		return err
 	}
 
-	locks.ByName(id.AzureFirewallName, AzureFirewallResourceName)
+	if err := locks.ByNameWithContext(ctx, id.AzureFirewallName, AzureFirewallResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.AzureFirewallName, AzureFirewallResourceName)
 
 	firewallId := azurefirewalls.NewAzureFirewallID(id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName)
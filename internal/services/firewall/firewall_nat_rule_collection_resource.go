@@ -146,7 +146,9 @@ func resourceFirewallNatRuleCollectionCreateUpdate(d *pluginsdk.ResourceData, me
 	firewallName := d.Get("azure_firewall_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(firewallName, AzureFirewallResourceName)
+	if err := locks.ByNameWithContext(ctx, firewallName, AzureFirewallResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(firewallName, AzureFirewallResourceName)
 
 	firewallId := azurefirewalls.NewAzureFirewallID(subscriptionId, resourceGroup, firewallName)
@@ -340,7 +342,9 @@ func resourceFirewallNatRuleCollectionDelete(d *pluginsdk.ResourceData, meta int
 		return err
 	}
 
-	locks.ByName(id.AzureFirewallName, AzureFirewallResourceName)
+	if err := locks.ByNameWithContext(ctx, id.AzureFirewallName, AzureFirewallResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.AzureFirewallName, AzureFirewallResourceName)
 
 	firewallId := azurefirewalls.NewAzureFirewallID(id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName)
@@ -148,7 +148,9 @@ func resourceFirewallNetworkRuleCollectionCreateUpdate(d *pluginsdk.ResourceData
 	firewallName := d.Get("azure_firewall_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(firewallName, AzureFirewallResourceName)
+	if err := locks.ByNameWithContext(ctx, firewallName, AzureFirewallResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(firewallName, AzureFirewallResourceName)
 
 	firewallId := azurefirewalls.NewAzureFirewallID(subscriptionId, resourceGroup, firewallName)
@@ -342,7 +344,9 @@ func resourceFirewallNetworkRuleCollectionDelete(d *pluginsdk.ResourceData, meta
 		return err
 	}
 
-	locks.ByName(id.AzureFirewallName, AzureFirewallResourceName)
+	if err := locks.ByNameWithContext(ctx, id.AzureFirewallName, AzureFirewallResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.AzureFirewallName, AzureFirewallResourceName)
 
 	firewallId := azurefirewalls.NewAzureFirewallID(id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName)
@@ -479,7 +479,9 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 		}
 	}
 
-	locks.ByName(policyId.FirewallPolicyName, AzureFirewallPolicyResourceName)
+	if err := locks.ByNameWithContext(ctx, policyId.FirewallPolicyName, AzureFirewallPolicyResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(policyId.FirewallPolicyName, AzureFirewallPolicyResourceName)
 
 	param := firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollectionGroup{
@@ -566,7 +568,9 @@ func resourceFirewallPolicyRuleCollectionGroupDelete(d *pluginsdk.ResourceData,
 		return err
 	}
 
-	locks.ByName(id.FirewallPolicyName, AzureFirewallPolicyResourceName)
+	if err := locks.ByNameWithContext(ctx, id.FirewallPolicyName, AzureFirewallPolicyResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.FirewallPolicyName, AzureFirewallPolicyResourceName)
 
 	if err = client.DeleteThenPoll(ctx, *id); err != nil {
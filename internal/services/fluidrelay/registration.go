@@ -31,4 +31,10 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.FluidRelay",
+	}
+}
+
 var _ sdk.TypedServiceRegistration = (*Registration)(nil)
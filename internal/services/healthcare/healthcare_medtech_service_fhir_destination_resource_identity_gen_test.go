@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthcare_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccHealthcareMedtechServiceFhirDestination_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_healthcare_medtech_service_fhir_destination", "test")
+	r := HealthCareMedTechServiceFhirDestinationResource{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.12.0-rc2"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_healthcare_medtech_service_fhir_destination.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+					customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_healthcare_medtech_service_fhir_destination.test", tfjsonpath.New("iot_connector_name"), tfjsonpath.New("medtech_service_id")),
+					customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_healthcare_medtech_service_fhir_destination.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("medtech_service_id")),
+					customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_healthcare_medtech_service_fhir_destination.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("medtech_service_id")),
+					customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_healthcare_medtech_service_fhir_destination.test", tfjsonpath.New("workspace_name"), tfjsonpath.New("medtech_service_id")),
+				},
+			},
+			data.ImportBlockWithResourceIdentityStep(),
+			data.ImportBlockWithIDStep(),
+		},
+	})
+}
@@ -112,7 +112,9 @@ func (r IotCentralApplicationNetworkRuleSetResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			app, err := client.Get(ctx, *id)
@@ -50,3 +50,9 @@ func (r Registration) WebsiteCategories() []string {
 		"IoT Central",
 	}
 }
+
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.IoTCentral",
+	}
+}
@@ -166,7 +166,9 @@ func (r IotHubEndpointCosmosDBAccountResource) Create() sdk.ResourceFunc {
 
 			id := parse.NewEndpointCosmosDBAccountID(subscriptionId, iotHubId.ResourceGroup, iotHubId.Name, state.Name)
 
-			locks.ByName(iotHubId.Name, IothubResourceName)
+			if err := locks.ByNameWithContext(ctx, iotHubId.Name, IothubResourceName); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(iotHubId.Name, IothubResourceName)
 
 			iothub, err := client.Get(ctx, iotHubId.ResourceGroup, iotHubId.Name)
@@ -330,7 +332,9 @@ func (r IotHubEndpointCosmosDBAccountResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByName(id.IotHubName, IothubResourceName)
+			if err := locks.ByNameWithContext(ctx, id.IotHubName, IothubResourceName); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(id.IotHubName, IothubResourceName)
 
 			var state IotHubEndpointCosmosDBAccountModel
@@ -429,7 +433,9 @@ func (r IotHubEndpointCosmosDBAccountResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByName(id.IotHubName, IothubResourceName)
+			if err := locks.ByNameWithContext(ctx, id.IotHubName, IothubResourceName); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(id.IotHubName, IothubResourceName)
 
 			iothub, err := client.Get(ctx, id.ResourceGroup, id.IotHubName)
@@ -171,7 +171,9 @@ func resourceIotHubEndpointStorageContainerCreateUpdate(d *pluginsdk.ResourceDat
 
 	id := parse.NewEndpointStorageContainerID(subscriptionId, iotHubRG, iotHubName, d.Get("name").(string))
 
-	locks.ByName(iotHubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, iotHubName, IothubResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(iotHubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, iotHubRG, iotHubName)
@@ -363,7 +365,9 @@ func resourceIotHubEndpointStorageContainerDelete(d *pluginsdk.ResourceData, met
 		return err
 	}
 
-	locks.ByName(id.IotHubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, id.IotHubName, IothubResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.IotHubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, id.ResourceGroup, id.IotHubName)
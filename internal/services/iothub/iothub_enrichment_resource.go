@@ -96,7 +96,9 @@ func resourceArmIotHubEnrichmentCreateUpdate(d *pluginsdk.ResourceData, meta int
 	iothubName := d.Get("iothub_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(iothubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, iothubName, IothubResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, resourceGroup, iothubName)
@@ -224,7 +226,9 @@ func resourceArmIotHubEnrichmentDelete(d *pluginsdk.ResourceData, meta interface
 		return err
 	}
 
-	locks.ByName(id.IotHubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, id.IotHubName, IothubResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.IotHubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, id.ResourceGroup, id.IotHubName)
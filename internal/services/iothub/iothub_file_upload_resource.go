@@ -143,7 +143,9 @@ func (r IotHubFileUploadResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			iotHub, err := client.Get(ctx, id.ResourceGroup, id.Name)
@@ -300,7 +302,9 @@ func (r IotHubFileUploadResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
@@ -403,7 +407,9 @@ func (r IotHubFileUploadResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
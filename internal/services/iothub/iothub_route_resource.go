@@ -113,7 +113,9 @@ func resourceIotHubRouteCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 
 	id := parse.NewRouteID(subscriptionId, d.Get("resource_group_name").(string), d.Get("iothub_name").(string), d.Get("name").(string))
 
-	locks.ByName(id.IotHubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, id.IotHubName, IothubResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.IotHubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, id.ResourceGroup, id.IotHubName)
@@ -249,7 +251,9 @@ func resourceIotHubRouteDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	locks.ByName(id.IotHubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, id.IotHubName, IothubResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.IotHubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, id.ResourceGroup, id.IotHubName)
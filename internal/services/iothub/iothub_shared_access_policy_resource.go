@@ -146,7 +146,9 @@ func resourceIotHubSharedAccessPolicyCreateUpdate(d *pluginsdk.ResourceData, met
 
 	id := parse.NewSharedAccessPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("iothub_name").(string), d.Get("name").(string))
 
-	locks.ByName(id.IotHubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, id.IotHubName, IothubResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.IotHubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, id.ResourceGroup, id.IotHubName)
@@ -272,7 +274,9 @@ func resourceIotHubSharedAccessPolicyDelete(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
-	locks.ByName(id.IotHubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, id.IotHubName, IothubResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.IotHubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, id.ResourceGroup, id.IotHubName)
@@ -28,6 +28,19 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Devices",
+	}
+}
+
+func (r Registration) ResourceProvidersByResource() map[string][]string {
+	return map[string][]string{
+		"azurerm_iothub_device_update_account":  {"Microsoft.DeviceUpdate"},
+		"azurerm_iothub_device_update_instance": {"Microsoft.DeviceUpdate"},
+	}
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
@@ -96,7 +96,9 @@ func resourceKeyVaultAccessPolicyCreate(d *pluginsdk.ResourceData, meta interfac
 	id := parse.NewAccessPolicyId(*keyVaultId, objectId, applicationId)
 
 	// Locking to prevent parallel changes causing issues
-	locks.ByName(keyVaultId.VaultName, keyVaultResourceName)
+	if err := locks.ByNameWithContext(ctx, keyVaultId.VaultName, keyVaultResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(keyVaultId.VaultName, keyVaultResourceName)
 
 	keyVault, err := client.Get(ctx, *keyVaultId)
@@ -196,7 +198,9 @@ func resourceKeyVaultAccessPolicyUpdate(d *pluginsdk.ResourceData, meta interfac
 	keyVaultId := id.KeyVaultId()
 
 	// Locking to prevent parallel changes causing issues
-	locks.ByName(keyVaultId.VaultName, keyVaultResourceName)
+	if err := locks.ByNameWithContext(ctx, keyVaultId.VaultName, keyVaultResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(keyVaultId.VaultName, keyVaultResourceName)
 
 	certPermissionsRaw := d.Get("certificate_permissions").([]interface{})
@@ -330,7 +334,9 @@ func resourceKeyVaultAccessPolicyDelete(d *pluginsdk.ResourceData, meta interfac
 	vaultId := id.KeyVaultId()
 
 	// Locking to prevent parallel changes causing issues
-	locks.ByName(vaultId.VaultName, keyVaultResourceName)
+	if err := locks.ByNameWithContext(ctx, vaultId.VaultName, keyVaultResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(vaultId.VaultName, keyVaultResourceName)
 
 	keyVault, err := client.Get(ctx, vaultId)
@@ -258,7 +258,9 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	// Locking this resource so we don't make modifications to it at the same time if there is a
 	// key vault access policy trying to update it as well
-	locks.ByName(id.VaultName, keyVaultResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VaultName, keyVaultResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VaultName, keyVaultResourceName)
 
 	isPublic := d.Get("public_network_access_enabled").(bool)
@@ -373,7 +375,9 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, &virtualNetworkNames, network.VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
 
 	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
@@ -462,7 +466,9 @@ func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	// Locking this resource so we don't make modifications to it at the same time if there is a
 	// key vault access policy trying to update it as well
-	locks.ByName(id.VaultName, keyVaultResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VaultName, keyVaultResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VaultName, keyVaultResourceName)
 
 	d.Partial(true)
@@ -543,7 +549,9 @@ func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 			}
 		}
 
-		locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+		if err := locks.MultipleByNameWithContext(ctx, &virtualNetworkNames, network.VirtualNetworkResourceName); err != nil {
+			return err
+		}
 		defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
 
 		update.Properties.NetworkAcls = networkAcls
@@ -795,7 +803,9 @@ func resourceKeyVaultDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.VaultName, keyVaultResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VaultName, keyVaultResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VaultName, keyVaultResourceName)
 
 	read, err := client.Get(ctx, *id)
@@ -837,7 +847,9 @@ func resourceKeyVaultDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, &virtualNetworkNames, network.VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
 
 	if _, err := client.Delete(ctx, *id); err != nil {
@@ -125,7 +125,9 @@ func resourceKustoDatabaseScriptCreateUpdate(d *pluginsdk.ResourceData, meta int
 	}
 
 	clusterId := commonids.NewKustoClusterID(databaseId.SubscriptionId, databaseId.ResourceGroupName, databaseId.KustoClusterName)
-	locks.ByID(clusterId.ID())
+	if err := locks.ByIDWithContext(ctx, clusterId.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(clusterId.ID())
 
 	forceUpdateTag := d.Get("force_an_update_when_value_changed").(string)
@@ -203,7 +205,9 @@ func resourceKustoDatabaseScriptDelete(d *pluginsdk.ResourceData, meta interface
 	}
 
 	// DELETE operation for script does not support running concurrently at cluster level
-	locks.ByName(id.ClusterName, "azurerm_kusto_cluster")
+	if err := locks.ByNameWithContext(ctx, id.ClusterName, "azurerm_kusto_cluster"); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.ClusterName, "azurerm_kusto_cluster")
 
 	err = client.DeleteThenPoll(ctx, *id)
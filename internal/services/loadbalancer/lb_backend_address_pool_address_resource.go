@@ -133,7 +133,9 @@ func (r BackendAddressPoolAddressResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByName(poolId.BackendAddressPoolName, backendAddressPoolResourceName)
+			if err := locks.ByNameWithContext(ctx, poolId.BackendAddressPoolName, backendAddressPoolResourceName); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(poolId.BackendAddressPoolName, backendAddressPoolResourceName)
 
 			// Backend Addresses can not be created for Basic sku, so we have to check
@@ -339,7 +341,9 @@ func (r BackendAddressPoolAddressResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
+			if err := locks.ByNameWithContext(ctx, id.BackendAddressPoolName, backendAddressPoolResourceName); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
 
 			poolId := loadbalancers.NewLoadBalancerBackendAddressPoolID(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
@@ -412,7 +416,9 @@ func (r BackendAddressPoolAddressResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
+			if err := locks.ByNameWithContext(ctx, id.BackendAddressPoolName, backendAddressPoolResourceName); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
 
 			var model BackendAddressPoolAddressModel
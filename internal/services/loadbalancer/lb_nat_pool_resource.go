@@ -137,7 +137,9 @@ func resourceArmLoadBalancerNatPoolCreateUpdate(d *pluginsdk.ResourceData, meta
 	id := parse.NewLoadBalancerInboundNatPoolID(subscriptionId, loadBalancerId.ResourceGroupName, loadBalancerId.LoadBalancerName, d.Get("name").(string))
 
 	loadBalancerID := loadBalancerId.ID()
-	locks.ByID(loadBalancerID)
+	if err := locks.ByIDWithContext(ctx, loadBalancerID); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(loadBalancerID)
 
 	plbId := loadbalancers.ProviderLoadBalancerId{SubscriptionId: id.SubscriptionId, ResourceGroupName: id.ResourceGroup, LoadBalancerName: id.LoadBalancerName}
@@ -259,7 +261,9 @@ func resourceArmLoadBalancerNatPoolDelete(d *pluginsdk.ResourceData, meta interf
 
 	loadBalancerId := loadbalancers.NewLoadBalancerID(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName)
 	loadBalancerID := loadBalancerId.ID()
-	locks.ByID(loadBalancerID)
+	if err := locks.ByIDWithContext(ctx, loadBalancerID); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(loadBalancerID)
 
 	plbId := loadbalancers.ProviderLoadBalancerId{SubscriptionId: id.SubscriptionId, ResourceGroupName: id.ResourceGroup, LoadBalancerName: id.LoadBalancerName}
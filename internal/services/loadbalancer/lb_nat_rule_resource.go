@@ -163,7 +163,9 @@ func resourceArmLoadBalancerNatRuleCreateUpdate(d *pluginsdk.ResourceData, meta
 	id := loadbalancers.NewInboundNatRuleID(subscriptionId, loadBalancerId.ResourceGroupName, loadBalancerId.LoadBalancerName, d.Get("name").(string))
 
 	loadBalancerIdRaw := loadBalancerId.ID()
-	locks.ByID(loadBalancerIdRaw)
+	if err := locks.ByIDWithContext(ctx, loadBalancerIdRaw); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(loadBalancerIdRaw)
 
 	plbId := loadbalancers.ProviderLoadBalancerId{SubscriptionId: id.SubscriptionId, ResourceGroupName: id.ResourceGroupName, LoadBalancerName: id.LoadBalancerName}
@@ -293,7 +295,9 @@ func resourceArmLoadBalancerNatRuleDelete(d *pluginsdk.ResourceData, meta interf
 
 	loadBalancerId := loadbalancers.NewLoadBalancerID(id.SubscriptionId, id.ResourceGroupName, id.LoadBalancerName)
 	loadBalancerID := loadBalancerId.ID()
-	locks.ByID(loadBalancerID)
+	if err := locks.ByIDWithContext(ctx, loadBalancerID); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(loadBalancerID)
 
 	plbId := loadbalancers.ProviderLoadBalancerId{SubscriptionId: id.SubscriptionId, ResourceGroupName: id.ResourceGroupName, LoadBalancerName: id.LoadBalancerName}
@@ -64,7 +64,9 @@ func resourceArmLoadBalancerRuleCreateUpdate(d *pluginsdk.ResourceData, meta int
 	id := loadbalancers.NewLoadBalancingRuleID(subscriptionId, loadBalancerId.ResourceGroupName, loadBalancerId.LoadBalancerName, d.Get("name").(string))
 
 	loadBalancerID := loadBalancerId.ID()
-	locks.ByID(loadBalancerID)
+	if err := locks.ByIDWithContext(ctx, loadBalancerID); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(loadBalancerID)
 
 	plbId := loadbalancers.ProviderLoadBalancerId{SubscriptionId: id.SubscriptionId, ResourceGroupName: id.ResourceGroupName, LoadBalancerName: id.LoadBalancerName}
@@ -222,7 +224,9 @@ func resourceArmLoadBalancerRuleDelete(d *pluginsdk.ResourceData, meta interface
 
 	loadBalancerId := loadbalancers.NewLoadBalancerID(id.SubscriptionId, id.ResourceGroupName, id.LoadBalancerName)
 	loadBalancerIDRaw := loadBalancerId.ID()
-	locks.ByID(loadBalancerIDRaw)
+	if err := locks.ByIDWithContext(ctx, loadBalancerIDRaw); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(loadBalancerIDRaw)
 
 	plbId := loadbalancers.ProviderLoadBalancerId{SubscriptionId: id.SubscriptionId, ResourceGroupName: id.ResourceGroupName, LoadBalancerName: id.LoadBalancerName}
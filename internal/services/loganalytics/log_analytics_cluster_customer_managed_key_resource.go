@@ -72,7 +72,9 @@ func resourceLogAnalyticsClusterCustomerManagedKeyCreate(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByID(id.ID())
+	if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(id.ID())
 
 	resp, err := client.Get(ctx, *id)
@@ -144,7 +146,9 @@ func resourceLogAnalyticsClusterCustomerManagedKeyUpdate(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByID(id.ID())
+	if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(id.ID())
 
 	keyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(d.Get("key_vault_key_id").(string))
@@ -247,7 +251,9 @@ func resourceLogAnalyticsClusterCustomerManagedKeyDelete(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByID(id.ID())
+	if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(id.ID())
 
 	resp, err := client.Get(ctx, *id)
@@ -99,7 +99,9 @@ func (r LogAnalyticsClusterResource) Create() sdk.ResourceFunc {
 
 			id := clusters.NewClusterID(subscriptionId, config.ResourceGroupName, config.Name)
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			existing, err := client.Get(ctx, id)
@@ -207,7 +209,9 @@ func (r LogAnalyticsClusterResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			payload := clusters.ClusterPatch{}
@@ -243,7 +247,9 @@ func (r LogAnalyticsClusterResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			err = client.DeleteThenPoll(ctx, *id)
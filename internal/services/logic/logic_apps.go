@@ -65,7 +65,9 @@ func resourceLogicAppComponentUpdate(d *pluginsdk.ResourceData, meta interface{}
 	log.Printf("[DEBUG] Preparing arguments for Logic App Workspace %s %s %q", workflowId, kind, name)
 
 	// lock to prevent against Actions or Triggers conflicting
-	locks.ByName(workflowId.WorkflowName, logicAppResourceName)
+	if err := locks.ByNameWithContext(ctx, workflowId.WorkflowName, logicAppResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(workflowId.WorkflowName, logicAppResourceName)
 
 	read, err := client.Get(ctx, workflowId)
@@ -146,7 +148,9 @@ func resourceLogicAppComponentRemove(d *pluginsdk.ResourceData, meta interface{}
 	log.Printf("[DEBUG] Preparing arguments for Logic App Workspace %q (Resource Group %q) %s %q Deletion", id.WorkflowName, id.ResourceGroupName, kind, name)
 
 	// lock to prevent against Actions, Parameters or Actions conflicting
-	locks.ByName(id.WorkflowName, logicAppResourceName)
+	if err := locks.ByNameWithContext(ctx, id.WorkflowName, logicAppResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.WorkflowName, logicAppResourceName)
 
 	read, err := client.Get(ctx, id)
@@ -252,7 +256,9 @@ func retreiveLogicAppTriggerCallbackUrl(d *pluginsdk.ResourceData, meta interfac
 	log.Printf("[DEBUG] Preparing arguments for Logic App Workspace %q (Resource Group %q) %s %q", id.WorkflowName, id.ResourceGroupName, "trigger", id.TriggerName)
 
 	// lock to prevent against Actions, Parameters or Actions conflicting
-	locks.ByName(id.WorkflowName, logicAppResourceName)
+	if err := locks.ByNameWithContext(ctx, id.WorkflowName, logicAppResourceName); err != nil {
+		return nil, err
+	}
 	defer locks.UnlockByName(id.WorkflowName, logicAppResourceName)
 
 	result, err := client.TriggersClient.ListCallbackURL(ctx, id)
@@ -275,7 +281,9 @@ func retrieveLogicAppComponent(d *pluginsdk.ResourceData, meta interface{}, kind
 	log.Printf("[DEBUG] Preparing arguments for %s: %s %q", id.ID(), kind, name)
 
 	// lock to prevent against Actions, Parameters or Actions conflicting
-	locks.ByName(id.WorkflowName, logicAppResourceName)
+	if err := locks.ByNameWithContext(ctx, id.WorkflowName, logicAppResourceName); err != nil {
+		return nil, nil, err
+	}
 	defer locks.UnlockByName(id.WorkflowName, logicAppResourceName)
 
 	read, err := client.Get(ctx, id)
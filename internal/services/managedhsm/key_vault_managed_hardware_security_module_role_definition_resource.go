@@ -207,7 +207,9 @@ func (r KeyVaultMHSMRoleDefinitionResource) Create() sdk.ResourceFunc {
 
 			// need a lock for hsm subresource create/update/delete, or API may respond error as below
 			// Status=409 Code="Conflict" Message="There was a conflict while trying to delete the role assignment.
-			locks.ByName(managedHsmId.ID(), "azurerm_key_vault_managed_hardware_security_module")
+			if err := locks.ByNameWithContext(ctx, managedHsmId.ID(), "azurerm_key_vault_managed_hardware_security_module"); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(managedHsmId.ID(), "azurerm_key_vault_managed_hardware_security_module")
 
 			scope := keyvault.RoleScopeGlobal
@@ -295,7 +297,9 @@ func (r KeyVaultMHSMRoleDefinitionResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("unable to determine the Managed HSM ID from the Base URI %q: %+v", id.BaseURI(), err)
 			}
 
-			locks.ByName(managedHsmId.ID(), "azurerm_key_vault_managed_hardware_security_module")
+			if err := locks.ByNameWithContext(ctx, managedHsmId.ID(), "azurerm_key_vault_managed_hardware_security_module"); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(managedHsmId.ID(), "azurerm_key_vault_managed_hardware_security_module")
 
 			result, err := client.Get(ctx, id.BaseURI(), id.Scope, id.RoleDefinitionName)
@@ -356,7 +360,9 @@ func (r KeyVaultMHSMRoleDefinitionResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("unable to determine the Managed HSM ID from the Base URI %q: %+v", id.BaseURI(), err)
 			}
 
-			locks.ByName(managedHsmId.ID(), "azurerm_key_vault_managed_hardware_security_module")
+			if err := locks.ByNameWithContext(ctx, managedHsmId.ID(), "azurerm_key_vault_managed_hardware_security_module"); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(managedHsmId.ID(), "azurerm_key_vault_managed_hardware_security_module")
 
 			var model KeyVaultMHSMRoleDefinitionModel
@@ -416,7 +422,9 @@ func (r KeyVaultMHSMRoleDefinitionResource) Delete() sdk.ResourceFunc {
 				return fmt.Errorf("unable to determine the Managed HSM ID from the Base URI %q: %+v", id.BaseURI(), err)
 			}
 
-			locks.ByName(managedHsmId.ID(), "azurerm_key_vault_managed_hardware_security_module")
+			if err := locks.ByNameWithContext(ctx, managedHsmId.ID(), "azurerm_key_vault_managed_hardware_security_module"); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(managedHsmId.ID(), "azurerm_key_vault_managed_hardware_security_module")
 
 			// TODO: @manicminer: when migrating to go-azure-sdk, the SDK should auto-retry on 409 responses
@@ -45,6 +45,12 @@ func (r Registration) WebsiteCategories() []string {
 	return r.autoRegistration.WebsiteCategories()
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.ManagedIdentity",
+	}
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
@@ -25,6 +25,12 @@ func (r Registration) WebsiteCategories() []string {
 	return []string{"Mongo Cluster"}
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.DocumentDB",
+	}
+}
+
 // DataSources returns a list of Data Sources supported by this Service
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{}
@@ -52,6 +52,14 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.AlertsManagement",
+		"microsoft.insights",
+		"Microsoft.Monitor",
+	}
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	dataSources := map[string]*pluginsdk.Resource{
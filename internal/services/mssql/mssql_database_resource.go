@@ -225,7 +225,9 @@ func resourceMsSqlDatabaseCreate(d *pluginsdk.ResourceData, meta interface{}) er
 	// upgrading those SKUs, we'll try to upgrade the partner databases first.
 
 	// Place a lock for the current database so any partner resources can't bump its SKU out of band
-	locks.ByID(id.ID())
+	if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(id.ID())
 
 	// NOTE: The service default is actually nil/empty which indicates enclave is disabled. the value `Default` is NOT the default.
@@ -249,7 +251,9 @@ func resourceMsSqlDatabaseCreate(d *pluginsdk.ResourceData, meta interface{}) er
 				return fmt.Errorf("parsing ID for Replication Partner Database %q: %+v", *partnerDatabase.Id, err)
 			}
 
-			locks.ByID(partnerDatabaseId.ID())
+			if err := locks.ByIDWithContext(ctx, partnerDatabaseId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(partnerDatabaseId.ID())
 		}
 
@@ -693,7 +697,9 @@ func resourceMsSqlDatabaseUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 	}
 
-	locks.ByID(id.ID())
+	if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(id.ID())
 
 	payload := databases.DatabaseUpdate{}
@@ -844,7 +850,9 @@ func resourceMsSqlDatabaseUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 					return fmt.Errorf("parsing ID for Replication Partner Database %q: %+v", id.ID(), err)
 				}
 
-				locks.ByID(id.ID())
+				if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+					return err
+				}
 				defer locks.UnlockByID(id.ID())
 			}
 
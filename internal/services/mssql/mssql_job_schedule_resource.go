@@ -124,7 +124,9 @@ func (r MsSqlJobScheduleResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(jobId.ID())
+			if err := locks.ByIDWithContext(ctx, jobId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(jobId.ID())
 
 			existing, err := client.Get(ctx, *jobId)
@@ -230,7 +232,9 @@ func (MsSqlJobScheduleResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(jobId.ID())
+			if err := locks.ByIDWithContext(ctx, jobId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(jobId.ID())
 
 			var config MsSqlJobScheduleResourceModel
@@ -296,7 +300,9 @@ func (MsSqlJobScheduleResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(jobId.ID())
+			if err := locks.ByIDWithContext(ctx, jobId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(jobId.ID())
 
 			existing, err := client.Get(ctx, *jobId)
@@ -31,6 +31,20 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Sql",
+	}
+}
+
+func (r Registration) ResourceProvidersByResource() map[string][]string {
+	return map[string][]string{
+		"azurerm_mssql_virtual_machine":                             {"Microsoft.SqlVirtualMachine"},
+		"azurerm_mssql_virtual_machine_availability_group_listener": {"Microsoft.SqlVirtualMachine"},
+		"azurerm_mssql_virtual_machine_group":                       {"Microsoft.SqlVirtualMachine"},
+	}
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
@@ -99,7 +99,9 @@ func (r NetAppAccountEncryptionResource) Create() sdk.ResourceFunc {
 
 			metadata.Logger.Infof("Import check for %s", accountID.ID())
 
-			locks.ByID(accountID.ID())
+			if err := locks.ByIDWithContext(ctx, accountID.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(accountID.ID())
 
 			existing, err := client.AccountsGet(ctx, pointer.From(accountID))
@@ -150,7 +152,9 @@ func (r NetAppAccountEncryptionResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			metadata.Logger.Infof("Decoding state for %s", id)
@@ -257,7 +261,9 @@ func (r NetAppAccountEncryptionResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			metadata.Logger.Infof("Decoding state for %s", id)
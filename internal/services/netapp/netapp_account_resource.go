@@ -173,7 +173,9 @@ func resourceNetAppAccountCreate(d *pluginsdk.ResourceData, meta interface{}) er
 
 	id := netappaccounts.NewNetAppAccountID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	locks.ByID(id.ID())
+	if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(id.ID())
 
 	if d.IsNewResource() {
@@ -236,7 +238,9 @@ func resourceNetAppAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		return err
 	}
 
-	locks.ByID(id.ID())
+	if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(id.ID())
 
 	update := netappaccounts.NetAppAccountPatch{
@@ -337,7 +341,9 @@ func resourceNetAppAccountDelete(d *pluginsdk.ResourceData, meta interface{}) er
 		return err
 	}
 
-	locks.ByID(id.ID())
+	if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(id.ID())
 
 	if err := client.AccountsDeleteThenPoll(ctx, *id); err != nil {
@@ -382,7 +382,9 @@ func resourceNetAppSnapshotPolicyDelete(d *pluginsdk.ResourceData, meta interfac
 					},
 				}
 
-				locks.ByID(volumeId)
+				if err := locks.ByIDWithContext(ctx, volumeId); err != nil {
+					return err
+				}
 
 				if err = volumeClient.UpdateThenPoll(ctx, *volId, update); err != nil {
 					locks.UnlockByID(volumeId)
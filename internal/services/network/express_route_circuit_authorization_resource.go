@@ -71,7 +71,9 @@ func resourceExpressRouteCircuitAuthorizationCreate(d *pluginsdk.ResourceData, m
 
 	id := expressroutecircuitauthorizations.NewAuthorizationID(subscriptionId, d.Get("resource_group_name").(string), d.Get("express_route_circuit_name").(string), d.Get("name").(string))
 
-	locks.ByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_express_route_circuit_authorization "+id.ID(), id.ExpressRouteCircuitName, expressRouteCircuitResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
 
 	if d.IsNewResource() {
@@ -143,7 +145,9 @@ func resourceExpressRouteCircuitAuthorizationDelete(d *pluginsdk.ResourceData, m
 		return err
 	}
 
-	locks.ByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_express_route_circuit_authorization "+id.ID(), id.ExpressRouteCircuitName, expressRouteCircuitResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
@@ -248,7 +248,9 @@ func resourceExpressRouteCircuitPeeringCreate(d *pluginsdk.ResourceData, meta in
 
 	id := commonids.NewExpressRouteCircuitPeeringID(subscriptionId, d.Get("resource_group_name").(string), d.Get("express_route_circuit_name").(string), d.Get("peering_type").(string))
 
-	locks.ByName(id.CircuitName, expressRouteCircuitResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_express_route_circuit_peering "+id.ID(), id.CircuitName, expressRouteCircuitResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.CircuitName, expressRouteCircuitResourceName)
 
 	existing, err := client.Get(ctx, id)
@@ -360,7 +362,9 @@ func resourceExpressRouteCircuitPeeringUpdate(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
-	locks.ByName(id.CircuitName, expressRouteCircuitResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_express_route_circuit_peering "+id.ID(), id.CircuitName, expressRouteCircuitResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.CircuitName, expressRouteCircuitResourceName)
 
 	existing, err := client.Get(ctx, *id)
@@ -543,7 +547,9 @@ func resourceExpressRouteCircuitPeeringDelete(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
-	locks.ByName(id.CircuitName, expressRouteCircuitResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_express_route_circuit_peering "+id.ID(), id.CircuitName, expressRouteCircuitResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.CircuitName, expressRouteCircuitResourceName)
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
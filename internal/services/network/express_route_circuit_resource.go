@@ -178,7 +178,9 @@ func resourceExpressRouteCircuitCreate(d *pluginsdk.ResourceData, meta interface
 
 	id := expressroutecircuits.NewExpressRouteCircuitID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	locks.ByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_express_route_circuit "+id.ID(), id.ExpressRouteCircuitName, expressRouteCircuitResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
 
 	existing, err := client.Get(ctx, id)
@@ -270,7 +272,9 @@ func resourceExpressRouteCircuitUpdate(d *pluginsdk.ResourceData, meta interface
 		return err
 	}
 
-	locks.ByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_express_route_circuit "+id.ID(), id.ExpressRouteCircuitName, expressRouteCircuitResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
 
 	// There is the potential for the express route circuit to become out of sync when the service provider updates
@@ -418,7 +422,9 @@ func resourceExpressRouteCircuitDelete(d *pluginsdk.ResourceData, meta interface
 		return err
 	}
 
-	locks.ByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_express_route_circuit "+id.ID(), id.ExpressRouteCircuitName, expressRouteCircuitResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
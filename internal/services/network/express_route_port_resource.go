@@ -231,7 +231,9 @@ func resourceArmExpressRoutePortCreate(d *pluginsdk.ResourceData, meta interface
 	}
 
 	// a lock is needed here for subresource express_route_port_authorization needs a lock.
-	locks.ByID(id.ID())
+	if err := locks.ByIDForHolder(ctx, "azurerm_express_route_port "+id.ID(), id.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(id.ID())
 
 	// The link properties can't be specified in first creation. It will result into either error (e.g. setting `adminState`) or being ignored (e.g. setting MACSec)
@@ -297,7 +299,9 @@ func resourceArmExpressRoutePortUpdate(d *pluginsdk.ResourceData, meta interface
 	}
 
 	// a lock is needed here for subresource express_route_port_authorization needs a lock.
-	locks.ByID(id.ID())
+	if err := locks.ByIDForHolder(ctx, "azurerm_express_route_port "+id.ID(), id.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(id.ID())
 
 	payload.Properties.Links = expandExpressRoutePortLinks(d.Get("link1").([]interface{}), d.Get("link2").([]interface{}))
@@ -379,7 +383,9 @@ func resourceArmExpressRoutePortDelete(d *pluginsdk.ResourceData, meta interface
 	}
 
 	// a lock is needed here for subresource express_route_port_authorization needs a lock.
-	locks.ByID(id.ID())
+	if err := locks.ByIDForHolder(ctx, "azurerm_express_route_port "+id.ID(), id.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(id.ID())
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
@@ -68,7 +68,9 @@ func resourceIpGroupCidrCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 	}
 	id := parse.NewIpGroupCidrID(ipGroupId.SubscriptionId, ipGroupId.ResourceGroupName, ipGroupId.IpGroupName, cidrName)
 
-	locks.ByID(ipGroupId.ID())
+	if err := locks.ByIDForHolder(ctx, "azurerm_ip_group_cidr "+id.ID(), ipGroupId.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(ipGroupId.ID())
 
 	existing, err := client.Get(ctx, *ipGroupId, ipgroups.DefaultGetOperationOptions())
@@ -164,7 +166,9 @@ func resourceIpGroupCidrDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 	cidr := d.Get("cidr").(string)
 	ipGroupId := ipgroups.NewIPGroupID(id.SubscriptionId, id.ResourceGroup, id.IpGroupName)
 
-	locks.ByID(ipGroupId.ID())
+	if err := locks.ByIDForHolder(ctx, "azurerm_ip_group_cidr "+id.ID(), ipGroupId.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(ipGroupId.ID())
 
 	existing, err := client.Get(ctx, ipGroupId, ipgroups.DefaultGetOperationOptions())
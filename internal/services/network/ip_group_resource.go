@@ -97,27 +97,35 @@ func resourceIpGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
+	id := ipgroups.NewIPGroupID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	holder := "azurerm_ip_group " + id.ID()
+
 	for _, fw := range d.Get("firewall_ids").([]interface{}) {
-		id, err := azurefirewalls.ParseAzureFirewallID(fw.(string))
+		fwID, err := azurefirewalls.ParseAzureFirewallID(fw.(string))
 		if err != nil {
 			return fmt.Errorf("parsing Azure Firewall ID %q: %+v", fw, err)
 		}
-		locks.ByName(id.AzureFirewallName, firewall.AzureFirewallResourceName)
-		defer locks.UnlockByName(id.AzureFirewallName, firewall.AzureFirewallResourceName)
+		if err := locks.ByNameForHolder(ctx, holder, fwID.AzureFirewallName, firewall.AzureFirewallResourceName); err != nil {
+			return err
+		}
+		defer locks.UnlockByName(fwID.AzureFirewallName, firewall.AzureFirewallResourceName)
 	}
 
 	for _, fwpol := range d.Get("firewall_policy_ids").([]interface{}) {
-		id, err := firewallpolicies.ParseFirewallPolicyID(fwpol.(string))
+		polID, err := firewallpolicies.ParseFirewallPolicyID(fwpol.(string))
 		if err != nil {
 			return fmt.Errorf("parsing Azure Firewall Policy ID %q: %+v", fwpol, err)
 		}
-		locks.ByName(id.FirewallPolicyName, firewall.AzureFirewallPolicyResourceName)
-		defer locks.UnlockByName(id.FirewallPolicyName, firewall.AzureFirewallPolicyResourceName)
+		if err := locks.ByNameForHolder(ctx, holder, polID.FirewallPolicyName, firewall.AzureFirewallPolicyResourceName); err != nil {
+			return err
+		}
+		defer locks.UnlockByName(polID.FirewallPolicyName, firewall.AzureFirewallPolicyResourceName)
 	}
 
-	id := ipgroups.NewIPGroupID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
-
-	locks.ByID(id.ID())
+	if err := locks.ByIDForHolder(ctx, holder, id.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(id.ID())
 
 	existing, err := client.Get(ctx, id, ipgroups.DefaultGetOperationOptions())
@@ -217,30 +225,38 @@ func resourceIpGroupUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
+	id, err := ipgroups.ParseIPGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	holder := "azurerm_ip_group " + id.ID()
+
 	for _, fw := range d.Get("firewall_ids").([]interface{}) {
-		id, err := azurefirewalls.ParseAzureFirewallID(fw.(string))
+		fwID, err := azurefirewalls.ParseAzureFirewallID(fw.(string))
 		if err != nil {
 			return fmt.Errorf("parsing Azure Firewall ID %q: %+v", fw, err)
 		}
-		locks.ByName(id.AzureFirewallName, firewall.AzureFirewallResourceName)
-		defer locks.UnlockByName(id.AzureFirewallName, firewall.AzureFirewallResourceName)
+		if err := locks.ByNameForHolder(ctx, holder, fwID.AzureFirewallName, firewall.AzureFirewallResourceName); err != nil {
+			return err
+		}
+		defer locks.UnlockByName(fwID.AzureFirewallName, firewall.AzureFirewallResourceName)
 	}
 
 	for _, fwpol := range d.Get("firewall_policy_ids").([]interface{}) {
-		id, err := firewallpolicies.ParseFirewallPolicyID(fwpol.(string))
+		polID, err := firewallpolicies.ParseFirewallPolicyID(fwpol.(string))
 		if err != nil {
 			return fmt.Errorf("parsing Azure Firewall Policy ID %q: %+v", fwpol, err)
 		}
-		locks.ByName(id.FirewallPolicyName, firewall.AzureFirewallPolicyResourceName)
-		defer locks.UnlockByName(id.FirewallPolicyName, firewall.AzureFirewallPolicyResourceName)
+		if err := locks.ByNameForHolder(ctx, holder, polID.FirewallPolicyName, firewall.AzureFirewallPolicyResourceName); err != nil {
+			return err
+		}
+		defer locks.UnlockByName(polID.FirewallPolicyName, firewall.AzureFirewallPolicyResourceName)
 	}
 
-	id, err := ipgroups.ParseIPGroupID(d.Id())
-	if err != nil {
+	if err := locks.ByIDForHolder(ctx, holder, id.ID()); err != nil {
 		return err
 	}
-
-	locks.ByID(id.ID())
 	defer locks.UnlockByID(id.ID())
 
 	existing, err := client.Get(ctx, *id, ipgroups.DefaultGetOperationOptions())
@@ -305,7 +321,11 @@ func resourceIpGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByID(id.ID())
+	holder := "azurerm_ip_group " + id.ID()
+
+	if err := locks.ByIDForHolder(ctx, holder, id.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(id.ID())
 
 	resp, err := client.Get(ctx, *id, ipgroups.DefaultGetOperationOptions())
@@ -329,7 +349,9 @@ func resourceIpGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		if err != nil {
 			return fmt.Errorf("parsing Azure Firewall ID %q: %+v", pointer.From(fw.Id), err)
 		}
-		locks.ByName(fwID.AzureFirewallName, firewall.AzureFirewallResourceName)
+		if err := locks.ByNameForHolder(ctx, holder, fwID.AzureFirewallName, firewall.AzureFirewallResourceName); err != nil {
+			return err
+		}
 		defer locks.UnlockByName(fwID.AzureFirewallName, firewall.AzureFirewallResourceName)
 	}
 
@@ -338,7 +360,9 @@ func resourceIpGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		if err != nil {
 			return fmt.Errorf("parsing Azure Firewall Policy ID %q: %+v", *fwpol.Id, err)
 		}
-		locks.ByName(polID.FirewallPolicyName, firewall.AzureFirewallPolicyResourceName)
+		if err := locks.ByNameForHolder(ctx, holder, polID.FirewallPolicyName, firewall.AzureFirewallPolicyResourceName); err != nil {
+			return err
+		}
 		defer locks.UnlockByName(polID.FirewallPolicyName, firewall.AzureFirewallPolicyResourceName)
 	}
 
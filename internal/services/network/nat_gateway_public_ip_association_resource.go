@@ -70,7 +70,11 @@ func resourceNATGatewayPublicIpAssociationCreate(d *pluginsdk.ResourceData, meta
 		return err
 	}
 
-	locks.ByName(natGatewayId.NatGatewayName, natGatewayResourceName)
+	id := commonids.NewCompositeResourceID(natGatewayId, publicIpAddressId)
+
+	if err := locks.ByNameForHolder(ctx, "azurerm_nat_gateway_public_ip_association "+id.ID(), natGatewayId.NatGatewayName, natGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(natGatewayId.NatGatewayName, natGatewayResourceName)
 
 	natGateway, err := client.Get(ctx, *natGatewayId, natgateways.DefaultGetOperationOptions())
@@ -88,8 +92,6 @@ func resourceNATGatewayPublicIpAssociationCreate(d *pluginsdk.ResourceData, meta
 		return fmt.Errorf("retrieving %s: `properties` was nil", natGatewayId)
 	}
 
-	id := commonids.NewCompositeResourceID(natGatewayId, publicIpAddressId)
-
 	publicIpAddresses := make([]natgateways.SubResource, 0)
 	if natGateway.Model.Properties.PublicIPAddresses != nil {
 		for _, existingPublicIPAddress := range *natGateway.Model.Properties.PublicIPAddresses {
@@ -183,7 +185,9 @@ func resourceNATGatewayPublicIpAssociationDelete(d *pluginsdk.ResourceData, meta
 		return err
 	}
 
-	locks.ByName(id.First.NatGatewayName, natGatewayResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_nat_gateway_public_ip_association "+id.ID(), id.First.NatGatewayName, natGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.First.NatGatewayName, natGatewayResourceName)
 
 	natGateway, err := client.Get(ctx, *id.First, natgateways.DefaultGetOperationOptions())
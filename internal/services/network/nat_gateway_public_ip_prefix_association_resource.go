@@ -71,7 +71,11 @@ func resourceNATGatewayPublicIpPrefixAssociationCreate(d *pluginsdk.ResourceData
 		return err
 	}
 
-	locks.ByName(natGatewayId.NatGatewayName, natGatewayResourceName)
+	id := commonids.NewCompositeResourceID(natGatewayId, publicIpPrefixId)
+
+	if err := locks.ByNameForHolder(ctx, "azurerm_nat_gateway_public_ip_prefix_association "+id.ID(), natGatewayId.NatGatewayName, natGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(natGatewayId.NatGatewayName, natGatewayResourceName)
 
 	natGateway, err := client.Get(ctx, *natGatewayId, natgateways.DefaultGetOperationOptions())
@@ -89,8 +93,6 @@ func resourceNATGatewayPublicIpPrefixAssociationCreate(d *pluginsdk.ResourceData
 		return fmt.Errorf("retrieving %s: `properties` was nil", natGatewayId)
 	}
 
-	id := commonids.NewCompositeResourceID(natGatewayId, publicIpPrefixId)
-
 	publicIpPrefixes := make([]natgateways.SubResource, 0)
 	if natGateway.Model.Properties.PublicIPPrefixes != nil {
 		for _, existingPublicIPPrefix := range *natGateway.Model.Properties.PublicIPPrefixes {
@@ -184,7 +186,9 @@ func resourceNATGatewayPublicIpPrefixAssociationDelete(d *pluginsdk.ResourceData
 		return err
 	}
 
-	locks.ByName(id.First.NatGatewayName, natGatewayResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_nat_gateway_public_ip_prefix_association "+id.ID(), id.First.NatGatewayName, natGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.First.NatGatewayName, natGatewayResourceName)
 
 	natGateway, err := client.Get(ctx, *id.First, natgateways.DefaultGetOperationOptions())
@@ -101,7 +101,9 @@ func resourceNatGatewayCreate(d *pluginsdk.ResourceData, meta interface{}) error
 
 	id := natgateways.NewNatGatewayID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	locks.ByName(id.NatGatewayName, natGatewayResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_nat_gateway "+id.ID(), id.NatGatewayName, natGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.NatGatewayName, natGatewayResourceName)
 
 	resp, err := client.Get(ctx, id, natgateways.DefaultGetOperationOptions())
@@ -149,7 +151,9 @@ func resourceNatGatewayUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 
-	locks.ByName(id.NatGatewayName, natGatewayResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_nat_gateway "+id.ID(), id.NatGatewayName, natGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.NatGatewayName, natGatewayResourceName)
 
 	existing, err := client.Get(ctx, *id, natgateways.DefaultGetOperationOptions())
@@ -256,7 +260,9 @@ func resourceNatGatewayDelete(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 
-	locks.ByName(id.NatGatewayName, natGatewayResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_nat_gateway "+id.ID(), id.NatGatewayName, natGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.NatGatewayName, natGatewayResourceName)
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
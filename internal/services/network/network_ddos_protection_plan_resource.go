@@ -81,9 +81,15 @@ func resourceNetworkDDoSProtectionPlanCreate(d *pluginsdk.ResourceData, meta int
 
 	id := ddosprotectionplans.NewDdosProtectionPlanID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	locks.ByName(id.DdosProtectionPlanName, ddosProtectionPlanResourceName)
+	holder := "azurerm_network_ddos_protection_plan " + id.ID()
+
+	if err := locks.ByNameForHolder(ctx, holder, id.DdosProtectionPlanName, ddosProtectionPlanResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.DdosProtectionPlanName, ddosProtectionPlanResourceName)
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameForHolder(ctx, holder, vnetsToLock, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
 
 	existing, err := client.Get(ctx, id)
@@ -126,9 +132,15 @@ func resourceNetworkDDoSProtectionPlanUpdate(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
-	locks.ByName(id.DdosProtectionPlanName, ddosProtectionPlanResourceName)
+	holder := "azurerm_network_ddos_protection_plan " + id.ID()
+
+	if err := locks.ByNameForHolder(ctx, holder, id.DdosProtectionPlanName, ddosProtectionPlanResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.DdosProtectionPlanName, ddosProtectionPlanResourceName)
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameForHolder(ctx, holder, vnetsToLock, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
 
 	existing, err := client.Get(ctx, *id)
@@ -227,10 +239,16 @@ func resourceNetworkDDoSProtectionPlanDelete(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("extracting names of Virtual Network: %+v", err)
 	}
 
-	locks.ByName(id.DdosProtectionPlanName, ddosProtectionPlanResourceName)
+	holder := "azurerm_network_ddos_protection_plan " + id.ID()
+
+	if err := locks.ByNameForHolder(ctx, holder, id.DdosProtectionPlanName, ddosProtectionPlanResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.DdosProtectionPlanName, ddosProtectionPlanResourceName)
 
-	locks.MultipleByName(virtualNetworksNamesToLock, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameForHolder(ctx, holder, virtualNetworksNamesToLock, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(virtualNetworksNamesToLock, VirtualNetworkResourceName)
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
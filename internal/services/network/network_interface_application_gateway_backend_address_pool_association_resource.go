@@ -75,7 +75,17 @@ func resourceNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationCrea
 		return err
 	}
 
-	locks.ByName(networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName)
+	ipConfigId := commonids.NewNetworkInterfaceIPConfigurationID(networkInterfaceId.SubscriptionId, networkInterfaceId.ResourceGroupName, networkInterfaceId.NetworkInterfaceName, ipConfigurationName)
+	backendAddressPoolId, err := parse.ApplicationGatewayBackendAddressPoolID(d.Get("backend_address_pool_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := commonids.NewCompositeResourceID(&ipConfigId, backendAddressPoolId)
+
+	if err := locks.ByNameForHolder(ctx, "azurerm_network_interface_application_gateway_backend_address_pool_association "+id.ID(), networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName)
 
 	resp, err := client.Get(ctx, *networkInterfaceId, networkinterfaces.DefaultGetOperationOptions())
@@ -109,14 +119,6 @@ func resourceNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationCrea
 
 	pools := make([]networkinterfaces.ApplicationGatewayBackendAddressPool, 0)
 
-	ipConfigId := commonids.NewNetworkInterfaceIPConfigurationID(networkInterfaceId.SubscriptionId, networkInterfaceId.ResourceGroupName, networkInterfaceId.NetworkInterfaceName, ipConfigurationName)
-	backendAddressPoolId, err := parse.ApplicationGatewayBackendAddressPoolID(d.Get("backend_address_pool_id").(string))
-	if err != nil {
-		return err
-	}
-
-	id := commonids.NewCompositeResourceID(&ipConfigId, backendAddressPoolId)
-
 	// first double-check it doesn't exist
 	if ipConfigProps.ApplicationGatewayBackendAddressPools != nil {
 		for _, existingPool := range *ipConfigProps.ApplicationGatewayBackendAddressPools {
@@ -220,7 +222,9 @@ func resourceNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationDele
 		return err
 	}
 
-	locks.ByName(id.First.NetworkInterfaceName, networkInterfaceResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_network_interface_application_gateway_backend_address_pool_association "+id.ID(), id.First.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.First.NetworkInterfaceName, networkInterfaceResourceName)
 
 	networkInterfaceId := commonids.NewNetworkInterfaceID(id.First.SubscriptionId, id.First.ResourceGroupName, id.First.NetworkInterfaceName)
@@ -76,7 +76,11 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationCreate(d *plugin
 		return err
 	}
 
-	locks.ByName(networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName)
+	id := commonids.NewCompositeResourceID(networkInterfaceId, applicationSecurityGroupId)
+
+	if err := locks.ByNameForHolder(ctx, "azurerm_network_interface_application_security_group_association "+id.ID(), networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName)
 
 	read, err := client.Get(ctx, *networkInterfaceId, networkinterfaces.DefaultGetOperationOptions())
@@ -103,8 +107,6 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationCreate(d *plugin
 	}
 
 	info := parseFieldsFromNetworkInterface(*props)
-	id := commonids.NewCompositeResourceID(networkInterfaceId, applicationSecurityGroupId)
-
 	if utils.SliceContainsValue(info.applicationSecurityGroupIDs, applicationSecurityGroupId.ID()) {
 		return tf.ImportAsExistsError("azurerm_network_interface_application_security_group_association", id.ID())
 	}
@@ -181,7 +183,9 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationDelete(d *plugin
 		return err
 	}
 
-	locks.ByName(id.First.NetworkInterfaceName, networkInterfaceResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_network_interface_application_security_group_association "+id.ID(), id.First.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.First.NetworkInterfaceName, networkInterfaceResourceName)
 
 	read, err := client.Get(ctx, *id.First, networkinterfaces.DefaultGetOperationOptions())
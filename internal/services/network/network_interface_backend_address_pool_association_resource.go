@@ -77,7 +77,11 @@ func resourceNetworkInterfaceBackendAddressPoolAssociationCreate(d *pluginsdk.Re
 	}
 	ipConfigId := commonids.NewNetworkInterfaceIPConfigurationID(networkInterfaceId.SubscriptionId, networkInterfaceId.ResourceGroupName, networkInterfaceId.NetworkInterfaceName, d.Get("ip_configuration_name").(string))
 
-	locks.ByName(networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName)
+	id := commonids.NewCompositeResourceID(&ipConfigId, backendAddressPoolId)
+
+	if err := locks.ByNameForHolder(ctx, "azurerm_network_interface_backend_address_pool_association "+id.ID(), networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName)
 
 	read, err := client.Get(ctx, *networkInterfaceId, networkinterfaces.DefaultGetOperationOptions())
@@ -110,7 +114,6 @@ func resourceNetworkInterfaceBackendAddressPoolAssociationCreate(d *pluginsdk.Re
 		return fmt.Errorf("retrieving %s: `ipConfiguration.properties` was nil", networkInterfaceId)
 	}
 
-	id := commonids.NewCompositeResourceID(&ipConfigId, backendAddressPoolId)
 	pools := make([]networkinterfaces.BackendAddressPool, 0)
 
 	// first double-check it doesn't exist
@@ -217,7 +220,9 @@ func resourceNetworkInterfaceBackendAddressPoolAssociationDelete(d *pluginsdk.Re
 
 	networkInterfaceId := commonids.NewNetworkInterfaceID(id.First.SubscriptionId, id.First.ResourceGroupName, id.First.NetworkInterfaceName)
 
-	locks.ByName(id.First.NetworkInterfaceName, networkInterfaceResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_network_interface_backend_address_pool_association "+id.ID(), id.First.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.First.NetworkInterfaceName, networkInterfaceResourceName)
 
 	read, err := client.Get(ctx, networkInterfaceId, networkinterfaces.DefaultGetOperationOptions())
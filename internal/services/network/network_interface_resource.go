@@ -240,7 +240,11 @@ func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{})
 		EnableAcceleratedNetworking: &enableAcceleratedNetworking,
 	}
 
-	locks.ByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+	holder := "azurerm_network_interface " + id.ID()
+
+	if err := locks.ByNameForHolder(ctx, holder, id.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.NetworkInterfaceName, networkInterfaceResourceName)
 
 	if auxiliaryMode, hasAuxiliaryMode := d.GetOk("auxiliary_mode"); hasAuxiliaryMode {
@@ -279,7 +283,9 @@ func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("determining locking details: %+v", err)
 	}
 
-	lockingDetails.lock()
+	if err := lockingDetails.lock(ctx, holder); err != nil {
+		return err
+	}
 	defer lockingDetails.unlock()
 
 	if len(*ipConfigs) > 0 {
@@ -313,7 +319,11 @@ func resourceNetworkInterfaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
-	locks.ByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+	holder := "azurerm_network_interface " + id.ID()
+
+	if err := locks.ByNameForHolder(ctx, holder, id.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.NetworkInterfaceName, networkInterfaceResourceName)
 
 	// first get the existing one so that we can pull things as needed
@@ -394,7 +404,9 @@ func resourceNetworkInterfaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("determining locking details: %+v", err)
 		}
 
-		lockingDetails.lock()
+		if err := lockingDetails.lock(ctx, holder); err != nil {
+			return err
+		}
 		defer lockingDetails.unlock()
 
 		// then map the fields managed in other resources back
@@ -553,7 +565,11 @@ func resourceNetworkInterfaceDelete(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
-	locks.ByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+	holder := "azurerm_network_interface " + id.ID()
+
+	if err := locks.ByNameForHolder(ctx, holder, id.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.NetworkInterfaceName, networkInterfaceResourceName)
 
 	existing, err := client.Get(ctx, *id, networkinterfaces.DefaultGetOperationOptions())
@@ -582,7 +598,9 @@ func resourceNetworkInterfaceDelete(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("determining locking details: %+v", err)
 	}
 
-	lockingDetails.lock()
+	if err := lockingDetails.lock(ctx, holder); err != nil {
+		return err
+	}
 	defer lockingDetails.unlock()
 
 	err = client.DeleteThenPoll(ctx, *id)
@@ -64,15 +64,23 @@ func resourceNetworkInterfaceSecurityGroupAssociationCreate(d *pluginsdk.Resourc
 		return err
 	}
 
-	locks.ByName(nicId.NetworkInterfaceName, networkInterfaceResourceName)
-	defer locks.UnlockByName(nicId.NetworkInterfaceName, networkInterfaceResourceName)
-
 	nsgId, err := networksecuritygroups.ParseNetworkSecurityGroupID(d.Get("network_security_group_id").(string))
 	if err != nil {
 		return err
 	}
 
-	locks.ByName(nsgId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+	id := commonids.NewCompositeResourceID(nicId, nsgId)
+
+	holder := "azurerm_network_interface_security_group_association " + id.ID()
+
+	if err := locks.ByNameForHolder(ctx, holder, nicId.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(nicId.NetworkInterfaceName, networkInterfaceResourceName)
+
+	if err := locks.ByNameForHolder(ctx, holder, nsgId.NetworkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(nsgId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
 
 	read, err := client.Get(ctx, *nicId, networkinterfaces.DefaultGetOperationOptions())
@@ -90,8 +98,6 @@ func resourceNetworkInterfaceSecurityGroupAssociationCreate(d *pluginsdk.Resourc
 		return fmt.Errorf("retrieving %s: `properties` was nil", nicId)
 	}
 
-	id := commonids.NewCompositeResourceID(nicId, nsgId)
-
 	if read.Model.Properties.NetworkSecurityGroup != nil {
 		return tf.ImportAsExistsError("azurerm_network_interface_security_group_association", id.ID())
 	}
@@ -156,7 +162,9 @@ func resourceNetworkInterfaceSecurityGroupAssociationDelete(d *pluginsdk.Resourc
 		return err
 	}
 
-	locks.ByName(id.First.NetworkInterfaceName, networkInterfaceResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_network_interface_security_group_association "+id.ID(), id.First.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.First.NetworkInterfaceName, networkInterfaceResourceName)
 
 	read, err := client.Get(ctx, *id.First, networkinterfaces.DefaultGetOperationOptions())
@@ -110,7 +110,9 @@ func (r ManagerDeploymentResource) Create() sdk.ResourceFunc {
 			normalizedLocation := azure.NormalizeLocation(state.Location)
 			id := parse.NewNetworkManagerDeploymentID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroupName, networkManagerId.NetworkManagerName, normalizedLocation, state.ScopeAccess)
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDForHolder(ctx, r.ResourceType()+" "+id.ID(), id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			metadata.Logger.Infof("creating %s", *id)
@@ -219,7 +221,9 @@ func (r ManagerDeploymentResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDForHolder(ctx, r.ResourceType()+" "+id.ID(), id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			metadata.Logger.Infof("updating %s..", *id)
@@ -302,7 +306,9 @@ func (r ManagerDeploymentResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDForHolder(ctx, r.ResourceType()+" "+id.ID(), id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			metadata.Logger.Infof("deleting %s..", *id)
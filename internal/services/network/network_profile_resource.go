@@ -131,13 +131,21 @@ func resourceNetworkProfileCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("extracting names of Subnet and Virtual Network: %+v", err)
 	}
 
-	locks.ByName(id.NetworkProfileName, azureNetworkProfileResourceName)
+	holder := "azurerm_network_profile " + id.ID()
+
+	if err := locks.ByNameForHolder(ctx, holder, id.NetworkProfileName, azureNetworkProfileResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.NetworkProfileName, azureNetworkProfileResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameForHolder(ctx, holder, vnetsToLock, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
 
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
+	if err := locks.MultipleByNameForHolder(ctx, holder, subnetsToLock, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
 
 	payload := networkprofiles.NetworkProfile{
@@ -187,13 +195,21 @@ func resourceNetworkProfileUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("extracting names of Subnet and Virtual Network: %+v", err)
 	}
 
-	locks.ByName(id.NetworkProfileName, azureNetworkProfileResourceName)
+	holder := "azurerm_network_profile " + id.ID()
+
+	if err := locks.ByNameForHolder(ctx, holder, id.NetworkProfileName, azureNetworkProfileResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.NetworkProfileName, azureNetworkProfileResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameForHolder(ctx, holder, vnetsToLock, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
 
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
+	if err := locks.MultipleByNameForHolder(ctx, holder, subnetsToLock, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
 
 	if d.HasChange("container_network_interface") {
@@ -283,13 +299,21 @@ func resourceNetworkProfileDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("extracting names of Subnet and Virtual Network: %+v", err)
 	}
 
-	locks.ByName(id.NetworkProfileName, azureNetworkProfileResourceName)
+	holder := "azurerm_network_profile " + id.ID()
+
+	if err := locks.ByNameForHolder(ctx, holder, id.NetworkProfileName, azureNetworkProfileResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.NetworkProfileName, azureNetworkProfileResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameForHolder(ctx, holder, vnetsToLock, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
 
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
+	if err := locks.MultipleByNameForHolder(ctx, holder, subnetsToLock, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
@@ -244,7 +244,9 @@ func resourceNetworkWatcherFlowLogCreate(d *pluginsdk.ResourceData, meta interfa
 		return tf.ImportAsExistsError("azurerm_network_watcher_flow_log", id.ID())
 	}
 
-	locks.ByID(targetResourceId)
+	if err := locks.ByIDForHolder(ctx, "azurerm_network_watcher_flow_log "+id.ID(), targetResourceId); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(targetResourceId)
 
 	loc := d.Get("location").(string)
@@ -329,7 +331,9 @@ func resourceNetworkWatcherFlowLogUpdate(d *pluginsdk.ResourceData, meta interfa
 		targetResourceId = v.(string)
 	}
 
-	locks.ByID(targetResourceId)
+	if err := locks.ByIDForHolder(ctx, "azurerm_network_watcher_flow_log "+id.ID(), targetResourceId); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(targetResourceId)
 
 	if d.HasChange("storage_account_id") {
@@ -472,7 +476,9 @@ func resourceNetworkWatcherFlowLogDelete(d *pluginsdk.ResourceData, meta interfa
 		targetResourceId = vnetId.ID()
 	}
 
-	locks.ByID(targetResourceId)
+	if err := locks.ByIDForHolder(ctx, "azurerm_network_watcher_flow_log "+id.ID(), targetResourceId); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(targetResourceId)
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
@@ -70,7 +70,9 @@ func (p PrivateEndpointApplicationSecurityGroupAssociationResource) Create() sdk
 				return err
 			}
 
-			locks.ByName(privateEndpointId.PrivateEndpointName, "azurerm_private_endpoint")
+			if err := locks.ByNameWithContext(ctx, privateEndpointId.PrivateEndpointName, "azurerm_private_endpoint"); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(privateEndpointId.PrivateEndpointName, "azurerm_private_endpoint")
 
 			ASGClient := metadata.Client.Network.ApplicationSecurityGroups
@@ -79,7 +81,9 @@ func (p PrivateEndpointApplicationSecurityGroupAssociationResource) Create() sdk
 				return err
 			}
 
-			locks.ByName(ASGId.ApplicationSecurityGroupName, "azurerm_application_security_group")
+			if err := locks.ByNameWithContext(ctx, ASGId.ApplicationSecurityGroupName, "azurerm_application_security_group"); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(ASGId.ApplicationSecurityGroupName, "azurerm_application_security_group")
 
 			existingPrivateEndpoint, err := privateEndpointClient.Get(ctx, *privateEndpointId, privateendpoints.DefaultGetOperationOptions())
@@ -167,7 +171,9 @@ func (p PrivateEndpointApplicationSecurityGroupAssociationResource) Read() sdk.R
 				return err
 			}
 
-			locks.ByName(privateEndpointId.PrivateEndpointName, "azurerm_private_endpoint")
+			if err := locks.ByNameWithContext(ctx, privateEndpointId.PrivateEndpointName, "azurerm_private_endpoint"); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(privateEndpointId.PrivateEndpointName, "azurerm_private_endpoint")
 
 			ASGClient := metadata.Client.Network.ApplicationSecurityGroups
@@ -177,7 +183,9 @@ func (p PrivateEndpointApplicationSecurityGroupAssociationResource) Read() sdk.R
 				return err
 			}
 
-			locks.ByName(ASGId.ApplicationSecurityGroupName, "azurerm_application_security_group")
+			if err := locks.ByNameWithContext(ctx, ASGId.ApplicationSecurityGroupName, "azurerm_application_security_group"); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(ASGId.ApplicationSecurityGroupName, "azurerm_application_security_group")
 
 			existingPrivateEndpoint, err := privateEndpointClient.Get(ctx, *privateEndpointId, privateendpoints.DefaultGetOperationOptions())
@@ -247,7 +255,9 @@ func (p PrivateEndpointApplicationSecurityGroupAssociationResource) Delete() sdk
 				return err
 			}
 
-			locks.ByName(privateEndpointId.PrivateEndpointName, "azurerm_private_endpoint")
+			if err := locks.ByNameWithContext(ctx, privateEndpointId.PrivateEndpointName, "azurerm_private_endpoint"); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(privateEndpointId.PrivateEndpointName, "azurerm_private_endpoint")
 
 			ASGClient := metadata.Client.Network.ApplicationSecurityGroups
@@ -257,7 +267,9 @@ func (p PrivateEndpointApplicationSecurityGroupAssociationResource) Delete() sdk
 				return err
 			}
 
-			locks.ByName(ASGId.ApplicationSecurityGroupName, "azurerm_application_security_group")
+			if err := locks.ByNameWithContext(ctx, ASGId.ApplicationSecurityGroupName, "azurerm_application_security_group"); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(ASGId.ApplicationSecurityGroupName, "azurerm_application_security_group")
 
 			existingPrivateEndpoint, err := privateEndpointClient.Get(ctx, *privateEndpointId, privateendpoints.DefaultGetOperationOptions())
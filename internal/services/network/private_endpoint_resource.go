@@ -347,7 +347,9 @@ func resourcePrivateEndpointCreate(d *pluginsdk.ResourceData, meta interface{})
 	cosmosDbResIds := getCosmosDbResIdInPrivateServiceConnections(parameters.Properties)
 	for _, cosmosDbResId := range cosmosDbResIds {
 		log.Printf("[DEBUG] Add Lock For Private Endpoint %q, lock name: %q", id.PrivateEndpointName, cosmosDbResId)
-		locks.ByName(cosmosDbResId, "azurerm_private_endpoint")
+		if err := locks.ByNameForHolder(ctx, "azurerm_private_endpoint "+id.ID(), cosmosDbResId, "azurerm_private_endpoint"); err != nil {
+			return err
+		}
 		//goland:noinspection GoDeferInLoop
 		defer locks.UnlockByName(cosmosDbResId, "azurerm_private_endpoint")
 	}
@@ -745,7 +747,9 @@ func resourcePrivateEndpointDelete(d *pluginsdk.ResourceData, meta interface{})
 
 	cosmosDbResIds := getCosmosDbResIdInPrivateServiceConnections(existing.Model.Properties)
 	for _, cosmosDbResId := range cosmosDbResIds {
-		locks.ByName(cosmosDbResId, "azurerm_private_endpoint")
+		if err := locks.ByNameForHolder(ctx, "azurerm_private_endpoint "+id.ID(), cosmosDbResId, "azurerm_private_endpoint"); err != nil {
+			return err
+		}
 		//goland:noinspection GoDeferInLoop
 		defer locks.UnlockByName(cosmosDbResId, "azurerm_private_endpoint")
 	}
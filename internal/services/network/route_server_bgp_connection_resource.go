@@ -83,11 +83,13 @@ func resourceRouteServerBgpConnectionCreate(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
-	locks.ByName(routerServerId.VirtualHubName, "azurerm_route_server")
-	defer locks.UnlockByName(routerServerId.VirtualHubName, "azurerm_route_server")
-
 	id := commonids.NewVirtualHubBGPConnectionID(routerServerId.SubscriptionId, routerServerId.ResourceGroupName, routerServerId.VirtualHubName, d.Get("name").(string))
 
+	if err := locks.ByNameForHolder(ctx, "azurerm_route_server_bgp_connection "+id.ID(), routerServerId.VirtualHubName, "azurerm_route_server"); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(routerServerId.VirtualHubName, "azurerm_route_server")
+
 	if d.IsNewResource() {
 		existing, err := client.VirtualHubBgpConnectionGet(ctx, id)
 		if err != nil {
@@ -129,7 +129,9 @@ func resourceRouteServerCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	id := virtualwans.NewVirtualHubID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	locks.ByName(id.VirtualHubName, "azurerm_route_server")
+	if err := locks.ByNameForHolder(ctx, "azurerm_route_server "+id.ID(), id.VirtualHubName, "azurerm_route_server"); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualHubName, "azurerm_route_server")
 
 	existing, err := client.VirtualHubsGet(ctx, id)
@@ -204,7 +206,9 @@ func resourceRouteServerUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	locks.ByName(id.VirtualHubName, "azurerm_route_server")
+	if err := locks.ByNameForHolder(ctx, "azurerm_route_server "+id.ID(), id.VirtualHubName, "azurerm_route_server"); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualHubName, "azurerm_route_server")
 
 	existing, err := client.VirtualHubsGet(ctx, *id)
@@ -71,11 +71,19 @@ func resourceSubnetNatGatewayAssociationCreate(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(gatewayId.NatGatewayName, natGatewayResourceName)
+	holder := "azurerm_subnet_nat_gateway_association " + subnetId.ID()
+
+	if err := locks.ByNameForHolder(ctx, holder, gatewayId.NatGatewayName, natGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(gatewayId.NatGatewayName, natGatewayResourceName)
-	locks.ByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameForHolder(ctx, holder, subnetId.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
-	locks.ByName(subnetId.SubnetName, SubnetResourceName)
+	if err := locks.ByNameForHolder(ctx, holder, subnetId.SubnetName, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(subnetId.SubnetName, SubnetResourceName)
 
 	subnet, err := client.Get(ctx, *subnetId, subnets.DefaultGetOperationOptions())
@@ -216,9 +224,15 @@ func resourceSubnetNatGatewayAssociationDelete(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(gatewayId.NatGatewayName, natGatewayResourceName)
+	holder := "azurerm_subnet_nat_gateway_association " + id.ID()
+
+	if err := locks.ByNameForHolder(ctx, holder, gatewayId.NatGatewayName, natGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(gatewayId.NatGatewayName, natGatewayResourceName)
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameForHolder(ctx, holder, id.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
 	subnet, err = client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
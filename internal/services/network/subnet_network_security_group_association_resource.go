@@ -71,13 +71,21 @@ func resourceSubnetNetworkSecurityGroupAssociationCreate(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByName(networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+	holder := "azurerm_subnet_network_security_group_association " + subnetId.ID()
+
+	if err := locks.ByNameForHolder(ctx, holder, networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
 
-	locks.ByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameForHolder(ctx, holder, subnetId.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
 
-	locks.ByName(subnetId.SubnetName, SubnetResourceName)
+	if err := locks.ByNameForHolder(ctx, holder, subnetId.SubnetName, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(subnetId.SubnetName, SubnetResourceName)
 
 	subnet, err := client.Get(ctx, *subnetId, subnets.DefaultGetOperationOptions())
@@ -223,13 +231,21 @@ func resourceSubnetNetworkSecurityGroupAssociationDelete(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByName(networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+	holder := "azurerm_subnet_network_security_group_association " + id.ID()
+
+	if err := locks.ByNameForHolder(ctx, holder, networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameForHolder(ctx, holder, id.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
-	locks.ByName(id.SubnetName, SubnetResourceName)
+	if err := locks.ByNameForHolder(ctx, holder, id.SubnetName, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.SubnetName, SubnetResourceName)
 
 	// then re-retrieve it to ensure we've got the latest state
@@ -297,7 +297,9 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		return tf.ImportAsExistsError("azurerm_subnet", id.ID())
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_subnet "+id.ID(), id.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
 	properties := subnets.SubnetPropertiesFormat{}
@@ -389,10 +391,16 @@ func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	holder := "azurerm_subnet " + id.ID()
+
+	if err := locks.ByNameForHolder(ctx, holder, id.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
-	locks.ByName(id.SubnetName, SubnetResourceName)
+	if err := locks.ByNameForHolder(ctx, holder, id.SubnetName, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.SubnetName, SubnetResourceName)
 
 	existing, err := client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
@@ -605,10 +613,16 @@ func resourceSubnetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	holder := "azurerm_subnet " + id.ID()
+
+	if err := locks.ByNameForHolder(ctx, holder, id.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
-	locks.ByName(id.SubnetName, SubnetResourceName)
+	if err := locks.ByNameForHolder(ctx, holder, id.SubnetName, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.SubnetName, SubnetResourceName)
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
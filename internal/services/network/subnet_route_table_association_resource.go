@@ -73,10 +73,16 @@ func resourceSubnetRouteTableAssociationCreate(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(routeTableId.RouteTableName, routeTableResourceName)
+	holder := "azurerm_subnet_route_table_association " + id.ID()
+
+	if err := locks.ByNameForHolder(ctx, holder, routeTableId.RouteTableName, routeTableResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(routeTableId.RouteTableName, routeTableResourceName)
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameForHolder(ctx, holder, id.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
 	subnet, err := client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
@@ -222,10 +228,16 @@ func resourceSubnetRouteTableAssociationDelete(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(parsedRouteTableId.RouteTableName, routeTableResourceName)
+	holder := "azurerm_subnet_route_table_association " + id.ID()
+
+	if err := locks.ByNameForHolder(ctx, holder, parsedRouteTableId.RouteTableName, routeTableResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(parsedRouteTableId.RouteTableName, routeTableResourceName)
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameForHolder(ctx, holder, id.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
 	// then re-retrieve it to ensure we've got the latest state
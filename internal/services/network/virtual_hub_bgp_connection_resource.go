@@ -91,11 +91,13 @@ func resourceVirtualHubBgpConnectionCreate(d *pluginsdk.ResourceData, meta inter
 		return err
 	}
 
-	locks.ByName(virtHubId.VirtualHubName, virtualHubResourceName)
-	defer locks.UnlockByName(virtHubId.VirtualHubName, virtualHubResourceName)
-
 	id := commonids.NewVirtualHubBGPConnectionID(virtHubId.SubscriptionId, virtHubId.ResourceGroupName, virtHubId.VirtualHubName, d.Get("name").(string))
 
+	if err := locks.ByNameForHolder(ctx, "azurerm_virtual_hub_bgp_connection "+id.ID(), virtHubId.VirtualHubName, virtualHubResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(virtHubId.VirtualHubName, virtualHubResourceName)
+
 	if d.IsNewResource() {
 		existing, err := client.VirtualHubBgpConnectionGet(ctx, id)
 		if err != nil {
@@ -142,14 +144,16 @@ func resourceVirtualHubBgpConnectionUpdate(d *pluginsdk.ResourceData, meta inter
 		return err
 	}
 
-	locks.ByName(virtHubId.VirtualHubName, virtualHubResourceName)
-	defer locks.UnlockByName(virtHubId.VirtualHubName, virtualHubResourceName)
-
 	id, err := commonids.ParseVirtualHubBGPConnectionID(d.Id())
 	if err != nil {
 		return err
 	}
 
+	if err := locks.ByNameForHolder(ctx, "azurerm_virtual_hub_bgp_connection "+id.ID(), virtHubId.VirtualHubName, virtualHubResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(virtHubId.VirtualHubName, virtualHubResourceName)
+
 	existing, err := client.VirtualHubBgpConnectionGet(ctx, *id)
 	if err != nil {
 		if !response.WasNotFound(existing.HttpResponse) {
@@ -232,7 +236,9 @@ func resourceVirtualHubBgpConnectionDelete(d *pluginsdk.ResourceData, meta inter
 		return err
 	}
 
-	locks.ByName(id.HubName, virtualHubResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_virtual_hub_bgp_connection "+id.ID(), id.HubName, virtualHubResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.HubName, virtualHubResourceName)
 
 	if err := client.VirtualHubBgpConnectionDeleteThenPoll(ctx, *id); err != nil {
@@ -200,7 +200,11 @@ func resourceVirtualHubConnectionCreateOrUpdate(d *pluginsdk.ResourceData, meta
 
 	id := virtualwans.NewHubVirtualNetworkConnectionID(virtualHubId.SubscriptionId, virtualHubId.ResourceGroupName, virtualHubId.VirtualHubName, d.Get("name").(string))
 
-	locks.ByName(virtualHubId.VirtualHubName, virtualHubResourceName)
+	holder := "azurerm_virtual_hub_connection " + id.ID()
+
+	if err := locks.ByNameForHolder(ctx, holder, virtualHubId.VirtualHubName, virtualHubResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(virtualHubId.VirtualHubName, virtualHubResourceName)
 
 	remoteVirtualNetworkId, err := commonids.ParseVirtualNetworkID(d.Get("remote_virtual_network_id").(string))
@@ -208,7 +212,9 @@ func resourceVirtualHubConnectionCreateOrUpdate(d *pluginsdk.ResourceData, meta
 		return err
 	}
 
-	locks.ByName(remoteVirtualNetworkId.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameForHolder(ctx, holder, remoteVirtualNetworkId.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(remoteVirtualNetworkId.VirtualNetworkName, VirtualNetworkResourceName)
 
 	if d.IsNewResource() {
@@ -310,7 +316,9 @@ func resourceVirtualHubConnectionDelete(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
-	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_virtual_hub_connection "+id.ID(), id.VirtualHubName, virtualHubResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
 
 	if err := client.HubVirtualNetworkConnectionsDeleteThenPoll(ctx, *id); err != nil {
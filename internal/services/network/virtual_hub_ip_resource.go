@@ -101,11 +101,13 @@ func resourceVirtualHubIPCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		return err
 	}
 
-	locks.ByName(virtualHubId.VirtualHubName, virtualHubResourceName)
-	defer locks.UnlockByName(virtualHubId.VirtualHubName, virtualHubResourceName)
-
 	id := commonids.NewVirtualHubIPConfigurationID(virtualHubId.SubscriptionId, virtualHubId.ResourceGroupName, virtualHubId.VirtualHubName, d.Get("name").(string))
 
+	if err := locks.ByNameForHolder(ctx, "azurerm_virtual_hub_ip "+id.ID(), virtualHubId.VirtualHubName, virtualHubResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(virtualHubId.VirtualHubName, virtualHubResourceName)
+
 	existing, err := client.VirtualHubIPConfigurationGet(ctx, id)
 	if err != nil {
 		if !response.WasNotFound(existing.HttpResponse) {
@@ -158,14 +160,16 @@ func resourceVirtualHubIPUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		return err
 	}
 
-	locks.ByName(virtualHubId.VirtualHubName, virtualHubResourceName)
-	defer locks.UnlockByName(virtualHubId.VirtualHubName, virtualHubResourceName)
-
 	id, err := commonids.ParseVirtualHubIPConfigurationID(d.Id())
 	if err != nil {
 		return err
 	}
 
+	if err := locks.ByNameForHolder(ctx, "azurerm_virtual_hub_ip "+id.ID(), virtualHubId.VirtualHubName, virtualHubResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(virtualHubId.VirtualHubName, virtualHubResourceName)
+
 	existing, err := client.VirtualHubIPConfigurationGet(ctx, *id)
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", id, err)
@@ -248,7 +252,9 @@ func resourceVirtualHubIPDelete(d *pluginsdk.ResourceData, meta interface{}) err
 		return err
 	}
 
-	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_virtual_hub_ip "+id.ID(), id.VirtualHubName, virtualHubResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
 
 	if err := client.VirtualHubIPConfigurationDeleteThenPoll(ctx, *id); err != nil {
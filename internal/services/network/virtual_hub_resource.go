@@ -167,7 +167,9 @@ func resourceVirtualHubCreate(d *pluginsdk.ResourceData, meta interface{}) error
 
 	id := virtualwans.NewVirtualHubID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_virtual_hub "+id.ID(), id.VirtualHubName, virtualHubResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
 
 	existing, err := client.VirtualHubsGet(ctx, id)
@@ -252,7 +254,9 @@ func resourceVirtualHubUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 
-	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_virtual_hub "+id.ID(), id.VirtualHubName, virtualHubResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
 
 	existing, err := client.VirtualHubsGet(ctx, *id)
@@ -398,7 +402,9 @@ func resourceVirtualHubDelete(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 
-	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_virtual_hub "+id.ID(), id.VirtualHubName, virtualHubResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
 
 	if err := client.VirtualHubsDeleteThenPoll(ctx, *id); err != nil {
@@ -130,11 +130,13 @@ func resourceVirtualHubRouteTableCreate(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
-	locks.ByName(virtHubId.VirtualHubName, virtualHubResourceName)
-	defer locks.UnlockByName(virtHubId.VirtualHubName, virtualHubResourceName)
-
 	id := virtualwans.NewHubRouteTableID(virtHubId.SubscriptionId, virtHubId.ResourceGroupName, virtHubId.VirtualHubName, d.Get("name").(string))
 
+	if err := locks.ByNameForHolder(ctx, "azurerm_virtual_hub_route_table "+id.ID(), virtHubId.VirtualHubName, virtualHubResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(virtHubId.VirtualHubName, virtualHubResourceName)
+
 	existing, err := client.HubRouteTablesGet(ctx, id)
 	if err != nil {
 		if !response.WasNotFound(existing.HttpResponse) {
@@ -173,14 +175,16 @@ func resourceVirtualHubRouteTableUpdate(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
-	locks.ByName(virtHubId.VirtualHubName, virtualHubResourceName)
-	defer locks.UnlockByName(virtHubId.VirtualHubName, virtualHubResourceName)
-
 	id, err := virtualwans.ParseHubRouteTableID(d.Id())
 	if err != nil {
 		return err
 	}
 
+	if err := locks.ByNameForHolder(ctx, "azurerm_virtual_hub_route_table "+id.ID(), virtHubId.VirtualHubName, virtualHubResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(virtHubId.VirtualHubName, virtualHubResourceName)
+
 	existing, err := client.HubRouteTablesGet(ctx, *id)
 	if err != nil {
 		if !response.WasNotFound(existing.HttpResponse) {
@@ -260,7 +264,9 @@ func resourceVirtualHubRouteTableDelete(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
-	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_virtual_hub_route_table "+id.ID(), id.VirtualHubName, virtualHubResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
 
 	if err := client.HubRouteTablesDeleteThenPoll(ctx, *id); err != nil {
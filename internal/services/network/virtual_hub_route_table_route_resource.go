@@ -103,7 +103,12 @@ func resourceVirtualHubRouteTableRouteCreate(d *pluginsdk.ResourceData, meta int
 		return err
 	}
 
-	locks.ByName(routeTableId.VirtualHubName, virtualHubResourceName)
+	name := d.Get("name").(string)
+	id := parse.NewHubRouteTableRouteID(routeTableId.SubscriptionId, routeTableId.ResourceGroupName, routeTableId.VirtualHubName, routeTableId.HubRouteTableName, name)
+
+	if err := locks.ByNameForHolder(ctx, "azurerm_virtual_hub_route_table_route "+id.ID(), routeTableId.VirtualHubName, virtualHubResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(routeTableId.VirtualHubName, virtualHubResourceName)
 
 	routeTable, err := client.HubRouteTablesGet(ctx, *routeTableId)
@@ -123,9 +128,6 @@ func resourceVirtualHubRouteTableRouteCreate(d *pluginsdk.ResourceData, meta int
 
 	props := routeTable.Model.Properties
 
-	name := d.Get("name").(string)
-	id := parse.NewHubRouteTableRouteID(routeTableId.SubscriptionId, routeTableId.ResourceGroupName, routeTableId.VirtualHubName, routeTableId.HubRouteTableName, name)
-
 	routes := make([]virtualwans.HubRoute, 0)
 	if hubRoutes := props.Routes; hubRoutes != nil {
 		for _, r := range *hubRoutes {
@@ -167,7 +169,14 @@ func resourceVirtualHubRouteTableRouteUpdate(d *pluginsdk.ResourceData, meta int
 		return err
 	}
 
-	locks.ByName(routeTableId.VirtualHubName, virtualHubResourceName)
+	id, err := parse.HubRouteTableRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := locks.ByNameForHolder(ctx, "azurerm_virtual_hub_route_table_route "+id.ID(), routeTableId.VirtualHubName, virtualHubResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(routeTableId.VirtualHubName, virtualHubResourceName)
 
 	routeTable, err := client.HubRouteTablesGet(ctx, *routeTableId)
@@ -184,11 +193,6 @@ func resourceVirtualHubRouteTableRouteUpdate(d *pluginsdk.ResourceData, meta int
 
 	props := routeTable.Model.Properties
 
-	id, err := parse.HubRouteTableRouteID(d.Id())
-	if err != nil {
-		return err
-	}
-
 	routes := *props.Routes
 	for i := range routes {
 		if routes[i].Name == id.RouteName {
@@ -283,7 +287,9 @@ func resourceVirtualHubRouteTableRouteDelete(d *pluginsdk.ResourceData, meta int
 
 	routeTableId := virtualwans.NewHubRouteTableID(id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.HubRouteTableName)
 
-	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_virtual_hub_route_table_route "+id.ID(), id.VirtualHubName, virtualHubResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
 
 	// get latest list of routes
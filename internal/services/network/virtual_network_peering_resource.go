@@ -173,7 +173,9 @@ func resourceVirtualNetworkPeeringCreate(d *pluginsdk.ResourceData, meta interfa
 		peer.Properties.RemoteSubnetNames = utils.ExpandStringSlice(v.([]interface{}))
 	}
 
-	locks.ByID(virtualNetworkPeeringResourceType)
+	if err := locks.ByIDForHolder(ctx, "azurerm_virtual_network_peering "+id.ID(), virtualNetworkPeeringResourceType); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(virtualNetworkPeeringResourceType)
 
 	deadline, ok := ctx.Deadline()
@@ -226,7 +228,9 @@ func resourceVirtualNetworkPeeringUpdate(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
-	locks.ByID(virtualNetworkPeeringResourceType)
+	if err := locks.ByIDForHolder(ctx, "azurerm_virtual_network_peering "+id.ID(), virtualNetworkPeeringResourceType); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(virtualNetworkPeeringResourceType)
 
 	existing, err := client.Get(ctx, *id)
@@ -328,7 +332,9 @@ func resourceVirtualNetworkPeeringDelete(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
-	locks.ByID(virtualNetworkPeeringResourceType)
+	if err := locks.ByIDForHolder(ctx, "azurerm_virtual_network_peering "+id.ID(), virtualNetworkPeeringResourceType); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(virtualNetworkPeeringResourceType)
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
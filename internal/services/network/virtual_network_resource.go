@@ -355,7 +355,11 @@ func resourceVirtualNetworkCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		return err
 	}
 
-	locks.MultipleByName(routeTables, routeTableResourceName)
+	holder := "azurerm_virtual_network " + id.ID()
+
+	if err := locks.MultipleByNameForHolder(ctx, holder, routeTables, routeTableResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(routeTables, routeTableResourceName)
 
 	vnet := virtualnetworks.VirtualNetwork{
@@ -385,7 +389,9 @@ func resourceVirtualNetworkCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	locks.MultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
+	if err := locks.MultipleByNameForHolder(ctx, holder, &networkSecurityGroupNames, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
 	if err := client.CreateOrUpdateThenPoll(ctx, id, vnet); err != nil {
@@ -500,6 +506,8 @@ func resourceVirtualNetworkUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		return err
 	}
 
+	holder := "azurerm_virtual_network " + id.ID()
+
 	existing, err := client.Get(ctx, *id, virtualnetworks.DefaultGetOperationOptions())
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", id, err)
@@ -591,7 +599,9 @@ func resourceVirtualNetworkUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 		payload.Properties.Subnets = subnets
 
-		locks.MultipleByName(routeTables, routeTableResourceName)
+		if err := locks.MultipleByNameForHolder(ctx, holder, routeTables, routeTableResourceName); err != nil {
+			return err
+		}
 		defer locks.UnlockMultipleByName(routeTables, routeTableResourceName)
 	}
 
@@ -620,7 +630,9 @@ func resourceVirtualNetworkUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	locks.MultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
+	if err := locks.MultipleByNameForHolder(ctx, holder, &networkSecurityGroupNames, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
 	if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
@@ -658,10 +670,16 @@ func resourceVirtualNetworkDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("parsing Network Security Group ID's: %+v", err)
 	}
 
-	locks.MultipleByName(&nsgNames, VirtualNetworkResourceName)
+	holder := "azurerm_virtual_network " + id.ID()
+
+	if err := locks.MultipleByNameForHolder(ctx, holder, &nsgNames, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(&nsgNames, VirtualNetworkResourceName)
 
-	locks.MultipleByName(&routeTableNames, routeTableResourceName)
+	if err := locks.MultipleByNameForHolder(ctx, holder, &routeTableNames, routeTableResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(&routeTableNames, routeTableResourceName)
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
@@ -310,7 +310,9 @@ func resourceVPNGatewayUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 
-	locks.ByName(id.VpnGatewayName, VPNGatewayResourceName)
+	if err := locks.ByNameForHolder(ctx, "azurerm_vpn_gateway "+id.ID(), id.VpnGatewayName, VPNGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VpnGatewayName, VPNGatewayResourceName)
 
 	existing, err := client.VpnGatewaysGet(ctx, *id)
@@ -112,11 +112,13 @@ func resourceVPNServerConfigurationPolicyGroupCreate(d *pluginsdk.ResourceData,
 		return err
 	}
 
-	locks.ByID(vpnServerConfigurationId.ID())
-	defer locks.UnlockByID(vpnServerConfigurationId.ID())
-
 	id := virtualwans.NewConfigurationPolicyGroupID(subscriptionId, vpnServerConfigurationId.ResourceGroupName, vpnServerConfigurationId.VpnServerConfigurationName, d.Get("name").(string))
 
+	if err := locks.ByIDForHolder(ctx, "azurerm_vpn_server_configuration_policy_group "+id.ID(), vpnServerConfigurationId.ID()); err != nil {
+		return err
+	}
+	defer locks.UnlockByID(vpnServerConfigurationId.ID())
+
 	existing, err := client.ConfigurationPolicyGroupsGet(ctx, id)
 	if err != nil {
 		if !response.WasNotFound(existing.HttpResponse) {
@@ -194,7 +196,9 @@ func resourceVPNServerConfigurationPolicyGroupUpdate(d *pluginsdk.ResourceData,
 
 	vpnServerConfigurationId := virtualwans.NewVpnServerConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.VpnServerConfigurationName)
 
-	locks.ByID(vpnServerConfigurationId.ID())
+	if err := locks.ByIDForHolder(ctx, "azurerm_vpn_server_configuration_policy_group "+id.ID(), vpnServerConfigurationId.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(vpnServerConfigurationId.ID())
 
 	existing, err := client.ConfigurationPolicyGroupsGet(ctx, *id)
@@ -240,7 +244,9 @@ func resourceVPNServerConfigurationPolicyGroupDelete(d *pluginsdk.ResourceData,
 
 	vpnServerConfigurationId := virtualwans.NewVpnServerConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.VpnServerConfigurationName)
 
-	locks.ByID(vpnServerConfigurationId.ID())
+	if err := locks.ByIDForHolder(ctx, "azurerm_vpn_server_configuration_policy_group "+id.ID(), vpnServerConfigurationId.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(vpnServerConfigurationId.ID())
 
 	if err := client.ConfigurationPolicyGroupsDeleteThenPoll(ctx, *id); err != nil {
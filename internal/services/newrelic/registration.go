@@ -35,3 +35,9 @@ func (r Registration) WebsiteCategories() []string {
 		"New Relic",
 	}
 }
+
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"NewRelic.Observability",
+	}
+}
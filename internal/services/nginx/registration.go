@@ -27,6 +27,12 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Nginx.NginxPlus",
+	}
+}
+
 // DataSources ...
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
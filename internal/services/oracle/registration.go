@@ -43,3 +43,9 @@ func (r Registration) WebsiteCategories() []string {
 		"Oracle",
 	}
 }
+
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Oracle.Database",
+	}
+}
@@ -20,6 +20,12 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Orbital",
+	}
+}
+
 func (r Registration) Name() string {
 	return "Orbital"
 }
@@ -102,7 +102,9 @@ func (r LocalRulestackFQDNList) Create() sdk.ResourceFunc {
 			if err != nil {
 				return err
 			}
-			locks.ByID(rulestackId.ID())
+			if err := locks.ByIDWithContext(ctx, rulestackId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(rulestackId.ID())
 
 			id := fqdnlistlocalrulestack.NewLocalRulestackFqdnListID(rulestackId.SubscriptionId, rulestackId.ResourceGroupName, rulestackId.LocalRulestackName, model.Name)
@@ -198,7 +200,9 @@ func (r LocalRulestackFQDNList) Delete() sdk.ResourceFunc {
 			}
 
 			rulestackId := localrulestacks.NewLocalRulestackID(id.SubscriptionId, id.ResourceGroupName, id.LocalRulestackName)
-			locks.ByID(rulestackId.ID())
+			if err := locks.ByIDWithContext(ctx, rulestackId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(rulestackId.ID())
 
 			if err = client.DeleteThenPoll(ctx, *id); err != nil {
@@ -233,7 +237,9 @@ func (r LocalRulestackFQDNList) Update() sdk.ResourceFunc {
 			}
 
 			rulestackId := localrulestacks.NewLocalRulestackID(id.SubscriptionId, id.ResourceGroupName, id.LocalRulestackName)
-			locks.ByID(rulestackId.ID())
+			if err := locks.ByIDWithContext(ctx, rulestackId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(rulestackId.ID())
 
 			existing, err := client.Get(ctx, *id)
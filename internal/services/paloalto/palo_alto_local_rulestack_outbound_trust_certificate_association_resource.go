@@ -70,11 +70,15 @@ func (l LocalRulestackOutboundTrustCertificateAssociationResource) Create() sdk.
 				return err
 			}
 
-			locks.ByID(certificateId.ID())
+			if err := locks.ByIDWithContext(ctx, certificateId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(certificateId.ID())
 			rulestackId := localrulestacks.NewLocalRulestackID(certificateId.SubscriptionId, certificateId.ResourceGroupName, certificateId.LocalRulestackName)
 
-			locks.ByID(rulestackId.ID())
+			if err := locks.ByIDWithContext(ctx, rulestackId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(rulestackId.ID())
 
 			existing, err := client.Get(ctx, rulestackId)
@@ -151,11 +155,15 @@ func (l LocalRulestackOutboundTrustCertificateAssociationResource) Delete() sdk.
 			if err != nil {
 				return err
 			}
-			locks.ByID(certId.ID())
+			if err := locks.ByIDWithContext(ctx, certId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(certId.ID())
 
 			rulestackId := localrulestacks.NewLocalRulestackID(certId.SubscriptionId, certId.ResourceGroupName, certId.LocalRulestackName)
-			locks.ByID(rulestackId.ID())
+			if err := locks.ByIDWithContext(ctx, rulestackId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(rulestackId.ID())
 
 			existing, err := client.Get(ctx, rulestackId)
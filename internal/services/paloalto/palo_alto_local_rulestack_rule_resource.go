@@ -206,7 +206,9 @@ func (r LocalRuleStackRule) Create() sdk.ResourceFunc {
 			if err != nil {
 				return err
 			}
-			locks.ByID(rulestackId.ID())
+			if err := locks.ByIDWithContext(ctx, rulestackId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(rulestackId.ID())
 
 			// API uses Priority not Name for ID, despite swagger defining `ruleName` as required, not Priority - https://github.com/Azure/azure-rest-api-specs/issues/24697
@@ -369,7 +371,9 @@ func (r LocalRuleStackRule) Delete() sdk.ResourceFunc {
 			}
 
 			rulestackId := localrulestacks.NewLocalRulestackID(id.SubscriptionId, id.ResourceGroupName, id.LocalRulestackName)
-			locks.ByID(rulestackId.ID())
+			if err := locks.ByIDWithContext(ctx, rulestackId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(rulestackId.ID())
 
 			if err = client.DeleteThenPoll(ctx, *id); err != nil {
@@ -399,11 +403,15 @@ func (r LocalRuleStackRule) Update() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			rulestackId := localrulestacks.NewLocalRulestackID(id.SubscriptionId, id.ResourceGroupName, id.LocalRulestackName)
-			locks.ByID(rulestackId.ID())
+			if err := locks.ByIDWithContext(ctx, rulestackId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(rulestackId.ID())
 
 			existing, err := client.Get(ctx, *id)
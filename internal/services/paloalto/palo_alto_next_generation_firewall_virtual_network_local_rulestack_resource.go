@@ -160,7 +160,9 @@ func (r NextGenerationFirewallVNetLocalRulestackResource) Create() sdk.ResourceF
 				Tags: tags.Expand(model.Tags),
 			}
 
-			locks.ByID(ruleStackID.ID())
+			if err := locks.ByIDWithContext(ctx, ruleStackID.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(ruleStackID.ID())
 
 			if err = client.CreateOrUpdateThenPoll(ctx, id, firewall); err != nil {
@@ -287,7 +289,9 @@ func (r NextGenerationFirewallVNetLocalRulestackResource) Update() sdk.ResourceF
 				}
 
 				props.AssociatedRulestack = ruleStack
-				locks.ByID(ruleStackID.ID())
+				if err := locks.ByIDWithContext(ctx, ruleStackID.ID()); err != nil {
+					return err
+				}
 				defer locks.UnlockByID(ruleStackID.ID())
 			}
 
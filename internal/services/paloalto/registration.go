@@ -49,3 +49,9 @@ func (r Registration) WebsiteCategories() []string {
 		"Palo Alto",
 	}
 }
+
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"PaloAltoNetworks.Cloudngfw",
+	}
+}
@@ -77,7 +77,9 @@ func resourceFlexibleServerConfigurationUpdate(d *pluginsdk.ResourceData, meta i
 	}
 	id := configurations.NewConfigurationID(subscriptionId, serverId.ResourceGroupName, serverId.FlexibleServerName, d.Get("name").(string))
 
-	locks.ByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+	if err := locks.ByNameWithContext(ctx, id.FlexibleServerName, postgresqlFlexibleServerResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
 
 	props := configurations.ConfigurationForUpdate{
@@ -172,7 +174,9 @@ func resourceFlexibleServerConfigurationDelete(d *pluginsdk.ResourceData, meta i
 		return nil
 	}
 
-	locks.ByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+	if err := locks.ByNameWithContext(ctx, id.FlexibleServerName, postgresqlFlexibleServerResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
 
 	resp, err := client.Get(ctx, *id)
@@ -85,7 +85,9 @@ func resourcePostgresqlFlexibleServerFirewallRuleCreateUpdate(d *pluginsdk.Resou
 
 	id := firewallrules.NewFirewallRuleID(subscriptionId, serverId.ResourceGroupName, serverId.FlexibleServerName, d.Get("name").(string))
 
-	locks.ByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+	if err := locks.ByNameWithContext(ctx, id.FlexibleServerName, postgresqlFlexibleServerResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
 
 	if d.IsNewResource() {
@@ -155,7 +157,9 @@ func resourcePostgresqlFlexibleServerFirewallRuleDelete(d *pluginsdk.ResourceDat
 		return err
 	}
 
-	locks.ByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+	if err := locks.ByNameWithContext(ctx, id.FlexibleServerName, postgresqlFlexibleServerResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
 
 	if err = client.DeleteThenPoll(ctx, *id); err != nil {
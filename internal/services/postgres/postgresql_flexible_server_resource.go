@@ -608,7 +608,9 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 	if v, ok := d.GetOk("source_server_id"); ok && v.(string) != "" {
 		// The source server will be Updating status when creating a replica
 		sourceServerId, _ := servers.ParseFlexibleServerID(v.(string))
-		locks.ByName(sourceServerId.FlexibleServerName, postgresqlFlexibleServerResourceName)
+		if err := locks.ByNameWithContext(ctx, sourceServerId.FlexibleServerName, postgresqlFlexibleServerResourceName); err != nil {
+			return err
+		}
 		defer locks.UnlockByName(sourceServerId.FlexibleServerName, postgresqlFlexibleServerResourceName)
 
 		parameters.Properties.SourceServerResourceId = pointer.To(v.(string))
@@ -127,11 +127,15 @@ func (r PostgresqlFlexibleServerVirtualEndpointResource) Create() sdk.ResourceFu
 			sourceEndpointId := virtualendpoints.NewVirtualEndpointID(sourceServerId.SubscriptionId, sourceServerId.ResourceGroupName, sourceServerId.FlexibleServerName, virtualEndpoint.Name)
 			replicaEndpointId := virtualendpoints.NewVirtualEndpointID(replicaServerId.SubscriptionId, replicaServerId.ResourceGroupName, replicaServerId.FlexibleServerName, virtualEndpoint.Name)
 
-			locks.ByName(sourceEndpointId.FlexibleServerName, postgresqlFlexibleServerResourceName)
+			if err := locks.ByNameWithContext(ctx, sourceEndpointId.FlexibleServerName, postgresqlFlexibleServerResourceName); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(sourceEndpointId.FlexibleServerName, postgresqlFlexibleServerResourceName)
 
 			if replicaServerId.FlexibleServerName != replicaEndpointId.FlexibleServerName {
-				locks.ByName(replicaServerId.FlexibleServerName, postgresqlFlexibleServerResourceName)
+				if err := locks.ByNameWithContext(ctx, replicaServerId.FlexibleServerName, postgresqlFlexibleServerResourceName); err != nil {
+					return err
+				}
 				defer locks.UnlockByName(replicaServerId.FlexibleServerName, postgresqlFlexibleServerResourceName)
 			}
 
@@ -280,7 +284,9 @@ func (r PostgresqlFlexibleServerVirtualEndpointResource) Delete() sdk.ResourceFu
 				}
 			}
 
-			locks.ByName(virtualEndpointId.FlexibleServerName, postgresqlFlexibleServerResourceName)
+			if err := locks.ByNameWithContext(ctx, virtualEndpointId.FlexibleServerName, postgresqlFlexibleServerResourceName); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(virtualEndpointId.FlexibleServerName, postgresqlFlexibleServerResourceName)
 
 			if err := client.DeleteThenPoll(ctx, virtualEndpointId); err != nil {
@@ -329,11 +335,15 @@ func (r PostgresqlFlexibleServerVirtualEndpointResource) Update() sdk.ResourceFu
 				return err
 			}
 
-			locks.ByName(id.First.FlexibleServerName, postgresqlFlexibleServerResourceName)
+			if err := locks.ByNameWithContext(ctx, id.First.FlexibleServerName, postgresqlFlexibleServerResourceName); err != nil {
+				return err
+			}
 			defer locks.UnlockByName(id.First.FlexibleServerName, postgresqlFlexibleServerResourceName)
 
 			if replicaServerId.FlexibleServerName != id.First.FlexibleServerName {
-				locks.ByName(replicaServerId.FlexibleServerName, postgresqlFlexibleServerResourceName)
+				if err := locks.ByNameWithContext(ctx, replicaServerId.FlexibleServerName, postgresqlFlexibleServerResourceName); err != nil {
+					return err
+				}
 				defer locks.UnlockByName(replicaServerId.FlexibleServerName, postgresqlFlexibleServerResourceName)
 			}
 
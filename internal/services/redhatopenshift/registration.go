@@ -27,6 +27,12 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.RedHatOpenShift",
+	}
+}
+
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		RedHatOpenShiftCluster{},
@@ -112,7 +112,9 @@ func (r RedisCacheAccessPolicyAssignmentResource) Create() sdk.ResourceFunc {
 				},
 			}
 
-			locks.ByID(model.RedisCacheID)
+			if err := locks.ByIDWithContext(ctx, model.RedisCacheID); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(model.RedisCacheID)
 
 			if err := client.AccessPolicyAssignmentCreateUpdateThenPoll(ctx, id, createInput); err != nil {
@@ -177,7 +179,9 @@ func (r RedisCacheAccessPolicyAssignmentResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(model.RedisCacheID)
+			if err := locks.ByIDWithContext(ctx, model.RedisCacheID); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(model.RedisCacheID)
 
 			if err := client.AccessPolicyAssignmentDeleteThenPoll(ctx, *id); err != nil {
@@ -31,6 +31,12 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Search",
+	}
+}
+
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		SharedPrivateLinkServiceResource{},
@@ -111,7 +111,9 @@ func (r SharedPrivateLinkServiceResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(searchServiceId.ID())
+			if err := locks.ByIDWithContext(ctx, searchServiceId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(searchServiceId.ID())
 
 			id := sharedprivatelinkresources.NewSharedPrivateLinkResourceID(subscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, model.Name)
@@ -205,7 +207,9 @@ func (r SharedPrivateLinkServiceResource) Update() sdk.ResourceFunc {
 			}
 
 			searchServiceId := sharedprivatelinkresources.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
-			locks.ByID(searchServiceId.ID())
+			if err := locks.ByIDWithContext(ctx, searchServiceId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(searchServiceId.ID())
 
 			var state SharedPrivateLinkServiceModel
@@ -241,7 +245,9 @@ func (r SharedPrivateLinkServiceResource) Delete() sdk.ResourceFunc {
 			}
 
 			searchServiceId := sharedprivatelinkresources.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
-			locks.ByID(searchServiceId.ID())
+			if err := locks.ByIDWithContext(ctx, searchServiceId.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(searchServiceId.ID())
 
 			if err := client.DeleteThenPoll(ctx, *id, sharedprivatelinkresources.DeleteOperationOptions{}); err != nil {
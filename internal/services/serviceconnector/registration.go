@@ -19,6 +19,12 @@ func (r Registration) WebsiteCategories() []string {
 	return []string{}
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.ServiceLinker",
+	}
+}
+
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{}
 }
@@ -38,3 +38,9 @@ func (r Registration) WebsiteCategories() []string {
 		"Service Networking",
 	}
 }
+
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.ServiceNetworking",
+	}
+}
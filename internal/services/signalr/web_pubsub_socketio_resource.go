@@ -277,7 +277,9 @@ func (w WebPubSubSocketIOResource) Create() sdk.ResourceFunc {
 
 			id := webpubsub.NewWebPubSubID(subscriptionId, config.ResourceGroupName, config.Name)
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			existing, err := client.Get(ctx, id)
@@ -508,7 +510,9 @@ func (w WebPubSubSocketIOResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			if err := client.DeleteThenPoll(ctx, *id); err != nil {
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/storageaccounts"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+)
+
+var _ sdk.EphemeralResource = &StorageAccountKeysEphemeralResource{}
+
+func NewStorageAccountKeysEphemeralResource() ephemeral.EphemeralResource {
+	return &StorageAccountKeysEphemeralResource{}
+}
+
+// StorageAccountKeysEphemeralResource exposes the Access Keys (and the Connection Strings using these) for a Storage
+// Account, without persisting these into the state
+type StorageAccountKeysEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type StorageAccountKeysEphemeralResourceModel struct {
+	StorageAccountId              types.String `tfsdk:"storage_account_id"`
+	PrimaryAccessKey              types.String `tfsdk:"primary_access_key"`
+	SecondaryAccessKey            types.String `tfsdk:"secondary_access_key"`
+	PrimaryConnectionString       types.String `tfsdk:"primary_connection_string"`
+	SecondaryConnectionString     types.String `tfsdk:"secondary_connection_string"`
+	PrimaryBlobConnectionString   types.String `tfsdk:"primary_blob_connection_string"`
+	SecondaryBlobConnectionString types.String `tfsdk:"secondary_blob_connection_string"`
+}
+
+func (e *StorageAccountKeysEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_storage_account_keys"
+}
+
+func (e *StorageAccountKeysEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *StorageAccountKeysEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"storage_account_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: commonids.ValidateStorageAccountID,
+					},
+				},
+			},
+
+			"primary_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_blob_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_blob_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *StorageAccountKeysEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.Storage.ResourceManager.StorageAccounts
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data StorageAccountKeysEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := commonids.ParseStorageAccountID(data.StorageAccountId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	storageDomainSuffix, ok := e.Client.Account.Environment.Storage.DomainSuffix()
+	if !ok {
+		sdk.SetResponseErrorDiagnostic(resp, "", fmt.Sprintf("could not determine Storage domain suffix for environment %q", e.Client.Account.Environment.Name))
+		return
+	}
+
+	existing, err := client.GetProperties(ctx, *id, storageaccounts.DefaultGetPropertiesOperationOptions())
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("%s was not found", id), err)
+			return
+		}
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving %s", id), err)
+		return
+	}
+
+	keys, err := client.ListKeys(ctx, *id, storageaccounts.DefaultListKeysOperationOptions())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing Keys for %s", id), err)
+		return
+	}
+
+	var endpoints accountEndpoints
+	if model := existing.Model; model != nil && model.Properties != nil {
+		endpoints = flattenAccountEndpoints(model.Properties.PrimaryEndpoints, model.Properties.SecondaryEndpoints, model.Properties.RoutingPreference)
+	}
+
+	storageAccountKeys := make([]storageaccounts.StorageAccountKey, 0)
+	if keys.Model != nil && keys.Model.Keys != nil {
+		storageAccountKeys = *keys.Model.Keys
+	}
+	keysAndConnectionStrings := flattenAccountAccessKeysAndConnectionStrings(id.StorageAccountName, *storageDomainSuffix, storageAccountKeys, endpoints)
+
+	data.PrimaryAccessKey = types.StringValue(keysAndConnectionStrings.primaryAccessKey)
+	data.SecondaryAccessKey = types.StringValue(keysAndConnectionStrings.secondaryAccessKey)
+	data.PrimaryConnectionString = types.StringValue(keysAndConnectionStrings.primaryConnectionString)
+	data.SecondaryConnectionString = types.StringValue(keysAndConnectionStrings.secondaryConnectionString)
+	data.PrimaryBlobConnectionString = types.StringValue(keysAndConnectionStrings.primaryBlobConnectionString)
+	data.SecondaryBlobConnectionString = types.StringValue(keysAndConnectionStrings.secondaryBlobConnectionString)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
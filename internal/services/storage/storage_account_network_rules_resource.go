@@ -134,7 +134,9 @@ func resourceStorageAccountNetworkRulesCreate(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
-	locks.ByName(id.StorageAccountName, storageAccountResourceName)
+	if err := locks.ByNameWithContext(ctx, id.StorageAccountName, storageAccountResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
 
 	resp, err := client.GetProperties(ctx, *id, storageaccounts.DefaultGetPropertiesOperationOptions())
@@ -201,7 +203,9 @@ func resourceStorageAccountNetworkRulesUpdate(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
-	locks.ByName(id.StorageAccountName, storageAccountResourceName)
+	if err := locks.ByNameWithContext(ctx, id.StorageAccountName, storageAccountResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
 
 	resp, err := client.GetProperties(ctx, *id, storageaccounts.DefaultGetPropertiesOperationOptions())
@@ -309,7 +313,9 @@ func resourceStorageAccountNetworkRulesDelete(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
-	locks.ByName(id.StorageAccountName, storageAccountResourceName)
+	if err := locks.ByNameWithContext(ctx, id.StorageAccountName, storageAccountResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
 
 	// We can't delete a network rule set so we'll just update it back to the default instead
@@ -1344,7 +1344,9 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	defer cancel()
 
 	id := commonids.NewStorageAccountID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
-	locks.ByName(id.StorageAccountName, storageAccountResourceName)
+	if err := locks.ByNameWithContext(ctx, id.StorageAccountName, storageAccountResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
 
 	existing, err := client.GetProperties(ctx, id, storageaccounts.DefaultGetPropertiesOperationOptions())
@@ -1685,7 +1687,9 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		return err
 	}
 
-	locks.ByName(id.StorageAccountName, storageAccountResourceName)
+	if err := locks.ByNameWithContext(ctx, id.StorageAccountName, storageAccountResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
 
 	accountTier := storageaccounts.SkuTier(d.Get("account_tier").(string))
@@ -2354,7 +2358,9 @@ func resourceStorageAccountDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		return err
 	}
 
-	locks.ByName(id.StorageAccountName, storageAccountResourceName)
+	if err := locks.ByNameWithContext(ctx, id.StorageAccountName, storageAccountResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
 
 	existing, err := client.GetProperties(ctx, *id, storageaccounts.DefaultGetPropertiesOperationOptions())
@@ -2389,7 +2395,9 @@ func resourceStorageAccountDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, &virtualNetworkNames, network.VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
 
 	if _, err := client.Delete(ctx, *id); err != nil {
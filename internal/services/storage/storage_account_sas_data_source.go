@@ -17,12 +17,13 @@ import (
 const (
 	connStringAccountKeyKey  = "AccountKey"
 	connStringAccountNameKey = "AccountName"
+
+	accountSasSignedVersion = "2022-11-02"
 )
 
 // This is an ACCOUNT SAS : https://docs.microsoft.com/en-us/rest/api/storageservices/Constructing-an-Account-SAS
 // not Service SAS
 func dataSourceStorageAccountSharedAccessSignature() *pluginsdk.Resource {
-	const sasSignedVersion = "2022-11-02"
 	return &pluginsdk.Resource{
 		Read: dataSourceStorageAccountSasRead,
 
@@ -55,7 +56,7 @@ func dataSourceStorageAccountSharedAccessSignature() *pluginsdk.Resource {
 			"signed_version": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
-				Default:  sasSignedVersion,
+				Default:  accountSasSignedVersion,
 			},
 
 			"resource_types": {
@@ -194,26 +195,55 @@ func dataSourceStorageAccountSharedAccessSignature() *pluginsdk.Resource {
 }
 
 func dataSourceStorageAccountSasRead(d *pluginsdk.ResourceData, _ interface{}) error {
-	connString := d.Get("connection_string").(string)
-	httpsOnly := d.Get("https_only").(bool)
-	ipAddresses := d.Get("ip_addresses").(string)
-	signedVersion := d.Get("signed_version").(string)
 	resourceTypesIface := d.Get("resource_types").([]interface{})
 	servicesIface := d.Get("services").([]interface{})
-	start := d.Get("start").(string)
-	expiry := d.Get("expiry").(string)
 	permissionsIface := d.Get("permissions").([]interface{})
 
-	resourceTypes := BuildResourceTypesString(resourceTypesIface[0].(map[string]interface{}))
-	services := BuildServicesString(servicesIface[0].(map[string]interface{}))
-	permissions := BuildPermissionsString(permissionsIface[0].(map[string]interface{}))
+	input := accountSharedAccessSignature{
+		connectionString: d.Get("connection_string").(string),
+		httpsOnly:        d.Get("https_only").(bool),
+		ipAddresses:      d.Get("ip_addresses").(string),
+		signedVersion:    d.Get("signed_version").(string),
+		resourceTypes:    BuildResourceTypesString(resourceTypesIface[0].(map[string]interface{})),
+		services:         BuildServicesString(servicesIface[0].(map[string]interface{})),
+		start:            d.Get("start").(string),
+		expiry:           d.Get("expiry").(string),
+		permissions:      BuildPermissionsString(permissionsIface[0].(map[string]interface{})),
+	}
 
-	// Parse the connection string
-	kvp, err := storage.ParseAccountSASConnectionString(connString)
+	sasToken, err := input.compute()
 	if err != nil {
 		return err
 	}
 
+	d.Set("sas", sasToken)
+	tokenHash := sha256.Sum256([]byte(sasToken))
+	d.SetId(hex.EncodeToString(tokenHash[:]))
+
+	return nil
+}
+
+// accountSharedAccessSignature contains the values used to compute an Account SAS, which is used by both the Data
+// Source and the Ephemeral Resource
+type accountSharedAccessSignature struct {
+	connectionString string
+	httpsOnly        bool
+	ipAddresses      string
+	signedVersion    string
+	resourceTypes    string
+	services         string
+	start            string
+	expiry           string
+	permissions      string
+}
+
+func (s accountSharedAccessSignature) compute() (string, error) {
+	// Parse the connection string
+	kvp, err := storage.ParseAccountSASConnectionString(s.connectionString)
+	if err != nil {
+		return "", err
+	}
+
 	// Create the string to sign with the key...
 
 	// Details on how to do this are here:
@@ -221,24 +251,15 @@ func dataSourceStorageAccountSasRead(d *pluginsdk.ResourceData, _ interface{}) e
 	accountName := kvp[connStringAccountNameKey]
 	accountKey := kvp[connStringAccountKeyKey]
 	signedProtocol := "https,http"
-	if httpsOnly {
+	if s.httpsOnly {
 		signedProtocol = "https"
 	}
 
 	// TODO: implement support for signedEncryptionScope
 	signedEncryptionScope := ""
 
-	sasToken, err := storage.ComputeAccountSASToken(accountName, accountKey, permissions, services, resourceTypes,
-		start, expiry, signedProtocol, ipAddresses, signedVersion, signedEncryptionScope)
-	if err != nil {
-		return err
-	}
-
-	d.Set("sas", sasToken)
-	tokenHash := sha256.Sum256([]byte(sasToken))
-	d.SetId(hex.EncodeToString(tokenHash[:]))
-
-	return nil
+	return storage.ComputeAccountSASToken(accountName, accountKey, s.permissions, s.services, s.resourceTypes,
+		s.start, s.expiry, signedProtocol, s.ipAddresses, s.signedVersion, signedEncryptionScope)
 }
 
 func BuildPermissionsString(perms map[string]interface{}) string {
@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.EphemeralResource = &StorageAccountSasEphemeralResource{}
+
+func NewStorageAccountSasEphemeralResource() ephemeral.EphemeralResource {
+	return &StorageAccountSasEphemeralResource{}
+}
+
+// StorageAccountSasEphemeralResource computes an Account SAS (as the `azurerm_storage_account_sas` Data Source does)
+// without persisting it into the state
+type StorageAccountSasEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type StorageAccountSasEphemeralResourceModel struct {
+	ConnectionString types.String                          `tfsdk:"connection_string"`
+	HttpsOnly        types.Bool                            `tfsdk:"https_only"`
+	IPAddresses      types.String                          `tfsdk:"ip_addresses"`
+	SignedVersion    types.String                          `tfsdk:"signed_version"`
+	ResourceTypes    []StorageAccountSasResourceTypesModel `tfsdk:"resource_types"`
+	Services         []StorageAccountSasServicesModel      `tfsdk:"services"`
+	Start            types.String                          `tfsdk:"start"`
+	Expiry           types.String                          `tfsdk:"expiry"`
+	Permissions      []StorageAccountSasPermissionsModel   `tfsdk:"permissions"`
+	Sas              types.String                          `tfsdk:"sas"`
+}
+
+type StorageAccountSasResourceTypesModel struct {
+	Service   types.Bool `tfsdk:"service"`
+	Container types.Bool `tfsdk:"container"`
+	Object    types.Bool `tfsdk:"object"`
+}
+
+type StorageAccountSasServicesModel struct {
+	Blob  types.Bool `tfsdk:"blob"`
+	Queue types.Bool `tfsdk:"queue"`
+	Table types.Bool `tfsdk:"table"`
+	File  types.Bool `tfsdk:"file"`
+}
+
+type StorageAccountSasPermissionsModel struct {
+	Read    types.Bool `tfsdk:"read"`
+	Write   types.Bool `tfsdk:"write"`
+	Delete  types.Bool `tfsdk:"delete"`
+	List    types.Bool `tfsdk:"list"`
+	Add     types.Bool `tfsdk:"add"`
+	Create  types.Bool `tfsdk:"create"`
+	Update  types.Bool `tfsdk:"update"`
+	Process types.Bool `tfsdk:"process"`
+	Tag     types.Bool `tfsdk:"tag"`
+	Filter  types.Bool `tfsdk:"filter"`
+}
+
+func (e *StorageAccountSasEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_storage_account_sas"
+}
+
+func (e *StorageAccountSasEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *StorageAccountSasEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	requiredBool := schema.BoolAttribute{
+		Required: true,
+	}
+	requiredSingleBlock := []validator.List{
+		listvalidator.IsRequired(),
+		listvalidator.SizeAtMost(1),
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"connection_string": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"https_only": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+
+			"ip_addresses": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validation.Any(
+							validation.IsIPv4Address,
+							validation.IsIPv4Range,
+						),
+					},
+				},
+			},
+
+			"signed_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			// Always in UTC and must be ISO-8601 format
+			"start": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validate.ISO8601DateTime,
+					},
+				},
+			},
+
+			// Always in UTC and must be ISO-8601 format
+			"expiry": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validate.ISO8601DateTime,
+					},
+				},
+			},
+
+			"sas": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"resource_types": schema.ListNestedBlock{
+				Validators: requiredSingleBlock,
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"service":   requiredBool,
+						"container": requiredBool,
+						"object":    requiredBool,
+					},
+				},
+			},
+
+			"services": schema.ListNestedBlock{
+				Validators: requiredSingleBlock,
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"blob":  requiredBool,
+						"queue": requiredBool,
+						"table": requiredBool,
+						"file":  requiredBool,
+					},
+				},
+			},
+
+			"permissions": schema.ListNestedBlock{
+				Validators: requiredSingleBlock,
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"read":    requiredBool,
+						"write":   requiredBool,
+						"delete":  requiredBool,
+						"list":    requiredBool,
+						"add":     requiredBool,
+						"create":  requiredBool,
+						"update":  requiredBool,
+						"process": requiredBool,
+						"tag":     requiredBool,
+						"filter":  requiredBool,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *StorageAccountSasEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data StorageAccountSasEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	input := accountSharedAccessSignature{
+		connectionString: data.ConnectionString.ValueString(),
+		httpsOnly:        true,
+		ipAddresses:      data.IPAddresses.ValueString(),
+		signedVersion:    accountSasSignedVersion,
+		start:            data.Start.ValueString(),
+		expiry:           data.Expiry.ValueString(),
+	}
+	if !data.HttpsOnly.IsNull() {
+		input.httpsOnly = data.HttpsOnly.ValueBool()
+	}
+	if !data.SignedVersion.IsNull() {
+		input.signedVersion = data.SignedVersion.ValueString()
+	}
+
+	if len(data.ResourceTypes) > 0 {
+		v := data.ResourceTypes[0]
+		input.resourceTypes = BuildResourceTypesString(map[string]interface{}{
+			"service":   v.Service.ValueBool(),
+			"container": v.Container.ValueBool(),
+			"object":    v.Object.ValueBool(),
+		})
+	}
+
+	if len(data.Services) > 0 {
+		v := data.Services[0]
+		input.services = BuildServicesString(map[string]interface{}{
+			"blob":  v.Blob.ValueBool(),
+			"queue": v.Queue.ValueBool(),
+			"table": v.Table.ValueBool(),
+			"file":  v.File.ValueBool(),
+		})
+	}
+
+	if len(data.Permissions) > 0 {
+		v := data.Permissions[0]
+		input.permissions = BuildPermissionsString(map[string]interface{}{
+			"read":    v.Read.ValueBool(),
+			"write":   v.Write.ValueBool(),
+			"delete":  v.Delete.ValueBool(),
+			"list":    v.List.ValueBool(),
+			"add":     v.Add.ValueBool(),
+			"create":  v.Create.ValueBool(),
+			"update":  v.Update.ValueBool(),
+			"process": v.Process.ValueBool(),
+			"tag":     v.Tag.ValueBool(),
+			"filter":  v.Filter.ValueBool(),
+		})
+	}
+
+	sasToken, err := input.compute()
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "computing the Account SAS", err)
+		return
+	}
+
+	data.HttpsOnly = types.BoolValue(input.httpsOnly)
+	data.SignedVersion = types.StringValue(input.signedVersion)
+	data.Sas = types.StringValue(sasToken)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
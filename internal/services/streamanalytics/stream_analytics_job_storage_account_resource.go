@@ -93,7 +93,9 @@ func (r JobStorageAccountResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			existing, err := client.Get(ctx, *id, streamingjobs.DefaultGetOperationOptions())
@@ -193,7 +195,9 @@ func (r JobStorageAccountResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			payload := streamingjobs.StreamingJob{
@@ -236,7 +240,9 @@ func (r JobStorageAccountResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByID(id.ID())
+			if err := locks.ByIDWithContext(ctx, id.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(id.ID())
 
 			existing, err := client.Get(ctx, *id, streamingjobs.DefaultGetOperationOptions())
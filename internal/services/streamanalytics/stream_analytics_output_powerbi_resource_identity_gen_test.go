@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package streamanalytics_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccStreamAnalyticsOutputPowerbi_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_output_powerbi", "test")
+	r := StreamAnalyticsOutputPowerBIResource{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.12.0-rc2"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_stream_analytics_output_powerbi.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+					customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_stream_analytics_output_powerbi.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("stream_analytics_job_id")),
+					customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_stream_analytics_output_powerbi.test", tfjsonpath.New("streaming_job_name"), tfjsonpath.New("stream_analytics_job_id")),
+					customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_stream_analytics_output_powerbi.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("stream_analytics_job_id")),
+				},
+			},
+			data.ImportBlockWithResourceIdentityStep(),
+			data.ImportBlockWithIDStep(),
+		},
+	})
+}
@@ -17,6 +17,12 @@ func (r Registration) WebsiteCategories() []string {
 	return []string{"Video Indexer"}
 }
 
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.VideoIndexer",
+	}
+}
+
 func (r Registration) Name() string {
 	return "VideoIndexer"
 }
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+// MergeDefaultTags returns the tags which should be assigned to a resource, that is the default tags specified in the
+// provider block merged with the tags specified on the resource - where the tags on the resource take precedence
+func MergeDefaultTags(defaultTags map[string]string, tagsMap map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(defaultTags)+len(tagsMap))
+
+	for k, v := range defaultTags {
+		output[k] = v
+	}
+
+	for k, v := range tagsMap {
+		// Validate should have ignored this error already
+		value, _ := TagValueToString(v)
+		output[k] = value
+	}
+
+	return output
+}
+
+// WithoutDefaultTags returns the tags assigned to a resource, excluding those which are only assigned because they're
+// specified in the default tags within the provider block - so that these don't show in the diff for the resource.
+// Tags which were previously set on the resource (`previousTags`) are always returned, since these are specified on
+// the resource itself.
+func WithoutDefaultTags(defaultTags map[string]string, tagsMap map[string]interface{}, previousTags map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(tagsMap))
+
+	for k, v := range tagsMap {
+		if _, isDefault := defaultTags[k]; isDefault {
+			if _, wasSet := previousTags[k]; !wasSet {
+				continue
+			}
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
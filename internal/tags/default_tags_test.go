@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeDefaultTags(t *testing.T) {
+	testData := []struct {
+		name        string
+		defaultTags map[string]string
+		tags        map[string]interface{}
+		expected    map[string]interface{}
+	}{
+		{
+			name:     "no default tags",
+			tags:     map[string]interface{}{"env": "test"},
+			expected: map[string]interface{}{"env": "test"},
+		},
+		{
+			name:        "default tags only",
+			defaultTags: map[string]string{"cost_center": "1234"},
+			expected:    map[string]interface{}{"cost_center": "1234"},
+		},
+		{
+			name:        "merged",
+			defaultTags: map[string]string{"cost_center": "1234", "owner": "platform"},
+			tags:        map[string]interface{}{"env": "test", "count": 3},
+			expected:    map[string]interface{}{"cost_center": "1234", "owner": "platform", "env": "test", "count": "3"},
+		},
+		{
+			name:        "resource tags take precedence",
+			defaultTags: map[string]string{"cost_center": "1234", "owner": "platform"},
+			tags:        map[string]interface{}{"owner": "networking"},
+			expected:    map[string]interface{}{"cost_center": "1234", "owner": "networking"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := MergeDefaultTags(v.defaultTags, v.tags)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("Expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestWithoutDefaultTags(t *testing.T) {
+	testData := []struct {
+		name         string
+		defaultTags  map[string]string
+		tags         map[string]interface{}
+		previousTags map[string]interface{}
+		expected     map[string]interface{}
+	}{
+		{
+			name:     "no default tags",
+			tags:     map[string]interface{}{"env": "test", "hidden-link": "value"},
+			expected: map[string]interface{}{"env": "test", "hidden-link": "value"},
+		},
+		{
+			name:         "default tags are excluded",
+			defaultTags:  map[string]string{"cost_center": "1234"},
+			tags:         map[string]interface{}{"env": "test", "cost_center": "1234"},
+			previousTags: map[string]interface{}{"env": "test"},
+			expected:     map[string]interface{}{"env": "test"},
+		},
+		{
+			name:         "default tags are excluded when their value differs",
+			defaultTags:  map[string]string{"cost_center": "5678"},
+			tags:         map[string]interface{}{"env": "test", "cost_center": "1234"},
+			previousTags: map[string]interface{}{"env": "test"},
+			expected:     map[string]interface{}{"env": "test"},
+		},
+		{
+			name:         "default tags overridden on the resource are kept",
+			defaultTags:  map[string]string{"cost_center": "1234"},
+			tags:         map[string]interface{}{"env": "test", "cost_center": "5678"},
+			previousTags: map[string]interface{}{"env": "test", "cost_center": "5678"},
+			expected:     map[string]interface{}{"env": "test", "cost_center": "5678"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := WithoutDefaultTags(v.defaultTags, v.tags, v.previousTags)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("Expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"strings"
+)
+
+// IgnoreConfig specifies the tags which are managed outside of Terraform (for example by Azure Policy) and which
+// should therefore be ignored - since tag keys are case-insensitive in Azure, these are compared case-insensitively
+type IgnoreConfig struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+// IsEmpty returns whether no tags are being ignored
+func (c IgnoreConfig) IsEmpty() bool {
+	return len(c.Keys) == 0 && len(c.KeyPrefixes) == 0
+}
+
+// Ignored returns whether the tag with the specified key should be ignored
+func (c IgnoreConfig) Ignored(key string) bool {
+	for _, k := range c.Keys {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+
+	for _, prefix := range c.KeyPrefixes {
+		if len(key) >= len(prefix) && strings.EqualFold(key[:len(prefix)], prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WithoutIgnoredTags returns the tags excluding any which should be ignored
+func (c IgnoreConfig) WithoutIgnoredTags(tagsMap map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(tagsMap))
+
+	for k, v := range tagsMap {
+		if c.Ignored(k) {
+			continue
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
+
+// OnlyIgnoredTags returns only the tags which should be ignored, which (since these aren't managed by Terraform) need
+// to be preserved when the tags of a resource are updated
+func (c IgnoreConfig) OnlyIgnoredTags(tagsMap map[string]*string) map[string]*string {
+	output := make(map[string]*string)
+
+	for k, v := range tagsMap {
+		if v == nil || !c.Ignored(k) {
+			continue
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
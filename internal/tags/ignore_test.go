@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestIgnoreConfigIgnored(t *testing.T) {
+	config := IgnoreConfig{
+		Keys:        []string{"ms-resource-usage"},
+		KeyPrefixes: []string{"hidden-"},
+	}
+
+	testData := map[string]bool{
+		"ms-resource-usage":         true,
+		"MS-Resource-Usage":         true,
+		"ms-resource-usage-example": false,
+		"hidden-link:/app-insights": true,
+		"Hidden-Title":              true,
+		"hidden":                    false,
+		"environment":               false,
+		"":                          false,
+	}
+
+	for key, expected := range testData {
+		t.Logf("[DEBUG] Testing %q..", key)
+
+		if actual := config.Ignored(key); actual != expected {
+			t.Fatalf("Expected %t but got %t", expected, actual)
+		}
+	}
+}
+
+func TestIgnoreConfigWithoutIgnoredTags(t *testing.T) {
+	config := IgnoreConfig{
+		Keys:        []string{"ms-resource-usage"},
+		KeyPrefixes: []string{"hidden-"},
+	}
+
+	actual := config.WithoutIgnoredTags(map[string]interface{}{
+		"environment":               "test",
+		"hidden-link:/app-insights": "Resource",
+		"ms-resource-usage":         "azure-cloud-shell",
+	})
+	expected := map[string]interface{}{
+		"environment": "test",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	if actual := (IgnoreConfig{}).WithoutIgnoredTags(expected); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestIgnoreConfigOnlyIgnoredTags(t *testing.T) {
+	config := IgnoreConfig{
+		Keys:        []string{"ms-resource-usage"},
+		KeyPrefixes: []string{"hidden-"},
+	}
+
+	actual := config.OnlyIgnoredTags(map[string]*string{
+		"environment":               pointer.To("test"),
+		"hidden-link:/app-insights": pointer.To("Resource"),
+		"hidden-title":              nil,
+		"ms-resource-usage":         pointer.To("azure-cloud-shell"),
+	})
+	expected := map[string]*string{
+		"hidden-link:/app-insights": pointer.To("Resource"),
+		"ms-resource-usage":         pointer.To("azure-cloud-shell"),
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
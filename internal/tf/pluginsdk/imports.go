@@ -15,6 +15,21 @@ type IDValidationFunc func(id string) error
 
 type ImporterFunc = func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error)
 
+type importIDValidationOnlyKey struct{}
+
+// WithImportIDValidationOnly returns a context which, when passed to an Importer built using these functions, only
+// validates the ID (or resource identity) without running the custom import logic - which is used to validate the
+// example IDs in the documentation, where there's no client for the custom import logic to use
+func WithImportIDValidationOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, importIDValidationOnlyKey{}, true)
+}
+
+// ImportIDValidationOnly returns whether only the ID should be validated when importing using this context
+func ImportIDValidationOnly(ctx context.Context) bool {
+	v, _ := ctx.Value(importIDValidationOnlyKey{}).(bool)
+	return v
+}
+
 // ImporterValidatingResourceId validates the ID provided at import time is valid
 // using the validateFunc.
 func ImporterValidatingResourceId(validateFunc IDValidationFunc) *schema.ResourceImporter {
@@ -42,6 +57,10 @@ func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc Im
 				return []*ResourceData{d}, err
 			}
 
+			if ImportIDValidationOnly(ctx) {
+				return []*ResourceData{d}, nil
+			}
+
 			return thenFunc(ctx, d, meta)
 		},
 	}
@@ -76,11 +95,12 @@ func ImporterValidatingIdentityThen(id resourceids.ResourceId, thenFunc Importer
 					// NOTE: we're intentionally not wrapping this error, since it's prefixed with `parsing %q:`
 					return []*ResourceData{d}, err
 				}
-				return thenFunc(ctx, d, meta)
+			} else if err := ValidateResourceIdentityData(d, id, idType...); err != nil {
+				return nil, err
 			}
 
-			if err := ValidateResourceIdentityData(d, id, idType...); err != nil {
-				return nil, err
+			if ImportIDValidationOnly(ctx) {
+				return []*ResourceData{d}, nil
 			}
 
 			return thenFunc(ctx, d, meta)
@@ -17,6 +17,8 @@ type (
 	MapFieldWriter         = schema.MapFieldWriter
 	Resource               = schema.Resource
 	ResourceData           = schema.ResourceData
+	ResourceIdentity       = schema.ResourceIdentity
+	ResourceImporter       = schema.ResourceImporter
 	ResourceDiff           = schema.ResourceDiff
 	SchemaDiffSuppressFunc = schema.SchemaDiffSuppressFunc
 	StateUpgrader          = schema.StateUpgrader
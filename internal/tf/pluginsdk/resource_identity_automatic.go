@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// These functions allow a resource identity to be added to resources which don't define one, based on the type of
+// resource ID used by the resource
+
+var (
+	resourceIdentities     = make(map[uintptr]resourceids.ResourceId)
+	resourceIdentitiesLock = &sync.RWMutex{}
+)
+
+// RegisterResourceIdentity registers the type of resource ID validated by the validation function `validateFunc`,
+// allowing resources using this validation function for their ID to be given a resource identity automatically.
+func RegisterResourceIdentity(validateFunc SchemaValidateFunc, id resourceids.ResourceId) {
+	if validateFunc == nil || id == nil {
+		return
+	}
+
+	resourceIdentitiesLock.Lock()
+	defer resourceIdentitiesLock.Unlock()
+
+	resourceIdentities[reflect.ValueOf(validateFunc).Pointer()] = id
+}
+
+// ResourceIdentityForValidateFunc returns the type of resource ID registered for the validation function `validateFunc`
+// (see RegisterResourceIdentity) when a resource identity can be generated for it.
+func ResourceIdentityForValidateFunc(validateFunc SchemaValidateFunc) (resourceids.ResourceId, bool) {
+	if validateFunc == nil {
+		return nil, false
+	}
+
+	resourceIdentitiesLock.RLock()
+	defer resourceIdentitiesLock.RUnlock()
+
+	id, ok := resourceIdentities[reflect.ValueOf(validateFunc).Pointer()]
+	if !ok || !resourceIdentitySupported(id) {
+		return nil, false
+	}
+
+	return id, true
+}
+
+// resourceIdentitySupported returns whether the resource ID can be represented as a resource identity, which requires
+// that the resource ID can be rebuilt from the values within the resource identity
+func resourceIdentitySupported(id resourceids.ResourceId) bool {
+	hasUserSpecifiedSegment := false
+	for _, segment := range id.Segments() {
+		switch segment.Type {
+		case resourceids.StaticSegmentType, resourceids.ResourceProviderSegmentType:
+			continue
+		case resourceids.UserSpecifiedSegmentType:
+			hasUserSpecifiedSegment = true
+		default:
+			if !segmentTypeSupported(segment.Type) {
+				return false
+			}
+		}
+	}
+
+	return hasUserSpecifiedSegment
+}
+
+// WithResourceIdentity adds a resource identity based on the resource ID type `id` to a resource which doesn't define
+// one. The resource identity data is set from the ID of the resource once the Create, Read and Update functions
+// complete, and the resource can be imported using either the resource ID or the resource identity.
+func WithResourceIdentity(resource *Resource, id resourceids.ResourceId, idType ...ResourceTypeForIdentity) *Resource {
+	t := identityType(idType)
+
+	resource.Identity = &schema.ResourceIdentity{
+		SchemaFunc: GenerateIdentitySchema(id, t),
+	}
+
+	// any existing import logic (e.g. validating the resource ID) runs once the resource ID has been determined
+	var thenFunc ImporterFunc
+	if importer := resource.Importer; importer != nil {
+		switch {
+		case importer.StateContext != nil:
+			thenFunc = importer.StateContext
+		case importer.State != nil: //nolint:staticcheck
+			thenFunc = func(_ context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
+				return importer.State(d, meta) //nolint:staticcheck
+			}
+		}
+	}
+	if thenFunc != nil {
+		resource.Importer = ImporterValidatingIdentityThen(id, thenFunc, t)
+	} else {
+		resource.Importer = ImporterValidatingIdentity(id, t)
+	}
+
+	setIdentity := func(d *ResourceData) error {
+		// the ID is unset when the resource no longer exists
+		if d.Id() == "" {
+			return nil
+		}
+
+		return setResourceIdentityDataFromResourceId(d, id, t)
+	}
+
+	wrapLegacy := func(f func(*ResourceData, interface{}) error) func(*ResourceData, interface{}) error {
+		return func(d *ResourceData, meta interface{}) error {
+			if err := f(d, meta); err != nil {
+				return err
+			}
+
+			return setIdentity(d)
+		}
+	}
+
+	wrap := func(f func(context.Context, *ResourceData, interface{}) diag.Diagnostics) func(context.Context, *ResourceData, interface{}) diag.Diagnostics {
+		return func(ctx context.Context, d *ResourceData, meta interface{}) diag.Diagnostics {
+			diags := f(ctx, d, meta)
+			if diags.HasError() {
+				return diags
+			}
+
+			if err := setIdentity(d); err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+
+			return diags
+		}
+	}
+
+	// the majority of the Untyped Resources use the (deprecated) legacy CRUD functions
+	if resource.Create != nil { //nolint:staticcheck
+		resource.Create = wrapLegacy(resource.Create) //nolint:staticcheck
+	}
+	if resource.Read != nil { //nolint:staticcheck
+		resource.Read = wrapLegacy(resource.Read) //nolint:staticcheck
+	}
+	if resource.Update != nil { //nolint:staticcheck
+		resource.Update = wrapLegacy(resource.Update) //nolint:staticcheck
+	}
+
+	if resource.CreateContext != nil {
+		resource.CreateContext = wrap(resource.CreateContext)
+	}
+	if resource.ReadContext != nil {
+		resource.ReadContext = wrap(resource.ReadContext)
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = wrap(resource.UpdateContext)
+	}
+
+	return resource
+}
+
+// setResourceIdentityDataFromResourceId sets the resource identity data in state by parsing the ID of the resource as
+// the resource ID type `id`
+func setResourceIdentityDataFromResourceId(d *ResourceData, id resourceids.ResourceId, idType ResourceTypeForIdentity) error {
+	parser := resourceids.NewParserFromResourceIdType(id)
+	parsed, err := parser.Parse(d.Id(), true)
+	if err != nil {
+		return fmt.Errorf("parsing resource ID: %s", err)
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		return fmt.Errorf("getting identity: %+v", err)
+	}
+
+	segments := id.Segments()
+	numSegments := len(segments)
+	for idx, segment := range segments {
+		if segmentTypeSupported(segment.Type) {
+			name := segmentName(segment, idType, numSegments, idx)
+
+			field, ok := parsed.Parsed[segment.Name]
+			if !ok {
+				return fmt.Errorf("field `%s` was not found in the parsed resource ID %s", name, d.Id())
+			}
+
+			if err = identity.Set(name, field); err != nil {
+				return fmt.Errorf("setting `%s` in resource identity: %+v", name, err)
+			}
+		}
+	}
+
+	return nil
+}
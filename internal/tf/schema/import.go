@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 // ResourceIDValidator takes a Resource ID and confirms that it's Valid
@@ -33,6 +34,10 @@ func ValidateResourceIDPriorToImportThen(idParser ResourceIDValidator, importer
 				return []*schema.ResourceData{d}, fmt.Errorf("parsing Resource ID %q: %+v", d.Id(), err)
 			}
 
+			if pluginsdk.ImportIDValidationOnly(ctx) {
+				return []*schema.ResourceData{d}, nil
+			}
+
 			return importer(ctx, d, meta)
 		},
 	}
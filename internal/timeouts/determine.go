@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -55,5 +56,13 @@ func ForUpdate(ctx context.Context, d *pluginsdk.ResourceData) (context.Context,
 }
 
 func buildWithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
-	return context.WithTimeout(ctx, timeout)
+	ctx, cancelTimeout := context.WithTimeout(ctx, timeout)
+
+	// the operation is cancelled should a lock it holds be lost, rather than continuing without holding it
+	ctx, cancelLocks := locks.WithCancelOnLostLock(ctx)
+
+	return ctx, func() {
+		cancelLocks()
+		cancelTimeout()
+	}
 }
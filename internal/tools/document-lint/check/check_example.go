@@ -0,0 +1,334 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/model"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/util"
+)
+
+// validate the HCL within the `## Example Usage` section against the schema of the resources it uses
+
+type ExampleIssue int
+
+const (
+	ExampleParseError ExampleIssue = iota
+	ExampleUnknownResource
+	ExampleUnknownArgument
+	ExampleReadOnlyArgument
+	ExampleMissingRequired
+	ExampleWrongNesting
+	ExampleDeprecated
+)
+
+type exampleDiff struct {
+	checkBase
+	issue ExampleIssue
+	msg   string
+
+	// endLine is the last line of the argument, when the argument spans multiple lines
+	endLine int
+}
+
+var _ Checker = exampleDiff{}
+
+func (e exampleDiff) String() string {
+	return fmt.Sprintf("%s example %s", e.checkBase.Str(), e.msg)
+}
+
+// ShouldSkip examples aren't linked to a field in the document, so are never skipped
+func (e exampleDiff) ShouldSkip() bool {
+	return false
+}
+
+func (e exampleDiff) Fix(line string) (string, error) {
+	return line, nil
+}
+
+// the HCL is within a code block, so the lines shouldn't be terminated with a full stop
+func (e exampleDiff) fixesCodeLine() {}
+
+// linesToRemove returns the range of lines to remove when fixing the document - arguments which don't exist in
+// the schema are removed from the example, whereas read-only arguments are left to be fixed by hand since these
+// may have been intended as a different argument
+func (e exampleDiff) linesToRemove() (from int, to int, ok bool) {
+	if e.issue != ExampleUnknownArgument {
+		return 0, 0, false
+	}
+	return e.line, e.endLine, true
+}
+
+func newExampleDiff(line int, key string, issue ExampleIssue, msg string) exampleDiff {
+	return exampleDiff{
+		checkBase: newCheckBase(line, key, nil),
+		issue:     issue,
+		msg:       msg,
+		endLine:   line,
+	}
+}
+
+// exampleSchemas is the schema for each of the Resources and Data Sources registered in the provider
+type exampleSchemas struct {
+	resources   map[string]*schema.Resource
+	dataSources map[string]*schema.Resource
+}
+
+var loadExampleSchemas = sync.OnceValue(func() exampleSchemas {
+	res := exampleSchemas{
+		resources:   map[string]*schema.Resource{},
+		dataSources: map[string]*schema.Resource{},
+	}
+
+	for _, svc := range provider.SupportedTypedServices() {
+		for _, r := range svc.Resources() {
+			wrapper := sdk.NewResourceWrapper(r)
+			if ins, err := wrapper.Resource(); err == nil {
+				res.resources[r.ResourceType()] = ins
+			}
+		}
+		for _, ds := range svc.DataSources() {
+			wrapper := sdk.NewDataSourceWrapper(ds)
+			if ins, err := wrapper.DataSource(); err == nil {
+				res.dataSources[ds.ResourceType()] = ins
+			}
+		}
+	}
+
+	for _, svc := range provider.SupportedUntypedServices() {
+		for name, r := range svc.SupportedResources() {
+			res.resources[name] = r
+		}
+		for name, ds := range svc.SupportedDataSources() {
+			res.dataSources[name] = ds
+		}
+	}
+	return res
+})
+
+// meta-arguments which are supported by every resource
+var exampleMetaArguments = map[string]struct{}{
+	"count":      {},
+	"depends_on": {},
+	"for_each":   {},
+	"provider":   {},
+}
+
+var exampleMetaBlocks = map[string]struct{}{
+	"connection":  {},
+	"lifecycle":   {},
+	"provisioner": {},
+}
+
+func checkExamples(md *model.ResourceDoc) []Checker {
+	return checkExamplesWithSchemas(md, loadExampleSchemas())
+}
+
+func checkExamplesWithSchemas(md *model.ResourceDoc, schemas exampleSchemas) (res []Checker) {
+	for _, example := range md.Examples {
+		file, diags := hclsyntax.ParseConfig([]byte(example.HCL), md.ResourceName, hcl.InitialPos)
+		if diags.HasErrors() {
+			line := example.Line
+			if diag := diags[0]; diag.Subject != nil {
+				line += diag.Subject.Start.Line - 1
+			}
+			res = append(res, newExampleDiff(line, md.ResourceName, ExampleParseError, fmt.Sprintf("can not be parsed: %s", diags.Error())))
+			continue
+		}
+
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		v := exampleValidator{
+			startLine: example.Line,
+		}
+		for _, block := range body.Blocks {
+			if len(block.Labels) < 2 || !strings.HasPrefix(block.Labels[0], "azurerm_") {
+				continue
+			}
+
+			var sch *schema.Resource
+			address := block.Labels[0] + "." + block.Labels[1]
+			switch block.Type {
+			case "resource":
+				sch = schemas.resources[block.Labels[0]]
+			case "data":
+				sch = schemas.dataSources[block.Labels[0]]
+				address = "data." + address
+			default:
+				continue
+			}
+
+			if sch == nil {
+				v.add(block.DefRange().Start.Line, address, ExampleUnknownResource, fmt.Sprintf("uses the %s %s which does not exist", block.Type, util.ItalicCode(block.Labels[0])))
+				continue
+			}
+
+			v.root = sch.Schema
+			v.validateBody(address, block, sch.Schema, true, sch.Timeouts != nil)
+		}
+		res = append(res, v.diffs...)
+	}
+	return res
+}
+
+type exampleValidator struct {
+	// startLine is the line within the document of the first line of HCL
+	startLine int
+
+	// root is the schema of the resource currently being validated
+	root map[string]*schema.Schema
+
+	diffs []Checker
+}
+
+func (v *exampleValidator) add(hclLine int, key string, issue ExampleIssue, msg string) *exampleDiff {
+	diff := newExampleDiff(v.startLine+hclLine-1, key, issue, msg)
+	v.diffs = append(v.diffs, &diff)
+	return &diff
+}
+
+func (v *exampleValidator) validateBody(path string, block *hclsyntax.Block, sch map[string]*schema.Schema, isRoot bool, hasTimeouts bool) {
+	// blocks which are empty or only contain comments (e.g. `# ...`) are shortened for brevity, so can't be checked
+	if len(block.Body.Attributes) == 0 && len(block.Body.Blocks) == 0 {
+		return
+	}
+
+	present := map[string]struct{}{}
+
+	attrs := make([]*hclsyntax.Attribute, 0, len(block.Body.Attributes))
+	for _, attr := range block.Body.Attributes {
+		attrs = append(attrs, attr)
+	}
+	sort.Slice(attrs, func(i, j int) bool {
+		return attrs[i].SrcRange.Start.Line < attrs[j].SrcRange.Start.Line
+	})
+
+	for _, attr := range attrs {
+		present[attr.Name] = struct{}{}
+		if _, ok := exampleMetaArguments[attr.Name]; ok && isRoot {
+			continue
+		}
+
+		key := path + "." + attr.Name
+		s, ok := sch[attr.Name]
+		if !ok || (!s.Optional && !s.Required) {
+			v.unknownArgument(key, attr.Name, s, attr.SrcRange)
+			continue
+		}
+		if s.Deprecated != "" {
+			v.add(attr.SrcRange.Start.Line, key, ExampleDeprecated, fmt.Sprintf("uses the deprecated argument %s: %s", util.ItalicCode(attr.Name), s.Deprecated))
+		}
+	}
+
+	for _, nested := range block.Body.Blocks {
+		if nested.Type == "dynamic" {
+			if len(nested.Labels) > 0 {
+				present[nested.Labels[0]] = struct{}{}
+			}
+			continue
+		}
+		if _, ok := exampleMetaBlocks[nested.Type]; ok && isRoot {
+			continue
+		}
+		if nested.Type == "timeouts" && isRoot && hasTimeouts {
+			continue
+		}
+
+		present[nested.Type] = struct{}{}
+		key := path + "." + nested.Type
+		rng := hcl.RangeBetween(nested.TypeRange, nested.CloseBraceRange)
+		s, ok := sch[nested.Type]
+		if !ok || (!s.Optional && !s.Required) {
+			v.unknownArgument(key, nested.Type, s, rng)
+			continue
+		}
+
+		elem, isBlock := s.Elem.(*schema.Resource)
+		if !isBlock || (s.Type != schema.TypeList && s.Type != schema.TypeSet) {
+			v.add(rng.Start.Line, key, ExampleWrongNesting, fmt.Sprintf("declares %s as a block, but it is an argument", util.ItalicCode(nested.Type)))
+			continue
+		}
+		if s.Deprecated != "" {
+			v.add(rng.Start.Line, key, ExampleDeprecated, fmt.Sprintf("uses the deprecated block %s: %s", util.ItalicCode(nested.Type), s.Deprecated))
+		}
+
+		v.validateBody(key, nested, elem.Schema, false, false)
+	}
+
+	names := make([]string, 0, len(sch))
+	for name := range sch {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, ok := present[name]; !ok && sch[name].Required {
+			v.add(block.DefRange().Start.Line, path+"."+name, ExampleMissingRequired, fmt.Sprintf("is missing the required argument %s", util.ItalicCode(name)))
+		}
+	}
+}
+
+// unknownArgument reports an argument which can't be set at this level - either since it is nested in the wrong
+// block, is read-only or doesn't exist in the schema at all
+func (v *exampleValidator) unknownArgument(key string, name string, s *schema.Schema, rng hcl.Range) {
+	if s != nil {
+		v.add(rng.Start.Line, key, ExampleReadOnlyArgument, fmt.Sprintf("sets %s which is read-only", util.ItalicCode(name)))
+		return
+	}
+
+	if correct := findSchemaPath(v.root, name); correct != "" {
+		msg := fmt.Sprintf("%s should be set at the top level of the resource", util.ItalicCode(name))
+		if strings.Contains(correct, ".") {
+			msg = fmt.Sprintf("%s should be nested in %s", util.ItalicCode(name), util.ItalicCode(util.XPathDir(correct)))
+		}
+		v.add(rng.Start.Line, key, ExampleWrongNesting, msg)
+		return
+	}
+
+	v.add(rng.Start.Line, key, ExampleUnknownArgument, fmt.Sprintf("sets %s which does not exist in the schema", util.ItalicCode(name))).endLine = v.startLine + rng.End.Line - 1
+}
+
+// findSchemaPath returns the shallowest path to an argument with this name within the schema, if any
+func findSchemaPath(sch map[string]*schema.Schema, name string) string {
+	type node struct {
+		path string
+		sch  map[string]*schema.Schema
+	}
+	queue := []node{{sch: sch}}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		names := make([]string, 0, len(cur.sch))
+		for k := range cur.sch {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+
+		for _, k := range names {
+			path := k
+			if cur.path != "" {
+				path = cur.path + "." + k
+			}
+			if k == name {
+				return path
+			}
+			if elem, ok := cur.sch[k].Elem.(*schema.Resource); ok {
+				queue = append(queue, node{path: path, sch: elem.Schema})
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/model"
+)
+
+func TestCheckExamples(t *testing.T) {
+	schemas := exampleSchemas{
+		resources: map[string]*schema.Resource{
+			"azurerm_example": {
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"sku": {
+						Type:       schema.TypeString,
+						Optional:   true,
+						Deprecated: "`sku` has been superseded by `sku_name`",
+					},
+					"network": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"subnet_id": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"private": {
+									Type:     schema.TypeBool,
+									Optional: true,
+								},
+							},
+						},
+					},
+					"endpoint": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		dataSources: map[string]*schema.Resource{},
+	}
+
+	// the HCL starts on the 5th line (index 4) of the document
+	md := &model.ResourceDoc{
+		ResourceName: "azurerm_example",
+		Examples: []model.Example{
+			{
+				Line: 4,
+				HCL: `resource "random_pet" "example" {
+  length = 2
+}
+
+resource "azurerm_example" "example" {
+  sku       = "Standard"
+  subnet_id = "example"
+  endpoint  = "example"
+  legacy {
+    enabled = true
+  }
+
+  network {
+    private = true
+  }
+
+  lifecycle {
+    ignore_changes = [sku]
+  }
+}
+
+data "azurerm_missing" "example" {
+}
+`,
+			},
+		},
+	}
+
+	type expected struct {
+		line    int
+		endLine int
+		key     string
+		issue   ExampleIssue
+	}
+	expectedDiffs := []expected{
+		{line: 9, endLine: 9, key: "azurerm_example.example.sku", issue: ExampleDeprecated},
+		{line: 10, endLine: 10, key: "azurerm_example.example.subnet_id", issue: ExampleWrongNesting},
+		{line: 11, endLine: 11, key: "azurerm_example.example.endpoint", issue: ExampleReadOnlyArgument},
+		{line: 12, endLine: 14, key: "azurerm_example.example.legacy", issue: ExampleUnknownArgument},
+		{line: 16, endLine: 16, key: "azurerm_example.example.network.subnet_id", issue: ExampleMissingRequired},
+		{line: 8, endLine: 8, key: "azurerm_example.example.name", issue: ExampleMissingRequired},
+		{line: 25, endLine: 25, key: "data.azurerm_missing.example", issue: ExampleUnknownResource},
+	}
+
+	diffs := checkExamplesWithSchemas(md, schemas)
+	if len(diffs) != len(expectedDiffs) {
+		t.Fatalf("expected %d diffs but got %d: %+v", len(expectedDiffs), len(diffs), diffs)
+	}
+	for idx, d := range diffs {
+		diff, ok := d.(*exampleDiff)
+		if !ok {
+			t.Fatalf("expected an *exampleDiff but got %T", d)
+		}
+		actual := expected{line: diff.Line(), endLine: diff.endLine, key: diff.Key(), issue: diff.issue}
+		if actual != expectedDiffs[idx] {
+			t.Errorf("diff %d: expected %+v but got %+v (%s)", idx, expectedDiffs[idx], actual, diff)
+		}
+		if _, _, removable := diff.linesToRemove(); removable != (diff.issue == ExampleUnknownArgument) {
+			t.Errorf("diff %d: expected only unknown arguments to be removed when fixing, got %t for %s", idx, removable, diff)
+		}
+	}
+}
+
+func TestCheckExamples_ShortenedBlocks(t *testing.T) {
+	schemas := exampleSchemas{
+		resources: map[string]*schema.Resource{
+			"azurerm_example": {
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"network": {
+						Type:     schema.TypeList,
+						Required: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"subnet_id": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		dataSources: map[string]*schema.Resource{},
+	}
+
+	md := &model.ResourceDoc{
+		ResourceName: "azurerm_example",
+		Examples: []model.Example{
+			{
+				Line: 4,
+				HCL: `resource "azurerm_example" "first" {
+  # ...
+}
+
+resource "azurerm_example" "second" {
+}
+
+resource "azurerm_example" "third" {
+  name = "example"
+
+  network {
+    # ...
+  }
+}
+`,
+			},
+		},
+	}
+
+	if diffs := checkExamplesWithSchemas(md, schemas); len(diffs) != 0 {
+		t.Fatalf("expected no diffs for blocks which are empty or only contain comments but got %d: %+v", len(diffs), diffs)
+	}
+}
+
+func TestCheckExamples_ParseError(t *testing.T) {
+	md := &model.ResourceDoc{
+		ResourceName: "azurerm_example",
+		Examples: []model.Example{
+			{
+				Line: 10,
+				HCL: `resource "azurerm_example" "example" {
+  name = "example"
+  sku  =
+}
+`,
+			},
+		},
+	}
+
+	diffs := checkExamplesWithSchemas(md, exampleSchemas{})
+	if len(diffs) != 1 {
+		t.Fatalf("expected a single diff but got %d: %+v", len(diffs), diffs)
+	}
+	diff, ok := diffs[0].(exampleDiff)
+	if !ok {
+		t.Fatalf("expected an exampleDiff but got %T", diffs[0])
+	}
+	if diff.issue != ExampleParseError {
+		t.Fatalf("expected a parse error but got %s", diff)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/model"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/util"
+)
+
+// validate the `terraform import` command within the `## Import` section, since an incorrect example ID causes imports to fail
+
+type ImportIssue int
+
+const (
+	ImportWrongResourceType ImportIssue = iota
+	ImportInvalidID
+)
+
+type importDiff struct {
+	checkBase
+	issue        ImportIssue
+	resourceType string // the resource type used in the document
+	msg          string
+}
+
+var _ Checker = importDiff{}
+
+func (i importDiff) String() string {
+	return fmt.Sprintf("%s import %s", i.checkBase.Str(), i.msg)
+}
+
+// ShouldSkip the import command isn't linked to a field in the document, so is never skipped
+func (i importDiff) ShouldSkip() bool {
+	return false
+}
+
+func (i importDiff) Fix(line string) (string, error) {
+	if i.issue == ImportWrongResourceType {
+		return strings.Replace(line, " "+i.resourceType+".", " "+i.key+".", 1), nil
+	}
+	return line, nil
+}
+
+// the import command is within a code block, so shouldn't be terminated with a full stop
+func (i importDiff) fixesCodeLine() {}
+
+func checkImport(r *schema.Resource, md *model.ResourceDoc) (res []Checker) {
+	imp := md.Import
+	if imp.Line == 0 {
+		return nil
+	}
+
+	if imp.ResourceType != r.ResourceType {
+		res = append(res, importDiff{
+			checkBase:    newCheckBase(imp.Line, r.ResourceType, nil),
+			issue:        ImportWrongResourceType,
+			resourceType: imp.ResourceType,
+			msg:          fmt.Sprintf("uses the resource type %s", util.ItalicCode(imp.ResourceType)),
+		})
+	}
+
+	if err := validateImportID(r, imp.ResourceID); err != nil {
+		res = append(res, importDiff{
+			checkBase:    newCheckBase(imp.Line, r.ResourceType, nil),
+			issue:        ImportInvalidID,
+			resourceType: imp.ResourceType,
+			msg:          fmt.Sprintf("ID %s is not valid: %s", util.ItalicCode(imp.ResourceID), err),
+		})
+	}
+	return res
+}
+
+// validateImportID validates the ID the same way as `terraform import` does - using the Resource ID type for
+// Typed Resources with an Identity, the IDValidationFunc for other Typed Resources and the Importer otherwise
+func validateImportID(r *schema.Resource, id string) error {
+	if r.SDKResource != nil {
+		if v, ok := r.SDKResource.(sdk.ResourceWithIdentity); ok {
+			_, err := resourceids.NewParserFromResourceIdType(v.Identity()).Parse(id, false)
+			return err
+		}
+
+		_, errs := r.SDKResource.IDValidationFunc()(id, "id")
+		return errors.Join(errs...)
+	}
+
+	if r.Schema == nil || r.Schema.Importer == nil || r.Schema.Importer.StateContext == nil {
+		return nil
+	}
+	return validateImportIDUsingImporter(r, id)
+}
+
+func validateImportIDUsingImporter(r *schema.Resource, id string) (err error) {
+	// only the ID is validated, since any custom import logic would try to use the (nil) client - so a panic means
+	// that the Importer doesn't support validating the ID alone (rather than the ID being invalid), so it's skipped
+	defer func() {
+		if v := recover(); v != nil {
+			log.Printf("%s %s: skipping validating the import ID since the importer requires a client: %v", color.YellowString("[WARN]"), r.ResourceType, v)
+			err = nil
+		}
+	}()
+
+	d := r.Schema.Data(nil)
+	d.SetId(id)
+	_, err = r.Schema.Importer.StateContext(pluginsdk.WithImportIDValidationOnly(context.Background()), d, nil)
+	return err
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/model"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/schema"
+)
+
+func TestCheckImport(t *testing.T) {
+	typed := &schema.Resource{
+		ResourceType: "azurerm_gallery_application",
+		SDKResource:  compute.GalleryApplicationResource{},
+	}
+	untyped := &schema.Resource{
+		ResourceType: "azurerm_resource_group",
+		Schema: &pluginsdk.Resource{
+			Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+				_, err := commonids.ParseResourceGroupID(id)
+				return err
+			}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+				// custom import logic using the client, which isn't available when linting
+				panic(fmt.Sprintf("unexpected import of %q", d.Id()))
+			}),
+		},
+	}
+
+	// an Importer which isn't built using the pluginsdk functions, so runs the custom import logic when validating the ID
+	custom := &schema.Resource{
+		ResourceType: "azurerm_resource_group",
+		Schema: &pluginsdk.Resource{
+			Importer: &pluginsdk.ResourceImporter{
+				StateContext: func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+					panic(fmt.Sprintf("unexpected import of %q", d.Id()))
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		resource *schema.Resource
+		doc      model.Import
+		expected []ImportIssue
+	}{
+		{
+			name:     "typed valid",
+			resource: typed,
+			doc: model.Import{
+				Line:         10,
+				ResourceType: "azurerm_gallery_application",
+				ResourceID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/galleries/gallery1/applications/app1",
+			},
+		},
+		{
+			name:     "typed invalid",
+			resource: typed,
+			doc: model.Import{
+				Line:         10,
+				ResourceType: "azurerm_gallery_application",
+				ResourceID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/galleries/gallery1",
+			},
+			expected: []ImportIssue{ImportInvalidID},
+		},
+		{
+			name:     "untyped valid",
+			resource: untyped,
+			doc: model.Import{
+				Line:         10,
+				ResourceType: "azurerm_resource_group",
+				ResourceID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			},
+		},
+		{
+			name:     "untyped invalid with the wrong resource type",
+			resource: untyped,
+			doc: model.Import{
+				Line:         10,
+				ResourceType: "azurerm_resource_groups",
+				ResourceID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/galleries/gallery1",
+			},
+			expected: []ImportIssue{ImportWrongResourceType, ImportInvalidID},
+		},
+		{
+			// the ID can't be validated without a client, so this is skipped rather than reported as invalid
+			name:     "custom importer panics",
+			resource: custom,
+			doc: model.Import{
+				Line:         10,
+				ResourceType: "azurerm_resource_group",
+				ResourceID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			},
+		},
+		{
+			name:     "no import",
+			resource: untyped,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diffs := checkImport(tt.resource, &model.ResourceDoc{Import: tt.doc})
+			if len(diffs) != len(tt.expected) {
+				t.Fatalf("expected %d diffs but got %d: %+v", len(tt.expected), len(diffs), diffs)
+			}
+			for idx, d := range diffs {
+				if issue := d.(importDiff).issue; issue != tt.expected[idx] {
+					t.Fatalf("diff %d: expected issue %d but got %d: %s", idx, tt.expected[idx], issue, d)
+				}
+			}
+		})
+	}
+}
+
+func TestImportDiff_Fix(t *testing.T) {
+	diff := importDiff{
+		checkBase:    newCheckBase(10, "azurerm_resource_group", nil),
+		issue:        ImportWrongResourceType,
+		resourceType: "azurerm_resource_groups",
+	}
+	line := "terraform import azurerm_resource_groups.example /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1"
+	expected := "terraform import azurerm_resource_group.example /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1"
+	if fixed, _ := diff.Fix(line); fixed != expected {
+		t.Fatalf("expected %q but got %q", expected, fixed)
+	}
+}
@@ -41,6 +41,8 @@ func (c propertyMissDiff) String() string {
 	switch c.MissType {
 	case MissBlockDeclare:
 		return fmt.Sprintf("%s blocks should be declared like '%s'", c.checkBase.Str(), util.ItalicCode("One or more `xxx` block as defined below."))
+	case MissInDocAttr:
+		return fmt.Sprintf("%s is exported but does not exist in the %s section", c.checkBase.Str(), util.ItalicCode("## Attributes Reference"))
 	case MissWrongPlace:
 		return fmt.Sprintf("%s should be nested in %s", c.checkBase.Str(), util.ItalicCode(util.XPathDir(c.correctName)))
 	case Misspelling:
@@ -79,6 +81,11 @@ func newMissInDoc(path string, f *model.Field) Checker {
 	return newMissItem(path, f, MissInDoc)
 }
 
+// exported attribute missed in the attributes part of the document, will fill a mock `f`
+func newMissInDocAttr(path string, f *model.Field) Checker {
+	return newMissItem(path, f, MissInDocAttr)
+}
+
 func newMissBlockDeclare(path string, f *model.Field) Checker {
 	return newMissItem(path, f, MissBlockDeclare)
 }
@@ -101,7 +108,7 @@ func mergeMisspelling(checks []Checker) (res []Checker) {
 	var missInDoc, missInCode []*propertyMissDiff
 	for _, c := range checks {
 		if p, ok := c.(*propertyMissDiff); ok {
-			if p.MissType == MissInDoc {
+			if p.MissType == MissInDoc || p.MissType == MissInDocAttr {
 				missInDoc = append(missInDoc, p)
 			} else if p.MissType == MissInCode {
 				missInCode = append(missInCode, p)
@@ -95,4 +95,10 @@ func (r *ResourceDiff) DiffAll() {
 
 	timeouts := diffTimeout(r.tf, r.md)
 	r.Diff = append(r.Diff, timeouts...)
+
+	examples := checkExamples(r.md)
+	r.Diff = append(r.Diff, examples...)
+
+	imports := checkImport(r.tf, r.md)
+	r.Diff = append(r.Diff, imports...)
 }
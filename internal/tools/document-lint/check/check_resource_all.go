@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/fatih/color"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/md"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/schema"
 )
@@ -124,6 +125,10 @@ func DiffAll(regs Resources, dryRun bool) *DiffResult {
 		var catName string
 
 		sch := schema.NewResource(res.schema, res.name)
+		// the `tags_all` attribute is added to the resources supporting the default tags when building the provider
+		withDefaultTags := *sch.Schema
+		withDefaultTags.Schema = provider.SchemaWithDefaultTags(sch.Schema)
+		sch.Schema = &withDefaultTags
 		rd := NewResourceDiff(sch)
 		if !dryRun {
 			md.FixFileNormalize(rd.MDFile)
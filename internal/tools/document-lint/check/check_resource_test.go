@@ -7,6 +7,10 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/model"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/schema"
 )
 
 func TestSliceDiff(t *testing.T) {
@@ -82,3 +86,94 @@ func TestLevenshteinDist(t *testing.T) {
 		}
 	}
 }
+
+func TestCrossCheckProperty_ExportedAttributes(t *testing.T) {
+	r := &schema.Resource{
+		ResourceType: "azurerm_example",
+		Schema: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+				},
+				"identity": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"type": {
+								Type:     pluginsdk.TypeString,
+								Required: true,
+							},
+							"principal_id": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"endpoint": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+				"legacy_endpoint": {
+					Type:       pluginsdk.TypeString,
+					Computed:   true,
+					Deprecated: "`legacy_endpoint` has been superseded by `endpoint`",
+				},
+				"sku": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	newDoc := func(identityAttr *model.Field) *model.ResourceDoc {
+		doc := model.NewResourceDoc()
+		doc.Args.AddField(&model.Field{Name: "name", Path: "name", Line: 10, Required: model.Required, Pos: model.PosArgs})
+		doc.Args.AddField(&model.Field{Name: "sku", Path: "sku", Line: 11, Required: model.Optional, Pos: model.PosArgs})
+		doc.Args.AddField(&model.Field{
+			Name:     "identity",
+			Path:     "identity",
+			Line:     12,
+			Required: model.Optional,
+			Typ:      model.FieldTypeBlock,
+			Pos:      model.PosArgs,
+			Subs: model.Properties{
+				"type": {Name: "type", Path: "identity.type", Line: 20, Required: model.Required, Pos: model.PosArgs},
+			},
+			SameNameAttr: identityAttr,
+		})
+		return doc
+	}
+
+	exported := func(diffs []Checker) (res []string) {
+		for _, d := range diffs {
+			if miss, ok := d.(*propertyMissDiff); ok && miss.MissType == MissInDocAttr {
+				res = append(res, miss.Key())
+			}
+		}
+		return res
+	}
+
+	// the attributes of the `identity` block are documented in the attributes part of the document
+	doc := newDoc(&model.Field{
+		Name: "identity",
+		Typ:  model.FieldTypeBlock,
+		Pos:  model.PosAttr,
+		Subs: model.Properties{
+			"principal_id": {Name: "principal_id", Path: "identity.principal_id", Line: 30, Pos: model.PosAttr},
+		},
+	})
+	if diff := cmp.Diff([]string{"endpoint"}, exported(crossCheckProperty(r, doc))); diff != "" {
+		t.Fatalf("unexpected undocumented attributes: %s", diff)
+	}
+
+	doc = newDoc(nil)
+	missed := exported(crossCheckProperty(r, doc))
+	if diff := cmp.Diff([]string{"endpoint", "identity.principal_id"}, missed, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Fatalf("unexpected undocumented attributes: %s", diff)
+	}
+}
@@ -71,7 +71,7 @@ func diffDocMiss(rt, path string, s *schema2.Schema, f *model.Field) (res []Chec
 	}
 
 	if f == nil {
-		if s.Deprecated == "" && !s.Computed && path != "id" {
+		if s.Deprecated == "" && path != "id" {
 			parts := strings.Split(path, ".")
 			name := parts[len(parts)-1]
 			f2 := &model.Field{
@@ -79,7 +79,12 @@ func diffDocMiss(rt, path string, s *schema2.Schema, f *model.Field) (res []Chec
 				Path:    path,
 				Content: s.GoString(),
 			}
-			res = append(res, newMissInDoc(path, f2))
+			if !s.Computed {
+				res = append(res, newMissInDoc(path, f2))
+			} else if !s.Optional && !s.Required {
+				// exported attributes should be documented in the `## Attributes Reference` section
+				res = append(res, newMissInDocAttr(path, f2))
+			}
 		}
 		return res
 	}
@@ -97,6 +102,10 @@ func diffDocMiss(rt, path string, s *schema2.Schema, f *model.Field) (res []Chec
 		}
 		for key, val := range ele.Schema {
 			subField := f.Subs[key]
+			if subField == nil && f.SameNameAttr != nil {
+				// the attributes of a block can be documented in the `## Attributes Reference` section
+				subField = f.SameNameAttr.Subs[key]
+			}
 			res = append(res, diffDocMiss(rt, path+"."+key, val, subField)...)
 		}
 	default:
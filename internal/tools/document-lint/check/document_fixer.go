@@ -22,6 +22,16 @@ type Fixer struct {
 	FixedContent string
 }
 
+// lineRemover is implemented by diffs which are fixed by removing lines from the document, rather than updating a line
+type lineRemover interface {
+	linesToRemove() (from int, to int, ok bool)
+}
+
+// codeLineFixer is implemented by diffs which fix a line within a code block, which shouldn't end with a full stop
+type codeLineFixer interface {
+	fixesCodeLine()
+}
+
 func NewFixer(d *ResourceDiff) *Fixer {
 	f := &Fixer{
 		MDFile:       d.MDFile,
@@ -107,6 +117,8 @@ func (f *Fixer) TryFix() (err error) {
 	}
 
 	lines := strings.Split(string(content), "\n")
+	// lines are removed once all other fixes are applied, so that the line numbers of the other diffs are unchanged
+	removeLines := map[int]struct{}{}
 	for idx, item := range f.Diff {
 		_ = idx
 		// fix timeout first!
@@ -120,6 +132,15 @@ func (f *Fixer) TryFix() (err error) {
 			continue
 		}
 
+		if remover, ok := item.(lineRemover); ok {
+			if from, to, ok := remover.linesToRemove(); ok {
+				for i := from; i <= to; i++ {
+					removeLines[i] = struct{}{}
+				}
+			}
+			continue
+		}
+
 		lineIdx := item.Line()
 		line := lines[lineIdx]
 
@@ -127,14 +148,26 @@ func (f *Fixer) TryFix() (err error) {
 			return err
 		}
 
-		if suf := strings.TrimSuffix(line, " "); suf != "" {
-			if ch := suf[len(suf)-1]; ch != '.' && ch != '?' {
-				line = suf + "."
+		if _, ok := item.(codeLineFixer); !ok {
+			if suf := strings.TrimSuffix(line, " "); suf != "" {
+				if ch := suf[len(suf)-1]; ch != '.' && ch != '?' {
+					line = suf + "."
+				}
 			}
 		}
 
 		lines[lineIdx] = line
 	}
+
+	if len(removeLines) > 0 {
+		kept := make([]string, 0, len(lines))
+		for idx, line := range lines {
+			if _, ok := removeLines[idx]; !ok {
+				kept = append(kept, line)
+			}
+		}
+		lines = kept
+	}
 	f.FixedContent = strings.Join(lines, "\n")
 	return nil
 }
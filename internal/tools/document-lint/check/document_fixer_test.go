@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/md"
+)
+
+func TestFixer_RemovesUnknownArguments(t *testing.T) {
+	schemas := exampleSchemas{
+		resources: map[string]*schema.Resource{
+			"azurerm_example": {
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"sku": {
+						Type:       schema.TypeString,
+						Optional:   true,
+						Deprecated: "`sku` has been superseded by `sku_name`",
+					},
+					"endpoint": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		dataSources: map[string]*schema.Resource{},
+	}
+
+	content := "# azurerm_example\n" +
+		"\n" +
+		"## Example Usage\n" +
+		"\n" +
+		"```hcl\n" +
+		"resource \"azurerm_example\" \"example\" {\n" +
+		"  name     = \"example\"\n" +
+		"  sku      = \"Standard\"\n" +
+		"  endpoint = \"example\"\n" +
+		"  legacy {\n" +
+		"    enabled = true\n" +
+		"  }\n" +
+		"  retired = true\n" +
+		"}\n" +
+		"```\n"
+
+	// the unknown `legacy` block and `retired` argument are removed, whereas the read-only `endpoint` argument and
+	// the deprecated `sku` argument are left to be fixed by hand
+	expected := "# azurerm_example\n" +
+		"\n" +
+		"## Example Usage\n" +
+		"\n" +
+		"```hcl\n" +
+		"resource \"azurerm_example\" \"example\" {\n" +
+		"  name     = \"example\"\n" +
+		"  sku      = \"Standard\"\n" +
+		"  endpoint = \"example\"\n" +
+		"}\n" +
+		"```\n"
+
+	path := filepath.Join(t.TempDir(), "example.html.markdown")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("writing document: %+v", err)
+	}
+
+	doc := md.MustNewMarkFromFile(path).BuildResourceDoc()
+	diffs := checkExamplesWithSchemas(doc, schemas)
+	if len(diffs) != 4 {
+		t.Fatalf("expected 4 diffs but got %d: %v", len(diffs), diffs)
+	}
+
+	fixer := &Fixer{
+		MDFile:       path,
+		ResourceType: "azurerm_example",
+		Diff:         diffs,
+	}
+	if err := fixer.TryFix(); err != nil {
+		t.Fatalf("fixing document: %+v", err)
+	}
+
+	if fixer.FixedContent != expected {
+		t.Fatalf("expected the fixed document to be:\n%s\nbut got:\n%s", expected, fixer.FixedContent)
+	}
+}
@@ -344,6 +344,10 @@ func (m *Mark) buildStruct() {
 
 	for _, f := range m.Fields {
 		fillField(f, "")
+		// a block can also be exported in the attributes part, with a different block definition
+		if f.SameNameAttr != nil {
+			fillField(f.SameNameAttr, "")
+		}
 	}
 
 	// build for block fields
@@ -365,9 +369,21 @@ func (m *Mark) BuildResourceDoc() *model.ResourceDoc {
 	}
 
 	doc.ResourceName = m.ResourceType
+	inExampleUsage, inImport := false, false
 	for _, item := range m.Items {
+		if item.Type == ItemHeader2 {
+			header := strings.TrimSpace(item.lines[0])
+			inExampleUsage = strings.HasPrefix(header, "## Example Usage")
+			inImport = posRegs[model.PosImport].MatchString(header)
+		}
 		if item.Type == ItemExample {
 			doc.ExampleHCL = item.content()
+			if inExampleUsage {
+				doc.Examples = append(doc.Examples, item.hclCodeBlocks()...)
+			}
+			if inImport && doc.Import.Line == 0 {
+				doc.Import = item.importCommand()
+			}
 		} else if item.Type == ItemTimeout {
 			doc.SetTimeout(item.FromLine, item.content())
 		}
@@ -375,3 +391,55 @@ func (m *Mark) BuildResourceDoc() *model.ResourceDoc {
 
 	return doc
 }
+
+// hclCodeBlocks returns the HCL code blocks within this item - which can contain more than one code block, since
+// any text following a code block is appended to the same item
+func (m *MarkItem) hclCodeBlocks() (res []model.Example) {
+	var inBlock, isHCL bool
+	var current model.Example
+	var lines []string
+	for idx, line := range m.lines {
+		switch {
+		case !strings.HasPrefix(line, "```"):
+			if inBlock {
+				lines = append(lines, line)
+			}
+		case inBlock:
+			// closing fence
+			if isHCL {
+				current.HCL = strings.Join(lines, "\n")
+				res = append(res, current)
+			}
+			inBlock, lines = false, nil
+		default:
+			// opening fence - only code blocks marked as hcl or terraform are parsed
+			lang := strings.TrimSpace(strings.TrimPrefix(line, "```"))
+			inBlock, isHCL = true, lang == "hcl" || lang == "terraform"
+			current = model.Example{
+				Line: m.FromLine + idx + 1,
+			}
+		}
+	}
+	return res
+}
+
+// importCommand returns the first `terraform import` command within this item, if any
+func (m *MarkItem) importCommand() (res model.Import) {
+	for idx, line := range m.lines {
+		fields := strings.Fields(line)
+		if len(fields) != 4 || fields[0] != "terraform" || fields[1] != "import" {
+			continue
+		}
+
+		address := fields[2]
+		if dot := strings.Index(address, "."); dot > 0 {
+			address = address[:dot]
+		}
+		return model.Import{
+			Line:         m.FromLine + idx,
+			ResourceType: address,
+			ResourceID:   strings.Trim(fields[3], `"'`),
+		}
+	}
+	return res
+}
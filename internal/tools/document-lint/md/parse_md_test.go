@@ -6,6 +6,7 @@ package md
 import (
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 )
 
@@ -37,3 +38,54 @@ func Test_unmarshalFile(t *testing.T) {
 		}
 	}
 }
+
+func Test_exampleHCL(t *testing.T) {
+	args := []struct {
+		file      string
+		lines     []int
+		firstLine string
+	}{
+		{"key_vault.html.markdown", []int{23}, "provider \"azurerm\" {"},
+		{"media_transform.html.markdown", []int{15, 58}, "resource \"azurerm_resource_group\" \"example\" {"},
+	}
+	for _, arg := range args {
+		file := filepath.Join(testDir, arg.file)
+		doc := MustNewMarkFromFile(file).BuildResourceDoc()
+		if len(doc.Examples) != len(arg.lines) {
+			t.Fatalf("`%s` expect example num: %d, got: %d", arg.file, len(arg.lines), len(doc.Examples))
+		}
+		for idx, example := range doc.Examples {
+			if example.Line != arg.lines[idx] {
+				t.Fatalf("`%s` expect example %d at line: %d, got: %d", arg.file, idx, arg.lines[idx], example.Line)
+			}
+		}
+		if got := strings.SplitN(doc.Examples[0].HCL, "\n", 2)[0]; got != arg.firstLine {
+			t.Fatalf("`%s` expect example to start with %q, got: %q", arg.file, arg.firstLine, got)
+		}
+	}
+}
+
+func Test_importCommand(t *testing.T) {
+	args := []struct {
+		file         string
+		line         int
+		resourceType string
+		resourceID   string
+	}{
+		{"key_vault.html.markdown", 177, "azurerm_key_vault", "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.KeyVault/vaults/vault1"},
+		{"media_transform.html.markdown", 887, "azurerm_media_transform", "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Media/mediaServices/media1/transforms/transform1"},
+	}
+	for _, arg := range args {
+		file := filepath.Join(testDir, arg.file)
+		doc := MustNewMarkFromFile(file).BuildResourceDoc()
+		if doc.Import.Line != arg.line {
+			t.Fatalf("`%s` expect import at line: %d, got: %d", arg.file, arg.line, doc.Import.Line)
+		}
+		if doc.Import.ResourceType != arg.resourceType {
+			t.Fatalf("`%s` expect import of: %s, got: %s", arg.file, arg.resourceType, doc.Import.ResourceType)
+		}
+		if doc.Import.ResourceID != arg.resourceID {
+			t.Fatalf("`%s` expect import ID: %s, got: %s", arg.file, arg.resourceID, doc.Import.ResourceID)
+		}
+	}
+}
@@ -193,8 +193,9 @@ type Timeouts struct {
 }
 
 type Import struct {
-	ResourceType string
-	ResourceID   string
+	Line         int    // line of the `terraform import` command, if line == 0 means no such command in document
+	ResourceType string // the resource type, e.g. `azurerm_resource_group`
+	ResourceID   string // the example Resource ID being imported
 }
 
 func (p Properties) FindField(name string) *Field {
@@ -231,11 +232,18 @@ func NewPossibleValue(values []string, f *Field) PossibleValue {
 	}
 }
 
+// Example is a HCL code block within the `## Example Usage` section of the document
+type Example struct {
+	Line int    // line of the first line of HCL (after the opening fence)
+	HCL  string // the content of the code block, excluding the fences
+}
+
 type ResourceDoc struct {
 	ResourceName string
 	Args         Properties
 	Attr         Properties
 	ExampleHCL   string
+	Examples     []Example
 	Timeouts     *Timeouts // nil if no timeouts part in document
 	Import       Import
 
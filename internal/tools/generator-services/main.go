@@ -43,6 +43,9 @@ func main() {
 		githubIssueLabelsGenerator{},
 		teamCityServicesListGenerator{},
 		websiteCategoriesGenerator{},
+		resourceIdentitiesGenerator{
+			rootDirectory: *filePath,
+		},
 	}
 	for _, value := range generators {
 		outputFile := value.outputPath(*filePath)
@@ -0,0 +1,443 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk" // nolint: typecheck
+)
+
+const modulePath = "github.com/hashicorp/terraform-provider-azurerm"
+
+var (
+	// e.g. `github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/disks.ValidateDiskID`
+	sdkValidationFuncRegex = regexp.MustCompile(`^Validate([A-Za-z0-9]+)ID$`)
+
+	// e.g. `github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/disks.ParseDiskID`
+	sdkParseFuncRegex = regexp.MustCompile(`^Parse([A-Za-z0-9]+)ID$`)
+
+	// e.g. `github.com/hashicorp/terraform-provider-azurerm/internal/services/example/validate.ExampleID`
+	localValidationFuncRegex = regexp.MustCompile(`^([A-Za-z0-9]+)ID$`)
+
+	// e.g. `"azurerm_example": resourceExample(),` within the `SupportedResources` func of an Untyped Service
+	untypedResourceRegex = regexp.MustCompile(`"(azurerm_[a-z0-9_]+)":\s+(\w+)\(\)`)
+
+	// e.g. an Importer only validating the Resource ID using `example.ParseExampleID` (or `parse.ExampleID`)
+	untypedImporterRegex = regexp.MustCompile(`Importer: pluginsdk\.ImporterValidatingResourceId\(func\(id string\) error \{\s*_, err := (\w+)\.(\w+)\(id\)\s*return err\s*\}\)`)
+)
+
+// resourceIdentitiesGenerator outputs the Resource ID types used by Typed Resources which don't define a Resource
+// Identity, and by the Importer of Untyped Resources which don't define a Resource Identity, allowing a Resource
+// Identity to be generated automatically for these Resources
+type resourceIdentitiesGenerator struct {
+	rootDirectory string
+}
+
+type resourceIdentity struct {
+	validateFuncPackage string
+	validateFuncName    string
+	idPackage           string
+	idTypeName          string
+}
+
+func (g resourceIdentitiesGenerator) outputPath(rootDirectory string) string {
+	return fmt.Sprintf("%s/internal/provider/resource_identities_gen.go", rootDirectory)
+}
+
+func (g resourceIdentitiesGenerator) run(outputFileName string, _ map[string]struct{}) error {
+	identities := make(map[string]resourceIdentity)
+	for _, service := range provider.SupportedTypedServices() {
+		for _, resource := range service.Resources() {
+			if _, ok := resource.(sdk.ResourceWithIdentity); ok {
+				continue
+			}
+
+			identity, err := g.resourceIdentityForResource(resource)
+			if err != nil {
+				return fmt.Errorf("determining the Resource Identity for %q: %+v", resource.ResourceType(), err)
+			}
+			if identity == nil {
+				continue
+			}
+
+			identities[identity.validateFuncPackage+"."+identity.validateFuncName] = *identity
+		}
+	}
+
+	untypedIdentities := make(map[string]resourceIdentity)
+	for _, service := range provider.SupportedUntypedServices() {
+		serviceIdentities, err := g.resourceIdentitiesForUntypedService(service)
+		if err != nil {
+			return fmt.Errorf("determining the Resource Identities for the Service %q: %+v", service.Name(), err)
+		}
+		for resourceType, identity := range serviceIdentities {
+			untypedIdentities[resourceType] = identity
+		}
+	}
+
+	keys := make([]string, 0)
+	for k := range identities {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	untypedKeys := make([]string, 0)
+	for k := range untypedIdentities {
+		untypedKeys = append(untypedKeys, k)
+	}
+	sort.Strings(untypedKeys)
+
+	packages := make([]string, 0)
+	for _, identity := range identities {
+		packages = append(packages, identity.validateFuncPackage, identity.idPackage)
+	}
+	for _, identity := range untypedIdentities {
+		packages = append(packages, identity.idPackage)
+	}
+	aliases := packageAliases(packages)
+
+	imports := make([]string, 0)
+	for _, pkg := range uniqueSorted(append(packages, "github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids", modulePath+"/internal/tf/pluginsdk")) {
+		// only packages with an ambiguous name are aliased
+		if alias, ok := aliases[pkg]; ok && alias != pkg[strings.LastIndex(pkg, "/")+1:] {
+			imports = append(imports, fmt.Sprintf("\t%s %q", alias, pkg))
+			continue
+		}
+		imports = append(imports, fmt.Sprintf("\t%q", pkg))
+	}
+
+	lines := make([]string, 0)
+	for _, k := range keys {
+		identity := identities[k]
+		lines = append(lines, fmt.Sprintf("\tpluginsdk.RegisterResourceIdentity(%s.%s, &%s.%s{})", aliases[identity.validateFuncPackage], identity.validateFuncName, aliases[identity.idPackage], identity.idTypeName))
+	}
+
+	untypedLines := make([]string, 0)
+	for _, k := range untypedKeys {
+		identity := untypedIdentities[k]
+		untypedLines = append(untypedLines, fmt.Sprintf("\t\t%q: &%s.%s{},", k, aliases[identity.idPackage], identity.idTypeName))
+	}
+
+	contents := fmt.Sprintf(`// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+%s
+)
+
+// registerResourceIdentities registers the Resource ID types used by Typed Resources which don't define a Resource
+// Identity, so that a Resource Identity is generated for these Resources
+func registerResourceIdentities() {
+%s
+}
+
+// untypedResourceIdentities returns the Resource ID types validated by the Importer of Untyped Resources which don't
+// define a Resource Identity, so that a Resource Identity is generated for these Resources
+func untypedResourceIdentities() map[string]resourceids.ResourceId {
+	return map[string]resourceids.ResourceId{
+%s
+	}
+}
+`, strings.Join(imports, "\n"), strings.Join(lines, "\n"), strings.Join(untypedLines, "\n"))
+
+	formatted, err := format.Source([]byte(contents))
+	if err != nil {
+		return fmt.Errorf("formatting %q: %+v", outputFileName, err)
+	}
+
+	return writeToFile(outputFileName, string(formatted))
+}
+
+// resourceIdentityForResource returns the Resource ID type validated by the IDValidationFunc of the resource, or nil
+// when this can't be determined
+func (g resourceIdentitiesGenerator) resourceIdentityForResource(resource sdk.Resource) (*resourceIdentity, error) {
+	validateFunc := resource.IDValidationFunc()
+	if validateFunc == nil {
+		return nil, nil
+	}
+
+	// closures (e.g. `func1`) can't be referenced, so are skipped below since these don't match the naming convention
+	fullName := runtime.FuncForPC(reflect.ValueOf(validateFunc).Pointer()).Name()
+	idx := strings.LastIndex(fullName, ".")
+	if idx == -1 {
+		return nil, nil
+	}
+	validateFuncPackage := fullName[:idx]
+	validateFuncName := fullName[idx+1:]
+
+	identity := resourceIdentity{
+		validateFuncPackage: validateFuncPackage,
+		validateFuncName:    validateFuncName,
+	}
+
+	// the validation functions within the Services are defined in the `validate` package, with the Resource ID types
+	// being defined in the sibling `parse` package
+	if strings.HasPrefix(validateFuncPackage, modulePath+"/") && strings.HasSuffix(validateFuncPackage, "/validate") {
+		matches := localValidationFuncRegex.FindStringSubmatch(validateFuncName)
+		if len(matches) != 2 {
+			return nil, nil
+		}
+
+		identity.idPackage = strings.TrimSuffix(validateFuncPackage, "/validate") + "/parse"
+		identity.idTypeName = matches[1] + "Id"
+	} else {
+		matches := sdkValidationFuncRegex.FindStringSubmatch(validateFuncName)
+		if len(matches) != 2 {
+			return nil, nil
+		}
+
+		identity.idPackage = validateFuncPackage
+		identity.idTypeName = matches[1] + "Id"
+	}
+
+	supported, err := g.resourceIdSupportsIdentity(identity.idPackage, identity.idTypeName)
+	if err != nil {
+		return nil, err
+	}
+	if !supported {
+		return nil, nil
+	}
+
+	return &identity, nil
+}
+
+// resourceIdentitiesForUntypedService returns the Resource ID types validated by the Importer of the Untyped Resources
+// within the Service, keyed by the name of the Resource. These are determined from the source of the Service, since the
+// Importer validates the Resource ID using a closure - as such only Resources registered directly within the
+// `SupportedResources` func, whose Importer only parses the Resource ID, are included.
+func (g resourceIdentitiesGenerator) resourceIdentitiesForUntypedService(service sdk.UntypedServiceRegistration) (map[string]resourceIdentity, error) {
+	pkg := reflect.TypeOf(service).PkgPath()
+	directory := filepath.Join(g.rootDirectory, strings.TrimPrefix(pkg, modulePath+"/"))
+
+	files, err := filepath.Glob(filepath.Join(directory, "*.go"))
+	if err != nil {
+		return nil, fmt.Errorf("listing the files within %q: %+v", directory, err)
+	}
+
+	contents := make(map[string]string)
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+
+		v, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("reading %q: %+v", file, err)
+		}
+		contents[file] = string(v)
+	}
+
+	resourceFuncs := make(map[string]string)
+	for _, v := range contents {
+		idx := strings.Index(v, ") SupportedResources() map[string]*pluginsdk.Resource {")
+		if idx == -1 {
+			continue
+		}
+
+		body := v[idx:]
+		if end := strings.Index(body, "\n}\n"); end != -1 {
+			body = body[:end]
+		}
+
+		for _, matches := range untypedResourceRegex.FindAllStringSubmatch(body, -1) {
+			resourceFuncs[matches[1]] = matches[2]
+		}
+		break
+	}
+
+	output := make(map[string]resourceIdentity)
+	for resourceType, funcName := range resourceFuncs {
+		if _, ok := service.SupportedResources()[resourceType]; !ok {
+			continue
+		}
+
+		funcRegex := regexp.MustCompile(fmt.Sprintf(`(?m)^func %s\(\) \*pluginsdk\.Resource \{`, regexp.QuoteMeta(funcName)))
+		for file, v := range contents {
+			loc := funcRegex.FindStringIndex(v)
+			if loc == nil {
+				continue
+			}
+
+			body := v[loc[0]:]
+			if end := strings.Index(body, "\n}\n"); end != -1 {
+				body = body[:end]
+			}
+
+			matches := untypedImporterRegex.FindStringSubmatch(body)
+			if len(matches) != 3 {
+				break
+			}
+
+			identity, err := g.resourceIdentityForParseFunc(file, matches[1], matches[2])
+			if err != nil {
+				return nil, fmt.Errorf("determining the Resource Identity for %q: %+v", resourceType, err)
+			}
+			if identity != nil {
+				output[resourceType] = *identity
+			}
+			break
+		}
+	}
+
+	return output, nil
+}
+
+// resourceIdentityForParseFunc returns the Resource ID type parsed by the function `funcName` within the package imported
+// as `alias` in the file `fileName`, or nil when this can't be determined
+func (g resourceIdentitiesGenerator) resourceIdentityForParseFunc(fileName string, alias string, funcName string) (*resourceIdentity, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), fileName, nil, parser.ImportsOnly)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the imports of %q: %+v", fileName, err)
+	}
+
+	pkg := ""
+	for _, v := range file.Imports {
+		path := strings.Trim(v.Path.Value, `"`)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if v.Name != nil {
+			name = v.Name.Name
+		}
+		if name == alias {
+			pkg = path
+			break
+		}
+	}
+	if pkg == "" {
+		return nil, nil
+	}
+
+	identity := resourceIdentity{
+		idPackage: pkg,
+	}
+
+	// the parse functions within the Services are defined in the `parse` package alongside the Resource ID types
+	if strings.HasPrefix(pkg, modulePath+"/") && strings.HasSuffix(pkg, "/parse") {
+		matches := localValidationFuncRegex.FindStringSubmatch(funcName)
+		if len(matches) != 2 {
+			return nil, nil
+		}
+		identity.idTypeName = matches[1] + "Id"
+	} else {
+		matches := sdkParseFuncRegex.FindStringSubmatch(funcName)
+		if len(matches) != 2 {
+			return nil, nil
+		}
+		identity.idTypeName = matches[1] + "Id"
+	}
+
+	supported, err := g.resourceIdSupportsIdentity(identity.idPackage, identity.idTypeName)
+	if err != nil {
+		return nil, err
+	}
+	if !supported {
+		return nil, nil
+	}
+
+	return &identity, nil
+}
+
+// resourceIdSupportsIdentity checks the source of the package `pkg` to determine whether the type `typeName` is a
+// Resource ID which can be represented as a Resource Identity
+func (g resourceIdentitiesGenerator) resourceIdSupportsIdentity(pkg string, typeName string) (bool, error) {
+	directory := filepath.Join(g.rootDirectory, "vendor", pkg)
+	if strings.HasPrefix(pkg, modulePath+"/") {
+		directory = filepath.Join(g.rootDirectory, strings.TrimPrefix(pkg, modulePath+"/"))
+	}
+
+	files, err := filepath.Glob(filepath.Join(directory, "*.go"))
+	if err != nil {
+		return false, fmt.Errorf("listing the files within %q: %+v", directory, err)
+	}
+
+	segmentsFuncRegex := regexp.MustCompile(fmt.Sprintf(`func \(\w+ \*?%s\) Segments\(\) \[\]resourceids\.Segment \{`, regexp.QuoteMeta(typeName)))
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+
+		contents, err := os.ReadFile(file)
+		if err != nil {
+			return false, fmt.Errorf("reading %q: %+v", file, err)
+		}
+
+		loc := segmentsFuncRegex.FindIndex(contents)
+		if loc == nil {
+			continue
+		}
+
+		segmentsFunc := string(contents[loc[0]:])
+		if end := strings.Index(segmentsFunc, "\n}\n"); end != -1 {
+			segmentsFunc = segmentsFunc[:end]
+		}
+
+		// Resource IDs containing a Scope or Constant can't be rebuilt from the Resource Identity
+		return !strings.Contains(segmentsFunc, "resourceids.ScopeSegment(") && !strings.Contains(segmentsFunc, "resourceids.ConstantSegment("), nil
+	}
+
+	return false, nil
+}
+
+// packageAliases returns a unique import alias for each of the packages, since several versions of an API
+// (or several `parse` packages) can be imported
+func packageAliases(packages []string) map[string]string {
+	byName := make(map[string][]string)
+	for _, pkg := range uniqueSorted(packages) {
+		name := pkg[strings.LastIndex(pkg, "/")+1:]
+		byName[name] = append(byName[name], pkg)
+	}
+
+	nonAlphanumeric := regexp.MustCompile(`[^A-Za-z0-9]+`)
+	aliases := make(map[string]string)
+	for name, pkgs := range byName {
+		// when the package name is ambiguous, the parent directories are included until the alias is unique
+		for depth := 1; ; depth++ {
+			candidates := make(map[string]string)
+			for _, pkg := range pkgs {
+				segments := strings.Split(pkg, "/")
+				alias := nonAlphanumeric.ReplaceAllString(name, "")
+				if len(pkgs) > 1 {
+					parents := segments[max(len(segments)-1-depth, 0) : len(segments)-1]
+					alias = fmt.Sprintf("%s_%s", alias, nonAlphanumeric.ReplaceAllString(strings.Join(parents, "_"), "_"))
+				}
+				candidates[alias] = pkg
+			}
+
+			if len(candidates) == len(pkgs) {
+				for alias, pkg := range candidates {
+					aliases[pkg] = alias
+				}
+				break
+			}
+		}
+	}
+
+	return aliases
+}
+
+func uniqueSorted(input []string) []string {
+	output := make([]string, 0)
+	for _, v := range input {
+		if !contains(output, v) {
+			output = append(output, v)
+		}
+	}
+	sort.Strings(output)
+	return output
+}
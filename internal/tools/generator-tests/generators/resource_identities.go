@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generators
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/iancoleman/strcase"
+	"github.com/mitchellh/cli"
+)
+
+type ResourceIdentitiesCommand struct {
+	Ui cli.Ui
+}
+
+type resourceIdentitiesData struct {
+	ServicesDirectory string
+	Force             bool
+}
+
+// resourceWithIdentity is a Resource which the Resource Identity test can be generated for
+type resourceWithIdentity struct {
+	resourceName       string
+	servicePackageName string
+	resource           *schema.Resource
+
+	// nameIdentityValue is the Resource Identity value for the last segment of the Resource ID, which is exposed in the
+	// schema as `name` (when known)
+	nameIdentityValue string
+}
+
+var _ cli.Command = &ResourceIdentitiesCommand{}
+
+func (c *ResourceIdentitiesCommand) Help() string {
+	return `
+Usage: resourceidentities [args]
+Optional args:
+	- services-directory [string]
+		the path to the directory containing the Service Packages, which the generated files are written to. Defaults to '../../services'.
+	- force [bool]
+		overwrite the Resource Identity test for a resource if it already exists. Defaults to 'false'.
+
+Example:
+resourceidentities -services-directory ../services
+
+Caveats and TODOs:
+Generates the Resource Identity test (as the 'resourceidentity' command does) for each resource which has been given a Resource
+Identity automatically - that is Typed Resources which don't implement 'sdk.ResourceWithIdentity' - and for each Untyped Resource
+with a Resource Identity (e.g. using 'pluginsdk.WithResourceIdentity'). The arguments are determined from the Resource Identity and the schema of the resource:
+	- Resource Identity values exposed in the schema are compared against the schema value, with the value for the last segment of
+	  the Resource ID compared against 'name' - values exposed as a Resource ID (e.g. 'management_group_id') are checked to be
+	  contained within the Resource ID.
+	- when only the 'subscription_id' isn't exposed in the schema, this is compared against the Primary Subscription.
+	- otherwise, when the resource references a parent resource (a Required and ForceNew '_id' property), the remaining values are compared against the parent resource ID.
+Resources whose arguments can't be determined, or whose test package doesn't contain a 'basic' config (see the 'resourceidentity'
+command) are skipped. Existing tests are left untouched unless '-force' is specified, since these may have been generated with
+additional arguments.
+`
+}
+
+func (c *ResourceIdentitiesCommand) Synopsis() string {
+	return "Generates the Resource Identity tests for each resource which has been given a Resource Identity automatically"
+}
+
+func (c *ResourceIdentitiesCommand) Run(args []string) int {
+	data := &resourceIdentitiesData{}
+
+	argSet := flag.NewFlagSet("ris", flag.ExitOnError)
+	argSet.StringVar(&data.ServicesDirectory, "services-directory", "../../services", "(Optional) the path to the directory containing the Service Packages.")
+	argSet.BoolVar(&data.Force, "force", false, "(Optional) overwrite the Resource Identity test for a resource if it already exists.")
+	if err := argSet.Parse(args); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+
+	resources, err := resourcesWithAutomaticIdentity()
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 2
+	}
+
+	for _, r := range resources {
+		testData, err := data.resourceIdentityDataFor(r)
+		if err != nil {
+			c.Ui.Warn(fmt.Sprintf("skipping %q: %+v", r.resourceName, err))
+			continue
+		}
+		if testData == nil {
+			continue
+		}
+
+		if err := testData.exec(); err != nil {
+			c.Ui.Error(err.Error())
+
+			log.Println(err)
+			return 2
+		}
+	}
+
+	return 0
+}
+
+// resourcesWithAutomaticIdentity returns the Typed Resources which have been given a Resource Identity automatically and
+// the Untyped Resources with a Resource Identity, sorted by the name of the resource
+func resourcesWithAutomaticIdentity() ([]resourceWithIdentity, error) {
+	output := make([]resourceWithIdentity, 0)
+	resources := provider.AzureProvider().ResourcesMap
+
+	for _, service := range provider.SupportedTypedServices() {
+		for _, r := range service.Resources() {
+			// these define the Resource Identity and so are generated using the 'resourceidentity' command
+			if _, ok := r.(sdk.ResourceWithIdentity); ok {
+				continue
+			}
+
+			resource, ok := resources[r.ResourceType()]
+			if !ok {
+				return nil, fmt.Errorf("the Resource %q was not registered in the Provider", r.ResourceType())
+			}
+			if resource.Identity == nil {
+				continue
+			}
+
+			output = append(output, resourceWithIdentity{
+				resourceName:       strings.TrimPrefix(r.ResourceType(), "azurerm_"),
+				servicePackageName: filepath.Base(reflect.TypeOf(r).PkgPath()),
+				resource:           resource,
+				nameIdentityValue:  nameIdentityValue(r),
+			})
+		}
+	}
+
+	for _, service := range provider.SupportedUntypedServices() {
+		for resourceType := range service.SupportedResources() {
+			// the Resource Identity is generated for these by the Provider, so the registered Resource is used
+			resource, ok := resources[resourceType]
+			if !ok {
+				return nil, fmt.Errorf("the Resource %q was not registered in the Provider", resourceType)
+			}
+			if resource.Identity == nil {
+				continue
+			}
+
+			output = append(output, resourceWithIdentity{
+				resourceName:       strings.TrimPrefix(resourceType, "azurerm_"),
+				servicePackageName: filepath.Base(reflect.TypeOf(service).PkgPath()),
+				resource:           resource,
+			})
+		}
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].resourceName < output[j].resourceName
+	})
+
+	return output, nil
+}
+
+// nameIdentityValue returns the name of the Resource Identity value for the last segment of the Resource ID of a Typed
+// Resource which has been given a Resource Identity automatically
+func nameIdentityValue(r sdk.Resource) string {
+	id, ok := pluginsdk.ResourceIdentityForValidateFunc(r.IDValidationFunc())
+	if !ok {
+		return ""
+	}
+
+	segments := id.Segments()
+	last := segments[len(segments)-1]
+	if last.Type != resourceids.UserSpecifiedSegmentType {
+		return ""
+	}
+
+	// the last segment is named `name` when this is a `*Name` segment, matching the Resource Identity schema
+	if strings.HasSuffix(last.Name, "Name") {
+		return "name"
+	}
+	return strcase.ToSnake(last.Name)
+}
+
+// resourceIdentityDataFor determines the arguments for the Resource Identity test for the resource, returning nil when
+// the test already exists
+func (d *resourceIdentitiesData) resourceIdentityDataFor(r resourceWithIdentity) (*resourceIdentityData, error) {
+	output := &resourceIdentityData{
+		ResourceName:       r.resourceName,
+		ServicePackageName: r.servicePackageName,
+		ServicesDirectory:  d.ServicesDirectory,
+		PropertyNameMap:    make(map[string]string),
+		KnownValueMap:      make(map[string]string),
+		CompareValueMap:    make(map[string]string),
+		TestName:           "basic",
+	}
+
+	if _, err := os.Stat(output.outputPath()); err == nil && !d.Force {
+		return nil, nil
+	}
+
+	testResourceType, err := d.testResourceType(r)
+	if err != nil {
+		return nil, err
+	}
+	output.TestResourceType = testResourceType
+
+	unmapped := make([]string, 0)
+	for name := range r.resource.Identity.SchemaMap() {
+		if name == r.nameIdentityValue {
+			// the value for the last segment of the Resource ID can't be compared against the parent Resource ID
+			if _, ok := r.resource.Schema["name"]; !ok {
+				return nil, fmt.Errorf("the Resource Identity value %q for the last segment of the Resource ID isn't exposed in the schema", name)
+			}
+
+			output.PropertyNameMap[name] = "name"
+			continue
+		}
+
+		if _, ok := r.resource.Schema[name]; ok {
+			// the schema contains the full Resource ID, e.g. `management_group_id`, whereas the Resource Identity
+			// contains the name
+			if strings.HasSuffix(name, "_id") {
+				output.CompareValueMap[name] = name
+				continue
+			}
+
+			output.PropertyNameMap[name] = name
+			continue
+		}
+
+		unmapped = append(unmapped, name)
+	}
+	sort.Strings(unmapped)
+
+	if len(unmapped) == 0 {
+		return output, nil
+	}
+
+	if len(unmapped) == 1 && unmapped[0] == "subscription_id" {
+		output.KnownValueMap["subscription_id"] = "data.Subscriptions.Primary"
+		return output, nil
+	}
+
+	if parentIdProperty := parentResourceIdProperty(r.resource.Schema, unmapped); parentIdProperty != "" {
+		for _, name := range unmapped {
+			output.CompareValueMap[name] = parentIdProperty
+		}
+		return output, nil
+	}
+
+	return nil, fmt.Errorf("the Resource Identity values %q aren't exposed in the schema", strings.Join(unmapped, ", "))
+}
+
+// parentResourceIdProperty returns the name of the property referencing the parent resource (a Required and ForceNew
+// property ending in `_id`), preferring the property matching the names of the Resource Identity values which aren't
+// exposed in the schema (e.g. `host_group_name` matches `dedicated_host_group_id`)
+func parentResourceIdProperty(resourceSchema map[string]*schema.Schema, unmapped []string) string {
+	candidates := make([]string, 0)
+	for name, v := range resourceSchema {
+		if strings.HasSuffix(name, "_id") && v.Type == schema.TypeString && v.Required && v.ForceNew {
+			candidates = append(candidates, name)
+		}
+	}
+	sort.Strings(candidates)
+
+	for _, name := range unmapped {
+		if name == "subscription_id" || name == "resource_group_name" {
+			continue
+		}
+
+		prefix := strings.TrimSuffix(name, "_name")
+		for _, candidate := range candidates {
+			if strings.HasSuffix(strings.TrimSuffix(candidate, "_id"), prefix) {
+				return candidate
+			}
+		}
+	}
+
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+
+	return ""
+}
+
+// testResourceType returns the name of the test resource type for the resource, which must contain the 'basic' config
+// used by the generated test. The name is matched case-insensitively, since the casing of the test resource type
+// doesn't necessarily match the casing generated from the name of the resource (e.g. `WebPubSubSocketIOResource`).
+func (d *resourceIdentitiesData) testResourceType(r resourceWithIdentity) (string, error) {
+	files, err := filepath.Glob(filepath.Join(d.ServicesDirectory, r.servicePackageName, "*_test.go"))
+	if err != nil {
+		return "", fmt.Errorf("listing the test files for %q: %+v", r.servicePackageName, err)
+	}
+
+	expected := strcase.ToCamel(r.resourceName) + "Resource"
+	typeRegex := regexp.MustCompile(fmt.Sprintf(`(?mi)^type (%s) struct`, expected))
+
+	// otherwise the test resource type used by the 'basic' test is used, e.g. `r := ExampleTestResource{}`
+	basicTestRegex := regexp.MustCompile(fmt.Sprintf(`(?mi)^func TestAcc%s_basic\(t \*testing\.T\) \{\n(?:.*\n){0,3}?\s*r := (\w+)\{\}`, strcase.ToCamel(r.resourceName)))
+
+	contents := make([][]byte, 0)
+	typeNames := make([]string, 0)
+	for _, file := range files {
+		v, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("reading %q: %+v", file, err)
+		}
+		contents = append(contents, v)
+
+		if matches := typeRegex.FindSubmatch(v); len(matches) == 2 {
+			typeNames = append([]string{string(matches[1])}, typeNames...)
+		}
+		if matches := basicTestRegex.FindSubmatch(v); len(matches) == 2 {
+			typeNames = append(typeNames, string(matches[1]))
+		}
+	}
+
+	for _, typeName := range typeNames {
+		basicRegex := regexp.MustCompile(fmt.Sprintf(`(?m)^func \(\w+ %s\) basic\(\w+ acceptance\.TestData\) string \{`, typeName))
+		for _, v := range contents {
+			if basicRegex.Match(v) {
+				return typeName, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("the test type %q with a 'basic' config was not found in the package %q", expected, r.servicePackageName)
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"strings"
 	"text/template"
 
@@ -33,6 +34,12 @@ type resourceIdentityData struct {
 	CompareValues      string
 	CompareValueMap    map[string]string
 	TestName           string
+
+	// ServicesDirectory overrides the directory containing the Service Packages, see riOutputFileFmt
+	ServicesDirectory string
+
+	// TestResourceType overrides the name of the test resource type, which otherwise is generated from the ResourceName
+	TestResourceType string
 }
 
 var _ cli.Command = &ResourceIdentityCommand{}
@@ -177,7 +184,7 @@ func (d *resourceIdentityData) parseArgs(args []string) (errors []error) {
 func (d *resourceIdentityData) exec() error {
 	tpl := template.Must(template.New("identity_test.gotpl").Funcs(TplFuncMap).ParseFS(Templatedir, "templates/identity_test.gotpl"))
 
-	outputPath := fmt.Sprintf(riOutputFileFmt, d.ServicePackageName, d.ResourceName)
+	outputPath := d.outputPath()
 
 	f, err := os.Create(outputPath)
 	if err != nil {
@@ -201,3 +208,11 @@ func (d *resourceIdentityData) exec() error {
 
 	return nil
 }
+
+func (d *resourceIdentityData) outputPath() string {
+	if d.ServicesDirectory != "" {
+		return filepath.Join(d.ServicesDirectory, d.ServicePackageName, fmt.Sprintf("%s_resource_identity_gen_test.go", d.ResourceName))
+	}
+
+	return fmt.Sprintf(riOutputFileFmt, d.ServicePackageName, d.ResourceName)
+}
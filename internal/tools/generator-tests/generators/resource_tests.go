@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generators
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"reflect"
+	"runtime"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/generator-tests/helpers"
+	"github.com/mitchellh/cli"
+)
+
+var rtOutputFileFmt = "../../services/%s/%s_resource_test.go"
+
+type ResourceTestsCommand struct {
+	Ui cli.Ui
+}
+
+type resourceTestsData struct {
+	ResourceName       string
+	ServicePackageName string
+	Force              bool
+
+	// populated from the Typed Resource
+	IDImportPath     string
+	IDPackage        string
+	IDParseFunc      string
+	ClientExpression string
+	GetOptions       string
+	SupportsUpdate   bool
+	BasicConfig      string
+	CompleteConfig   string
+	RequiresImport   string
+}
+
+var _ cli.Command = &ResourceTestsCommand{}
+
+func (c *ResourceTestsCommand) Help() string {
+	return `
+Usage: resourcetests [args]
+Required args:
+	- resource-name [string]
+		the name of the Typed Resource to generate the acceptance tests for, the 'azurerm_' prefix is not required.
+	- service-package-name [string]
+		the name of the Service Package the resource belongs to. This forms part of the output path for the generated file.
+
+Optional args:
+	- force [bool]
+		overwrite the test file for the resource if it already exists. Defaults to 'false'.
+
+Example:
+resourcetests -resource-name gallery_application -service-package-name compute
+
+Caveats and TODOs:
+The generated file is a starting point - the 'basic' config contains the Required arguments and the 'complete' config contains all
+arguments which can be set, populated with placeholder values which pass the validation for each argument. Values which could not
+be determined (e.g. the IDs of other resources) are marked with a TODO comment and should be replaced with references to resources
+defined in the 'template' config.
+The 'update' test is only generated for resources which support being updated.
+`
+}
+
+func (c *ResourceTestsCommand) Synopsis() string {
+	return "Scaffolds the basic, complete, requiresImport and update acceptance tests for a Typed Resource"
+}
+
+func (c *ResourceTestsCommand) Run(args []string) int {
+	data := &resourceTestsData{}
+
+	if err := data.parseArgs(args); err != nil {
+		for _, e := range err {
+			c.Ui.Error(e.Error())
+		}
+
+		return 1
+	}
+
+	if err := data.exec(); err != nil {
+		c.Ui.Error(err.Error())
+
+		log.Println(err)
+		return 2
+	}
+
+	return 0
+}
+
+func (d *resourceTestsData) parseArgs(args []string) (errors []error) {
+	argSet := flag.NewFlagSet("rt", flag.ExitOnError)
+
+	argSet.StringVar(&d.ResourceName, "resource-name", "", "(Required) the name of the resource to generate the acceptance tests for.")
+	argSet.StringVar(&d.ServicePackageName, "service-package-name", "", "(Required) the name of the service package to write the generated tests to.")
+	argSet.BoolVar(&d.Force, "force", false, "(Optional) overwrite the test file for the resource if it already exists.")
+
+	if err := argSet.Parse(args); err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	switch {
+	case d.ResourceName == "":
+		errors = append(errors, fmt.Errorf("resource name is required"))
+	case d.ServicePackageName == "":
+		errors = append(errors, fmt.Errorf("service-package-name is required"))
+	}
+
+	d.ResourceName = strings.TrimPrefix(d.ResourceName, "azurerm_")
+
+	return
+}
+
+func (d *resourceTestsData) exec() error {
+	resource, err := findTypedResource("azurerm_" + d.ResourceName)
+	if err != nil {
+		return err
+	}
+
+	if err := d.populate(resource); err != nil {
+		return err
+	}
+
+	outputPath := fmt.Sprintf(rtOutputFileFmt, d.ServicePackageName, d.ResourceName)
+	if _, err := os.Stat(outputPath); err == nil && !d.Force {
+		return fmt.Errorf("the test file %s already exists, use `-force` to overwrite it", outputPath)
+	}
+
+	tpl := template.Must(template.New("resource_test.gotpl").Funcs(TplFuncMap).ParseFS(Templatedir, "templates/resource_test.gotpl"))
+
+	f, err := os.Create(outputPath)
+	if err != nil {
+		return fmt.Errorf("failed opening output resource file for writing: %+v", err.Error())
+	}
+	defer func(f *os.File) {
+		err := f.Close()
+		if err != nil {
+			log.Println("failed closing output resource file for writing:", err.Error())
+			os.Exit(3)
+		}
+	}(f)
+
+	if err := tpl.Execute(f, d); err != nil {
+		return fmt.Errorf("failed writing output test file (%s): %s", outputPath, err.Error())
+	}
+
+	if err := helpers.GoFmt(outputPath); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func findTypedResource(resourceType string) (sdk.Resource, error) {
+	for _, service := range provider.SupportedTypedServices() {
+		for _, r := range service.Resources() {
+			if r.ResourceType() == resourceType {
+				return r, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("the Typed Resource %q was not found - only Typed Resources are supported", resourceType)
+}
+
+// populate sets the ID parser, client and configs for the resource into the template data
+func (d *resourceTestsData) populate(r sdk.Resource) error {
+	d.IDImportPath, d.IDParseFunc = idParserForResource(r)
+	if d.IDImportPath != "" {
+		d.IDPackage = path.Base(d.IDImportPath)
+		d.ClientExpression, d.GetOptions = clientForIDPackage(d.IDImportPath, d.IDPackage)
+	}
+
+	_, d.SupportsUpdate = r.(sdk.ResourceWithUpdate)
+
+	wrapper := sdk.NewResourceWrapper(r)
+	resource, err := wrapper.Resource()
+	if err != nil {
+		return fmt.Errorf("building the schema for %s: %+v", r.ResourceType(), err)
+	}
+
+	d.BasicConfig = buildConfig(resource.Schema, configModeBasic)
+	d.CompleteConfig = buildConfig(resource.Schema, configModeComplete)
+	d.RequiresImport = buildRequiresImportConfig(resource.Schema, r.ResourceType())
+
+	return nil
+}
+
+// idParserForResource returns the import path and name of the function used to parse the ID of the resource - using the
+// Resource ID type when the resource has an Identity, or else the function used to validate the Resource ID
+func idParserForResource(r sdk.Resource) (importPath string, parseFunc string) {
+	if v, ok := r.(sdk.ResourceWithIdentity); ok {
+		t := reflect.TypeOf(v.Identity())
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		return t.PkgPath(), "Parse" + strings.TrimSuffix(t.Name(), "Id") + "ID"
+	}
+
+	fn := runtime.FuncForPC(reflect.ValueOf(r.IDValidationFunc()).Pointer())
+	if fn == nil {
+		return "", ""
+	}
+
+	// e.g. `github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-03/galleryapplications.ValidateApplicationID`
+	fullName := fn.Name()
+	idx := strings.LastIndex(fullName, ".")
+	if idx < 0 || strings.Contains(fullName[idx:], "func") {
+		return "", ""
+	}
+	importPath, name := fullName[:idx], fullName[idx+1:]
+
+	switch {
+	case path.Base(importPath) == "validate":
+		// validation functions within the `validate` package of a service have the same name as the parse function
+		return path.Join(path.Dir(importPath), "parse"), name
+	case strings.HasPrefix(name, "Validate"):
+		return importPath, "Parse" + strings.TrimPrefix(name, "Validate")
+	}
+
+	return "", ""
+}
+
+// clientForIDPackage returns the expression to access the client within the same package as the Resource ID (which is
+// the case for the go-azure-sdk), along with the options for the Get method when these are required
+func clientForIDPackage(importPath, pkg string) (expression string, getOptions string) {
+	var find func(t reflect.Type, expr string, depth int) (string, string)
+	find = func(t reflect.Type, expr string, depth int) (string, string) {
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct || depth > 3 {
+			return "", ""
+		}
+
+		if t.PkgPath() == importPath {
+			method, ok := reflect.PointerTo(t).MethodByName("Get")
+			if !ok {
+				return "", ""
+			}
+			// the method includes the receiver, followed by the context and Resource ID
+			if method.Type.NumIn() == 4 {
+				return expr, pkg + ".DefaultGetOperationOptions()"
+			}
+			return expr, ""
+		}
+
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if expression, options := find(field.Type, expr+"."+field.Name, depth+1); expression != "" {
+				return expression, options
+			}
+		}
+		return "", ""
+	}
+
+	return find(reflect.TypeOf(clients.Client{}), "client", 0)
+}
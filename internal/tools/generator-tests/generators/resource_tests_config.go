@@ -0,0 +1,351 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generators
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// the configs are used within `fmt.Sprintf` in the generated test, where `%[1]s` is the template config,
+// `%[2]d` is `data.RandomInteger` and `%[3]s` is `data.RandomString`
+const (
+	randomIntegerVerb = "%[2]d"
+	randomStringVerb  = "%[3]s"
+
+	// sample values used to check the placeholder values are valid
+	sampleRandomInteger = "24010112345699"
+	sampleRandomString  = "abcde"
+)
+
+type configMode int
+
+const (
+	// configModeBasic includes the Required arguments only
+	configModeBasic configMode = iota
+
+	// configModeComplete includes all of the arguments which can be set
+	configModeComplete
+)
+
+// stringPlaceholders are tried in order until one passes the validation for the argument
+var stringPlaceholders = []string{
+	"acctest-" + randomIntegerVerb,
+	"acctest" + randomIntegerVerb,
+	"acctest" + randomStringVerb,
+	"00000000-0000-0000-0000-000000000000",
+	"https://www.example.com",
+	"10.0.0.0/16",
+	"10.0.0.4",
+	"2030-01-01T00:00:00Z",
+	"P1D",
+	"PT1H",
+	"admin@example.com",
+}
+
+// placeholderHints are the placeholders tried first for arguments with the suffix, since these often only validate the value isn't empty
+var placeholderHints = map[string]string{
+	"_uri":   "https://www.example.com",
+	"_url":   "https://www.example.com",
+	"_email": "admin@example.com",
+}
+
+var (
+	stringInSliceRegexp    = regexp.MustCompile(`to be one of \[(.*)\], got`)
+	intRangeRegexp         = regexp.MustCompile(`to be in the range \((-?\d+) - (-?\d+)\)`)
+	intAtLeastRegexp       = regexp.MustCompile(`to be at least \((-?\d+)\)`)
+	intInSliceRegexp       = regexp.MustCompile(`to be one of \[(-?\d+)`)
+	quotedValueRegexp      = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+	expectedResourceRegexp = regexp.MustCompile(`ID that matched(?: \(containing \d+ segments\))?:\s+> (\S+)`)
+)
+
+// buildConfig returns the HCL for the arguments of the resource, which are indented for use within the resource block
+func buildConfig(schema map[string]*pluginsdk.Schema, mode configMode) string {
+	var sb strings.Builder
+	writeBody(&sb, schema, mode, 1)
+	return sb.String()
+}
+
+// buildRequiresImportConfig returns the HCL for the Required arguments of a resource which imports the `test` resource
+func buildRequiresImportConfig(schema map[string]*pluginsdk.Schema, resourceType string) string {
+	lines := make([]configLine, 0)
+	var blocks strings.Builder
+	for _, name := range orderedArguments(schema, configModeBasic) {
+		s := schema[name]
+		if elem, ok := blockElem(s); ok {
+			writeBlock(&blocks, name, elem.Schema, configModeBasic, 1)
+			continue
+		}
+		lines = append(lines, configLine{name: name, value: fmt.Sprintf("%s.test.%s", resourceType, name)})
+	}
+
+	var sb strings.Builder
+	writeLines(&sb, lines, 1)
+	sb.WriteString(blocks.String())
+	return sb.String()
+}
+
+type configLine struct {
+	name    string
+	value   string
+	comment string
+}
+
+func writeBody(sb *strings.Builder, schema map[string]*pluginsdk.Schema, mode configMode, depth int) {
+	lines := make([]configLine, 0)
+	var blocks strings.Builder
+	var tags *configLine
+	for _, name := range orderedArguments(schema, mode) {
+		s := schema[name]
+		if elem, ok := blockElem(s); ok {
+			writeBlock(&blocks, name, elem.Schema, mode, depth)
+			continue
+		}
+
+		value, comment := placeholderValue(name, s, mode, depth)
+		line := configLine{name: name, value: value, comment: comment}
+		if name == "tags" && depth == 1 {
+			// tags are conventionally the last argument of a resource
+			tags = &line
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	writeLines(sb, lines, depth)
+	sb.WriteString(blocks.String())
+	if tags != nil {
+		sb.WriteString("\n")
+		writeLines(sb, []configLine{*tags}, depth)
+	}
+}
+
+func writeBlock(sb *strings.Builder, name string, schema map[string]*pluginsdk.Schema, mode configMode, depth int) {
+	indent := strings.Repeat("  ", depth)
+	sb.WriteString("\n" + indent + name + " {\n")
+	writeBody(sb, schema, mode, depth+1)
+	sb.WriteString(indent + "}\n")
+}
+
+// writeLines writes the attributes with the equals signs aligned, as `terraform fmt` does
+func writeLines(sb *strings.Builder, lines []configLine, depth int) {
+	width := 0
+	for _, line := range lines {
+		width = max(width, len(line.name))
+	}
+
+	indent := strings.Repeat("  ", depth)
+	for _, line := range lines {
+		sb.WriteString(fmt.Sprintf("%s%-*s = %s", indent, width, line.name, line.value))
+		if line.comment != "" {
+			sb.WriteString(" # TODO: " + line.comment)
+		}
+		sb.WriteString("\n")
+	}
+}
+
+func blockElem(s *pluginsdk.Schema) (*pluginsdk.Resource, bool) {
+	if s.Type != pluginsdk.TypeList && s.Type != pluginsdk.TypeSet {
+		return nil, false
+	}
+	elem, ok := s.Elem.(*pluginsdk.Resource)
+	return elem, ok
+}
+
+// orderedArguments returns the names of the arguments to include in the config - in the order `name`, `resource_group_name`,
+// `location`, the remaining Required arguments, the Optional arguments and finally `tags`
+func orderedArguments(schema map[string]*pluginsdk.Schema, mode configMode) []string {
+	names := make([]string, 0, len(schema))
+	for name := range schema {
+		names = append(names, name)
+	}
+
+	priority := func(name string) int {
+		s := schema[name]
+		switch {
+		case name == "name":
+			return 0
+		case name == "resource_group_name":
+			return 1
+		case name == "location":
+			return 2
+		case name == "tags":
+			return 5
+		case s.Required:
+			return 3
+		}
+		return 4
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if pi, pj := priority(names[i]), priority(names[j]); pi != pj {
+			return pi < pj
+		}
+		return names[i] < names[j]
+	})
+
+	included := make(map[string]struct{})
+	res := make([]string, 0)
+	for _, name := range names {
+		s := schema[name]
+		if (!s.Required && !s.Optional) || s.Deprecated != "" {
+			continue
+		}
+
+		if !s.Required {
+			// only one argument within an `ExactlyOneOf` group can be set, and at least one from an `AtLeastOneOf` group must be
+			oneOf := append(append([]string{}, s.ExactlyOneOf...), s.AtLeastOneOf...)
+			anyIncluded := false
+			for _, other := range oneOf {
+				if _, ok := included[argumentName(other)]; ok {
+					anyIncluded = true
+				}
+			}
+			conflicts := false
+			for _, other := range s.ConflictsWith {
+				if _, ok := included[argumentName(other)]; ok {
+					conflicts = true
+				}
+			}
+
+			switch {
+			case conflicts, anyIncluded && len(s.ExactlyOneOf) > 0:
+				continue
+			case mode == configModeBasic && (len(oneOf) == 0 || anyIncluded):
+				continue
+			}
+		}
+
+		included[name] = struct{}{}
+		res = append(res, name)
+	}
+	return res
+}
+
+// argumentName returns the name of the argument from the path used in `ConflictsWith` and `ExactlyOneOf`, e.g. `block.0.name`
+func argumentName(path string) string {
+	return path[strings.LastIndex(path, ".")+1:]
+}
+
+// placeholderValue returns a value for the argument which passes its validation, and a comment when a valid value couldn't be found
+func placeholderValue(name string, s *pluginsdk.Schema, mode configMode, depth int) (string, string) {
+	switch s.Type {
+	case pluginsdk.TypeBool:
+		if v, ok := s.Default.(bool); ok && v {
+			return "false", ""
+		}
+		return "true", ""
+
+	case pluginsdk.TypeInt:
+		return intValue(s)
+
+	case pluginsdk.TypeFloat:
+		return "1.0", ""
+
+	case pluginsdk.TypeString:
+		return stringValue(name, s)
+
+	case pluginsdk.TypeList, pluginsdk.TypeSet:
+		if elem, ok := s.Elem.(*pluginsdk.Schema); ok {
+			value, comment := placeholderValue(name, elem, mode, depth)
+			return "[" + value + "]", comment
+		}
+
+	case pluginsdk.TypeMap:
+		indent := strings.Repeat("  ", depth)
+		key, value := "key", `"value"`
+		if name == "tags" {
+			key, value = "ENV", `"Test"`
+		}
+		return fmt.Sprintf("{\n%s  %s = %s\n%s}", indent, key, value, indent), ""
+	}
+
+	return `"TODO"`, fmt.Sprintf("the type of `%s` is not supported", name)
+}
+
+func intValue(s *pluginsdk.Schema) (string, string) {
+	if s.ValidateFunc == nil {
+		return "1", ""
+	}
+
+	_, errs := s.ValidateFunc(1, "value")
+	if len(errs) == 0 {
+		return "1", ""
+	}
+
+	for _, err := range errs {
+		msg := err.Error()
+		if m := intRangeRegexp.FindStringSubmatch(msg); m != nil {
+			return m[1], ""
+		}
+		if m := intAtLeastRegexp.FindStringSubmatch(msg); m != nil {
+			return m[1], ""
+		}
+		if m := intInSliceRegexp.FindStringSubmatch(msg); m != nil {
+			return m[1], ""
+		}
+	}
+	return "1", "a valid value for this argument could not be determined"
+}
+
+func stringValue(name string, s *pluginsdk.Schema) (string, string) {
+	switch name {
+	case "resource_group_name":
+		return "azurerm_resource_group.test.name", ""
+	case "location":
+		return "azurerm_resource_group.test.location", ""
+	}
+
+	if s.ValidateFunc == nil {
+		if name == "name" || strings.HasSuffix(name, "_name") {
+			return quoted("acctest-" + randomIntegerVerb), ""
+		}
+		return quoted("example"), ""
+	}
+
+	placeholders := stringPlaceholders
+	for suffix, hint := range placeholderHints {
+		if strings.HasSuffix(name, suffix) {
+			placeholders = append([]string{hint}, stringPlaceholders...)
+		}
+	}
+
+	var errs []error
+	for _, placeholder := range placeholders {
+		sample := strings.NewReplacer(randomIntegerVerb, sampleRandomInteger, randomStringVerb, sampleRandomString).Replace(placeholder)
+		if _, errs = s.ValidateFunc(sample, name); len(errs) == 0 {
+			return quoted(placeholder), ""
+		}
+	}
+
+	// otherwise the value can be determined from the validation error, e.g. the possible values or an example Resource ID
+	for _, err := range errs {
+		msg := err.Error()
+		if m := stringInSliceRegexp.FindStringSubmatch(msg); m != nil {
+			if first := quotedValueRegexp.FindString(m[1]); first != "" {
+				if value, err := strconv.Unquote(first); err == nil {
+					return quoted(escapeVerbs(value)), ""
+				}
+			}
+		}
+		if m := expectedResourceRegexp.FindStringSubmatch(msg); m != nil {
+			return quoted(escapeVerbs(m[1])), "reference the ID of a resource defined in the template"
+		}
+	}
+
+	return quoted("TODO"), "a valid value for this argument could not be determined"
+}
+
+func quoted(value string) string {
+	return `"` + value + `"`
+}
+
+// escapeVerbs escapes any `%` within literal values, since the config is used within `fmt.Sprintf`
+func escapeVerbs(value string) string {
+	return strings.ReplaceAll(value, "%", "%%")
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generators
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func TestIntValue(t *testing.T) {
+	testCases := []struct {
+		name            string
+		validateFunc    pluginsdk.SchemaValidateFunc
+		expectedValue   string
+		expectedComment string
+	}{
+		{
+			name:          "no validation",
+			expectedValue: "1",
+		},
+		{
+			name:          "valid",
+			validateFunc:  validation.IntAtLeast(0),
+			expectedValue: "1",
+		},
+		{
+			name:          "range",
+			validateFunc:  validation.IntBetween(5, 10),
+			expectedValue: "5",
+		},
+		{
+			name:          "negative range",
+			validateFunc:  validation.IntBetween(-10, -5),
+			expectedValue: "-10",
+		},
+		{
+			name:          "at least",
+			validateFunc:  validation.IntAtLeast(30),
+			expectedValue: "30",
+		},
+		{
+			name:          "in slice",
+			validateFunc:  validation.IntInSlice([]int{10, 20}),
+			expectedValue: "10",
+		},
+		{
+			name:            "unhandled validation",
+			validateFunc:    validation.IntDivisibleBy(7),
+			expectedValue:   "1",
+			expectedComment: "a valid value for this argument could not be determined",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value, comment := intValue(&pluginsdk.Schema{
+				Type:         pluginsdk.TypeInt,
+				ValidateFunc: tc.validateFunc,
+			})
+			if value != tc.expectedValue {
+				t.Fatalf("expected the value %q but got %q", tc.expectedValue, value)
+			}
+			if comment != tc.expectedComment {
+				t.Fatalf("expected the comment %q but got %q", tc.expectedComment, comment)
+			}
+		})
+	}
+}
+
+func TestStringValue(t *testing.T) {
+	testCases := []struct {
+		name            string
+		argument        string
+		validateFunc    pluginsdk.SchemaValidateFunc
+		expectedValue   string
+		expectedComment string
+	}{
+		{
+			name:          "resource group name",
+			argument:      "resource_group_name",
+			validateFunc:  validation.StringIsNotEmpty,
+			expectedValue: "azurerm_resource_group.test.name",
+		},
+		{
+			name:          "location",
+			argument:      "location",
+			expectedValue: "azurerm_resource_group.test.location",
+		},
+		{
+			name:          "name without validation",
+			argument:      "server_name",
+			expectedValue: `"acctest-%[2]d"`,
+		},
+		{
+			name:          "without validation",
+			argument:      "description",
+			expectedValue: `"example"`,
+		},
+		{
+			name:          "first placeholder",
+			argument:      "name",
+			validateFunc:  validation.StringIsNotEmpty,
+			expectedValue: `"acctest-%[2]d"`,
+		},
+		{
+			name:          "later placeholder",
+			argument:      "principal_id",
+			validateFunc:  validation.IsUUID,
+			expectedValue: `"00000000-0000-0000-0000-000000000000"`,
+		},
+		{
+			name:          "placeholder hint",
+			argument:      "callback_url",
+			validateFunc:  validation.StringIsNotEmpty,
+			expectedValue: `"https://www.example.com"`,
+		},
+		{
+			name:          "in slice",
+			argument:      "sku_name",
+			validateFunc:  validation.StringInSlice([]string{"Standard", "Premium"}, false),
+			expectedValue: `"Standard"`,
+		},
+		{
+			name:          "in slice with a verb",
+			argument:      "percentage",
+			validateFunc:  validation.StringInSlice([]string{"50%", "100%"}, false),
+			expectedValue: `"50%%"`,
+		},
+		{
+			name:            "resource id",
+			argument:        "resource_group_id",
+			validateFunc:    commonids.ValidateResourceGroupID,
+			expectedValue:   `"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group"`,
+			expectedComment: "reference the ID of a resource defined in the template",
+		},
+		{
+			name:            "unhandled validation",
+			argument:        "pattern",
+			validateFunc:    validation.StringMatch(regexp.MustCompile(`^[A-Z]{3}$`), "must be three uppercase letters"),
+			expectedValue:   `"TODO"`,
+			expectedComment: "a valid value for this argument could not be determined",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value, comment := stringValue(tc.argument, &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: tc.validateFunc,
+			})
+			if value != tc.expectedValue {
+				t.Fatalf("expected the value %q but got %q", tc.expectedValue, value)
+			}
+			if comment != tc.expectedComment {
+				t.Fatalf("expected the comment %q but got %q", tc.expectedComment, comment)
+			}
+		})
+	}
+}
@@ -34,6 +34,16 @@ func realMain(args []string) int {
 				Ui: ui,
 			}, nil
 		},
+		"resourceidentities": func() (cli.Command, error) {
+			return &generators.ResourceIdentitiesCommand{
+				Ui: ui,
+			}, nil
+		},
+		"resourcetests": func() (cli.Command, error) {
+			return &generators.ResourceTestsCommand{
+				Ui: ui,
+			}, nil
+		},
 	}
 
 	gen := cli.CLI{
@@ -4,22 +4,39 @@
 package main
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 
 	. "github.com/dave/jennifer/jen"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"golang.org/x/tools/imports"
 )
 
 func main() {
+	sdkPackage := flag.String("sdk-package", "", "the import path of the go-azure-sdk resource-manager package containing the model")
+	sdkModel := flag.String("model", "", "the name of the model within the go-azure-sdk package")
+	name := flag.String("name", "", "the name used for the generated model, resource and expand/flatten functions, defaults to the name of the model")
+	output := flag.String("output", "", "the path of the file to write the generated code to, the round-trip unit test is written alongside it")
+	packageName := flag.String("package", "", "the name of the package for the generated code, defaults to the name of the directory of the output file")
 	flag.Parse()
+
+	if *sdkPackage != "" {
+		if err := generateFromSDK(*sdkPackage, *sdkModel, *name, *output, *packageName); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	if len(flag.Args()) != 1 {
 		fmt.Fprintln(os.Stderr, "Usage: generator-typed-model <resource_type>")
+		fmt.Fprintln(os.Stderr, "       generator-typed-model -sdk-package <import_path> -model <model_name> -output <path> [-name <name>] [-package <package_name>]")
 		os.Exit(1)
 	}
 	rt := flag.Args()[0]
@@ -37,6 +54,73 @@ func main() {
 	fmt.Printf("%#v", f)
 }
 
+// generateFromSDK generates the Typed Model, schema, expand/flatten functions and a round-trip unit test for a model
+// within a go-azure-sdk package
+func generateFromSDK(importPath, sdkModel, name, output, packageName string) error {
+	if sdkModel == "" || output == "" {
+		return fmt.Errorf("`-model` and `-output` must be specified when generating from a go-azure-sdk package")
+	}
+	if name == "" {
+		name = sdkModel
+	}
+	if packageName == "" {
+		abs, err := filepath.Abs(output)
+		if err != nil {
+			return fmt.Errorf("determining the package name for %q: %+v", output, err)
+		}
+		packageName = filepath.Base(filepath.Dir(abs))
+	}
+
+	dir, err := resolvePackageDir(importPath)
+	if err != nil {
+		return err
+	}
+	pkg, err := loadSDKPackage(dir, importPath)
+	if err != nil {
+		return err
+	}
+	generator, err := newSDKGenerator(pkg, sdkModel, name)
+	if err != nil {
+		return err
+	}
+
+	if err := save(generator.code(packageName), output); err != nil {
+		return err
+	}
+	return save(generator.test(packageName), strings.TrimSuffix(output, ".go")+"_test.go")
+}
+
+func save(f *File, path string) error {
+	out, err := render(f, path)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, out, 0o644); err != nil {
+		return fmt.Errorf("writing %q: %+v", path, err)
+	}
+	return nil
+}
+
+// render returns the source of the file with the standard library imports grouped separately, as `goimports` does
+func render(f *File, path string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := f.Render(&buf); err != nil {
+		return nil, fmt.Errorf("rendering %q: %+v", path, err)
+	}
+
+	out, err := imports.Process(path, buf.Bytes(), &imports.Options{
+		Comments:   true,
+		TabIndent:  true,
+		TabWidth:   8,
+		FormatOnly: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("formatting %q: %+v", path, err)
+	}
+	return out, nil
+}
+
 func snake2Camel(input string) string {
 	segs := strings.Split(input, "_")
 	var out string
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"flag"
+	"os"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files within the testdata directory")
+
+func TestGenerateFromSDK(t *testing.T) {
+	pkg, err := loadSDKPackage("testdata/widgets", "github.com/hashicorp/go-azure-sdk/resource-manager/example/2024-01-01/widgets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	generator, err := newSDKGenerator(pkg, "WidgetProperties", "Widget")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code, err := render(generator.code("example"), "widget.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	test, err := render(generator.test("example"), "widget_test.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for path, actual := range map[string][]byte{
+		"testdata/widget.go.golden":      code,
+		"testdata/widget_test.go.golden": test,
+	} {
+		if *update {
+			if err := os.WriteFile(path, actual, 0o644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+
+		expected, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(expected) != string(actual) {
+			t.Fatalf("the generated code doesn't match %s (run the tests with `-update` to update it):\n%s", path, actual)
+		}
+	}
+}
+
+func TestGenerateFromSDK_modelNotFound(t *testing.T) {
+	pkg, err := loadSDKPackage("testdata/widgets", "github.com/hashicorp/go-azure-sdk/resource-manager/example/2024-01-01/widgets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newSDKGenerator(pkg, "Gadget", "Gadget"); err == nil {
+		t.Fatal("expected an error for a model which doesn't exist but got none")
+	}
+}
+
+func TestConvertToSnakeCase(t *testing.T) {
+	cases := []struct {
+		in  string
+		out string
+	}{
+		{
+			"name",
+			"name",
+		},
+		{
+			"ipAddress",
+			"ip_address",
+		},
+		{
+			"supportedOSType",
+			"supported_os_type",
+		},
+		{
+			"sizeInGB",
+			"size_in_gb",
+		},
+		{
+			"IPAddress",
+			"ip_address",
+		},
+		{
+			"v2Enabled",
+			"v2_enabled",
+		},
+	}
+
+	for idx, c := range cases {
+		out := convertToSnakeCase(c.in)
+		if c.out != out {
+			t.Fatalf("%d. %q (expect) != %q (actual)", idx, c.out, out)
+		}
+	}
+}
@@ -0,0 +1,465 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/dave/jennifer/jen"
+)
+
+const (
+	pointerPath    = "github.com/hashicorp/go-azure-helpers/lang/pointer"
+	pluginsdkPath  = "github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	validationPath = "github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var multiLine = Options{
+	Open:      "{",
+	Close:     "}",
+	Separator: ",",
+	Multi:     true,
+}
+
+// sdkGenerator generates the Typed Model, schema and expand/flatten functions for a model within the SDK
+type sdkGenerator struct {
+	pkg   *sdkPackage
+	name  string
+	root  *model
+	other []*model
+}
+
+func newSDKGenerator(pkg *sdkPackage, sdkModelName, name string) (*sdkGenerator, error) {
+	builder := newModelBuilder(pkg)
+	root, err := builder.build(sdkModelName, name+"Model")
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdkGenerator{
+		pkg:   pkg,
+		name:  name,
+		root:  root,
+		other: builder.models[1:],
+	}, nil
+}
+
+func newFile(packageName string) *File {
+	f := NewFile(packageName)
+	f.HeaderComment("Copyright (c) HashiCorp, Inc.")
+	f.HeaderComment("SPDX-License-Identifier: MPL-2.0")
+	f.ImportNames(map[string]string{
+		pointerPath:    "pointer",
+		pluginsdkPath:  "pluginsdk",
+		validationPath: "validation",
+	})
+	return f
+}
+
+// code returns the file containing the models, the `Arguments()` schema and the expand/flatten functions
+func (g *sdkGenerator) code(packageName string) *File {
+	f := newFile(packageName)
+	f.ImportName(g.pkg.importPath, g.pkg.name)
+
+	for _, m := range g.models() {
+		f.Add(g.modelStruct(m)).Line()
+	}
+
+	f.Func().Params(Id("r").Id(g.name+"Resource")).Id("Arguments").Params().Map(String()).Op("*").Qual(pluginsdkPath, "Schema").Block(
+		Return(g.schemaMap(g.root)),
+	).Line()
+
+	g.expandRoot(f)
+	g.flattenRoot(f)
+	for _, m := range g.other {
+		if m.usedAsBlock {
+			g.expandBlock(f, m)
+			g.flattenBlock(f, m)
+		}
+		if m.usedAsList {
+			g.expandList(f, m)
+			g.flattenList(f, m)
+		}
+	}
+
+	return f
+}
+
+// test returns the file containing a unit test which round-trips the model through the expand/flatten functions
+func (g *sdkGenerator) test(packageName string) *File {
+	f := newFile(packageName)
+	f.ImportName(g.pkg.importPath, g.pkg.name)
+
+	f.Func().Id("TestExpandFlatten"+g.name).Params(Id("t").Op("*").Qual("testing", "T")).Block(
+		Id("input").Op(":=").Id(g.root.name).Add(g.testValues(g.root)),
+		Line(),
+		Id("actual").Op(":=").Id("flatten"+g.name).Call(Id("expand"+g.name).Call(Id("input"))),
+		If(Op("!").Qual("reflect", "DeepEqual").Call(Id("input"), Id("actual"))).Block(
+			Id("t").Dot("Fatalf").Call(Lit("expected %+v but got %+v"), Id("input"), Id("actual")),
+		),
+	)
+
+	return f
+}
+
+func (g *sdkGenerator) models() []*model {
+	return append([]*model{g.root}, g.other...)
+}
+
+func (g *sdkGenerator) modelStruct(m *model) *Statement {
+	fields := make([]Code, 0)
+	for _, field := range m.fields {
+		if field.unsupported != "" {
+			fields = append(fields, Comment(fmt.Sprintf("TODO: `%s` must be mapped by hand since %s", field.sdkName, field.unsupported)))
+			continue
+		}
+		fields = append(fields, Id(field.sdkName).Add(g.modelType(field)).Tag(map[string]string{"tfschema": field.key}))
+	}
+	return Type().Id(m.name).Struct(fields...)
+}
+
+func (g *sdkGenerator) modelType(field modelField) *Statement {
+	if field.isMap {
+		return Map(String()).String()
+	}
+
+	var elem *Statement
+	switch field.kind {
+	case fieldKindBool:
+		elem = Bool()
+	case fieldKindInt:
+		elem = Int64()
+	case fieldKindFloat:
+		elem = Float64()
+	case fieldKindBlock:
+		return Index().Id(field.nested.name)
+	default:
+		elem = String()
+	}
+
+	if field.list {
+		return Index().Add(elem)
+	}
+	return elem
+}
+
+func (g *sdkGenerator) schemaMap(m *model) *Statement {
+	items := make([]Code, 0)
+	for _, field := range m.fields {
+		if field.unsupported != "" {
+			continue
+		}
+		items = append(items, Lit(field.key).Op(":").Custom(multiLine, g.schemaItems(field)...))
+	}
+	return Map(String()).Op("*").Qual(pluginsdkPath, "Schema").Custom(multiLine, items...)
+}
+
+func (g *sdkGenerator) schemaItems(field modelField) []Code {
+	items := make([]Code, 0)
+	switch {
+	case field.isMap:
+		items = append(items, Id("Type").Op(":").Qual(pluginsdkPath, "TypeMap"))
+	case field.list || field.kind == fieldKindBlock:
+		items = append(items, Id("Type").Op(":").Qual(pluginsdkPath, "TypeList"))
+	default:
+		items = append(items, Id("Type").Op(":").Add(g.schemaType(field.kind)))
+	}
+
+	if field.required {
+		items = append(items, Id("Required").Op(":").True())
+	} else {
+		items = append(items, Id("Optional").Op(":").True())
+	}
+
+	switch {
+	case field.kind == fieldKindBlock:
+		if !field.list {
+			items = append(items, Id("MaxItems").Op(":").Lit(1))
+		}
+		items = append(items, Id("Elem").Op(":").Op("&").Qual(pluginsdkPath, "Resource").Custom(multiLine,
+			Id("Schema").Op(":").Add(g.schemaMap(field.nested)),
+		))
+
+	case field.list || field.isMap:
+		elem := []Code{Id("Type").Op(":").Add(g.schemaType(field.kind))}
+		if validateFunc := g.validateFunc(field); validateFunc != nil {
+			elem = append(elem, Id("ValidateFunc").Op(":").Add(validateFunc))
+		}
+		items = append(items, Id("Elem").Op(":").Op("&").Qual(pluginsdkPath, "Schema").Custom(multiLine, elem...))
+
+	default:
+		if validateFunc := g.validateFunc(field); validateFunc != nil {
+			items = append(items, Id("ValidateFunc").Op(":").Add(validateFunc))
+		}
+	}
+
+	return items
+}
+
+func (g *sdkGenerator) schemaType(kind fieldKind) *Statement {
+	switch kind {
+	case fieldKindBool:
+		return Qual(pluginsdkPath, "TypeBool")
+	case fieldKindInt:
+		return Qual(pluginsdkPath, "TypeInt")
+	case fieldKindFloat:
+		return Qual(pluginsdkPath, "TypeFloat")
+	}
+	return Qual(pluginsdkPath, "TypeString")
+}
+
+func (g *sdkGenerator) validateFunc(field modelField) *Statement {
+	switch {
+	case field.isMap:
+		return nil
+	case field.kind == fieldKindEnum:
+		return Qual(validationPath, "StringInSlice").Call(Qual(g.pkg.importPath, "PossibleValuesFor"+field.sdkType).Call(), False())
+	case field.kind == fieldKindString:
+		return Qual(validationPath, "StringIsNotEmpty")
+	}
+	return nil
+}
+
+func expandFuncName(m *model, list bool) string {
+	if list && m.usedAsBlock {
+		return "expand" + m.sdkName + "List"
+	}
+	return "expand" + m.sdkName
+}
+
+func flattenFuncName(m *model, list bool) string {
+	if list && m.usedAsBlock {
+		return "flatten" + m.sdkName + "List"
+	}
+	return "flatten" + m.sdkName
+}
+
+func (g *sdkGenerator) expandRoot(f *File) {
+	body := []Code{Id("output").Op(":=").Qual(g.pkg.importPath, g.root.sdkName).Values()}
+	body = append(body, g.expandFields(g.root, "input", "output")...)
+	body = append(body, Line(), Return(Op("&").Id("output")))
+
+	f.Func().Id("expand"+g.name).Params(Id("input").Id(g.root.name)).Op("*").Qual(g.pkg.importPath, g.root.sdkName).Block(body...).Line()
+}
+
+func (g *sdkGenerator) flattenRoot(f *File) {
+	body := []Code{
+		Id("output").Op(":=").Id(g.root.name).Values(),
+		If(Id("input").Op("==").Nil()).Block(Return(Id("output"))),
+		Line(),
+	}
+	body = append(body, g.flattenFields(g.root, "input", "output")...)
+	body = append(body, Line(), Return(Id("output")))
+
+	f.Func().Id("flatten" + g.name).Params(Id("input").Op("*").Qual(g.pkg.importPath, g.root.sdkName)).Id(g.root.name).Block(body...).Line()
+}
+
+func (g *sdkGenerator) expandBlock(f *File, m *model) {
+	body := []Code{
+		If(Len(Id("input")).Op("==").Lit(0)).Block(Return(Nil())),
+		Id("v").Op(":=").Id("input").Index(Lit(0)),
+		Line(),
+		Id("output").Op(":=").Qual(g.pkg.importPath, m.sdkName).Values(),
+	}
+	body = append(body, g.expandFields(m, "v", "output")...)
+	body = append(body, Line(), Return(Op("&").Id("output")))
+
+	f.Func().Id(expandFuncName(m, false)).Params(Id("input").Index().Id(m.name)).Op("*").Qual(g.pkg.importPath, m.sdkName).Block(body...).Line()
+}
+
+func (g *sdkGenerator) flattenBlock(f *File, m *model) {
+	body := []Code{
+		If(Id("input").Op("==").Nil()).Block(Return(Make(Index().Id(m.name), Lit(0)))),
+		Line(),
+		Id("output").Op(":=").Id(m.name).Values(),
+	}
+	body = append(body, g.flattenFields(m, "input", "output")...)
+	body = append(body, Line(), Return(Index().Id(m.name).Values(Id("output"))))
+
+	f.Func().Id(flattenFuncName(m, false)).Params(Id("input").Op("*").Qual(g.pkg.importPath, m.sdkName)).Index().Id(m.name).Block(body...).Line()
+}
+
+func (g *sdkGenerator) expandList(f *File, m *model) {
+	loop := []Code{Id("item").Op(":=").Qual(g.pkg.importPath, m.sdkName).Values()}
+	loop = append(loop, g.expandFields(m, "v", "item")...)
+	loop = append(loop, Id("output").Op("=").Append(Id("output"), Id("item")))
+
+	f.Func().Id(expandFuncName(m, true)).Params(Id("input").Index().Id(m.name)).Op("*").Index().Qual(g.pkg.importPath, m.sdkName).Block(
+		Id("output").Op(":=").Make(Index().Qual(g.pkg.importPath, m.sdkName), Lit(0)),
+		For(List(Id("_"), Id("v")).Op(":=").Range().Id("input")).Block(loop...),
+		Line(),
+		Return(Op("&").Id("output")),
+	).Line()
+}
+
+func (g *sdkGenerator) flattenList(f *File, m *model) {
+	loop := []Code{Id("item").Op(":=").Id(m.name).Values()}
+	loop = append(loop, g.flattenFields(m, "v", "item")...)
+	loop = append(loop, Id("output").Op("=").Append(Id("output"), Id("item")))
+
+	f.Func().Id(flattenFuncName(m, true)).Params(Id("input").Op("*").Index().Qual(g.pkg.importPath, m.sdkName)).Index().Id(m.name).Block(
+		Id("output").Op(":=").Make(Index().Id(m.name), Lit(0)),
+		If(Id("input").Op("==").Nil()).Block(Return(Id("output"))),
+		Line(),
+		For(List(Id("_"), Id("v")).Op(":=").Range().Op("*").Id("input")).Block(loop...),
+		Line(),
+		Return(Id("output")),
+	).Line()
+}
+
+// expandFields returns the statements which set the fields of the SDK model `output` from the Typed Model `input`
+func (g *sdkGenerator) expandFields(m *model, input, output string) []Code {
+	out := make([]Code, 0)
+	for _, field := range m.fields {
+		if field.unsupported != "" {
+			continue
+		}
+		src := Id(input).Dot(field.sdkName)
+		dst := Id(output).Dot(field.sdkName)
+
+		switch {
+		case field.kind == fieldKindBlock:
+			value := Id(expandFuncName(field.nested, field.list)).Call(src)
+			if !field.pointer {
+				value = Qual(pointerPath, "From").Call(value)
+			}
+			out = append(out, dst.Op("=").Add(value))
+
+		case field.list && field.kind == fieldKindEnum:
+			values := lowerFirst(field.sdkName) + "Values"
+			out = append(out,
+				Id(values).Op(":=").Make(Index().Qual(g.pkg.importPath, field.sdkType), Lit(0)),
+				For(List(Id("_"), Id("value")).Op(":=").Range().Add(src)).Block(
+					Id(values).Op("=").Append(Id(values), Qual(g.pkg.importPath, field.sdkType).Call(Id("value"))),
+				),
+				dst.Op("=").Add(g.pointerTo(field, Id(values))),
+			)
+
+		case field.list || field.isMap || field.kind == fieldKindBool:
+			out = append(out, dst.Op("=").Add(g.pointerTo(field, src)))
+
+		case field.kind == fieldKindInt || field.kind == fieldKindFloat:
+			value := src
+			if field.sdkType != "int64" && field.sdkType != "float64" {
+				value = Id(field.sdkType).Call(src)
+			}
+			out = append(out, dst.Op("=").Add(g.pointerTo(field, value)))
+
+		default:
+			value := src
+			if field.kind == fieldKindEnum {
+				value = Qual(g.pkg.importPath, field.sdkType).Call(src)
+			}
+			if field.pointer {
+				// empty strings are omitted, since Optional arguments which aren't set are empty strings
+				out = append(out, If(Id(input).Dot(field.sdkName).Op("!=").Lit("")).Block(
+					dst.Op("=").Qual(pointerPath, "To").Call(value),
+				))
+				continue
+			}
+			out = append(out, dst.Op("=").Add(value))
+		}
+	}
+	return out
+}
+
+// flattenFields returns the statements which set the fields of the Typed Model `output` from the SDK model `input`
+func (g *sdkGenerator) flattenFields(m *model, input, output string) []Code {
+	out := make([]Code, 0)
+	for _, field := range m.fields {
+		if field.unsupported != "" {
+			continue
+		}
+		src := Id(input).Dot(field.sdkName)
+		dst := Id(output).Dot(field.sdkName)
+
+		switch {
+		case field.kind == fieldKindBlock:
+			value := src
+			if !field.pointer {
+				value = Op("&").Add(src)
+			}
+			out = append(out, dst.Op("=").Id(flattenFuncName(field.nested, field.list)).Call(value))
+
+		case field.list && field.kind == fieldKindEnum:
+			values := src
+			if field.pointer {
+				values = Op("*").Add(src)
+			}
+			loop := For(List(Id("_"), Id("value")).Op(":=").Range().Add(values)).Block(
+				Id(output).Dot(field.sdkName).Op("=").Append(Id(output).Dot(field.sdkName), String().Call(Id("value"))),
+			)
+			if field.pointer {
+				loop = If(Id(input).Dot(field.sdkName).Op("!=").Nil()).Block(loop)
+			}
+			out = append(out, loop)
+
+		default:
+			value := src
+			if field.pointer {
+				value = Qual(pointerPath, "From").Call(src)
+			}
+			switch {
+			case field.kind == fieldKindEnum && !field.list:
+				value = String().Call(value)
+			case field.kind == fieldKindInt && field.sdkType != "int64":
+				value = Int64().Call(value)
+			case field.kind == fieldKindFloat && field.sdkType != "float64":
+				value = Float64().Call(value)
+			}
+			out = append(out, dst.Op("=").Add(value))
+		}
+	}
+	return out
+}
+
+func (g *sdkGenerator) pointerTo(field modelField, value *Statement) *Statement {
+	if field.pointer {
+		return Qual(pointerPath, "To").Call(value)
+	}
+	return value
+}
+
+// testValues returns a populated instance of the Typed Model, using the first possible value for enums
+func (g *sdkGenerator) testValues(m *model) *Statement {
+	items := make([]Code, 0)
+	for _, field := range m.fields {
+		if field.unsupported != "" {
+			continue
+		}
+
+		var value *Statement
+		switch field.kind {
+		case fieldKindBlock:
+			value = Index().Id(field.nested.name).Custom(multiLine, g.testValues(field.nested))
+		case fieldKindBool:
+			value = True()
+		case fieldKindInt:
+			value = Lit(1)
+		case fieldKindFloat:
+			value = Lit(1.5)
+		case fieldKindEnum:
+			value = Qual(g.pkg.importPath, "PossibleValuesFor"+field.sdkType).Call().Index(Lit(0))
+		default:
+			value = Lit("example")
+		}
+
+		switch {
+		case field.isMap:
+			value = Map(String()).String().Values(Dict{Lit("key"): Lit("value")})
+		case field.list && field.kind != fieldKindBlock:
+			value = g.modelType(field).Values(value)
+		}
+		items = append(items, Id(field.sdkName).Op(":").Add(value))
+	}
+	return Custom(multiLine, items...)
+}
+
+func lowerFirst(input string) string {
+	if input == "" {
+		return input
+	}
+	return strings.ToLower(input[:1]) + input[1:]
+}
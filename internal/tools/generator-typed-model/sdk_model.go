@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type fieldKind int
+
+const (
+	fieldKindString fieldKind = iota
+	fieldKindBool
+	fieldKindInt
+	fieldKindFloat
+	fieldKindEnum
+	fieldKindBlock
+)
+
+// model is a Typed Model generated from a model within the SDK
+type model struct {
+	sdkName string
+	name    string
+	fields  []modelField
+
+	// usedAsBlock and usedAsList track how a nested model is used, which determines the expand/flatten functions required
+	usedAsBlock bool
+	usedAsList  bool
+}
+
+type modelField struct {
+	// sdkName is the name of the field within the SDK model, and key the name of the argument in the schema
+	sdkName string
+	key     string
+
+	required bool
+	pointer  bool
+	list     bool
+	isMap    bool
+	kind     fieldKind
+
+	// sdkType is the name of the type of the (element of the) field within the SDK, e.g. `int32` or the name of an enum
+	sdkType string
+	nested  *model
+
+	// unsupported is the reason the field can't be mapped, in which case it's left for the author to map by hand
+	unsupported string
+}
+
+var primitiveKinds = map[string]fieldKind{
+	"string":  fieldKindString,
+	"bool":    fieldKindBool,
+	"int":     fieldKindInt,
+	"int32":   fieldKindInt,
+	"int64":   fieldKindInt,
+	"float32": fieldKindFloat,
+	"float64": fieldKindFloat,
+}
+
+type modelBuilder struct {
+	pkg    *sdkPackage
+	models []*model
+	byName map[string]*model
+}
+
+func newModelBuilder(pkg *sdkPackage) *modelBuilder {
+	return &modelBuilder{
+		pkg:    pkg,
+		byName: make(map[string]*model),
+	}
+}
+
+// build returns the Typed Model for the SDK model, along with the models for any nested SDK models
+func (b *modelBuilder) build(sdkName, name string) (*model, error) {
+	if _, ok := b.pkg.structs[sdkName]; !ok {
+		return nil, fmt.Errorf("the model %q was not found within the package %q", sdkName, b.pkg.importPath)
+	}
+	return b.buildModel(sdkName, name, map[string]struct{}{}), nil
+}
+
+func (b *modelBuilder) buildModel(sdkName, name string, parents map[string]struct{}) *model {
+	if existing, ok := b.byName[sdkName]; ok {
+		return existing
+	}
+
+	m := &model{
+		sdkName: sdkName,
+		name:    name,
+	}
+	b.byName[sdkName] = m
+	b.models = append(b.models, m)
+
+	parents[sdkName] = struct{}{}
+	defer delete(parents, sdkName)
+
+	for _, f := range b.pkg.structs[sdkName].Fields.List {
+		// embedded fields aren't used within the SDK models
+		if len(f.Names) == 0 {
+			continue
+		}
+		jsonName, omitEmpty := jsonTag(f.Tag)
+		if jsonName == "-" {
+			continue
+		}
+		if jsonName == "" {
+			jsonName = f.Names[0].Name
+		}
+
+		field := modelField{
+			sdkName: f.Names[0].Name,
+			key:     convertToSnakeCase(jsonName),
+		}
+		b.populateFieldType(&field, f.Type, parents)
+		field.required = !field.pointer && !omitEmpty
+		m.fields = append(m.fields, field)
+	}
+
+	// Required arguments are conventionally defined before Optional arguments
+	sort.SliceStable(m.fields, func(i, j int) bool {
+		if m.fields[i].required != m.fields[j].required {
+			return m.fields[i].required
+		}
+		return m.fields[i].key < m.fields[j].key
+	})
+
+	return m
+}
+
+func (b *modelBuilder) populateFieldType(field *modelField, expr ast.Expr, parents map[string]struct{}) {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		field.pointer = true
+		expr = star.X
+	}
+
+	switch t := expr.(type) {
+	case *ast.ArrayType:
+		if t.Len != nil {
+			break
+		}
+		field.list = true
+		expr = t.Elt
+
+	case *ast.MapType:
+		key, keyOk := t.Key.(*ast.Ident)
+		value, valueOk := t.Value.(*ast.Ident)
+		if !keyOk || !valueOk || key.Name != "string" || value.Name != "string" {
+			field.unsupported = fmt.Sprintf("the map type `%s` is not supported", exprString(t))
+			return
+		}
+		field.isMap = true
+		field.kind = fieldKindString
+		field.sdkType = "string"
+		return
+	}
+
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		// e.g. the common types such as `identity.LegacySystemAndUserAssignedMap` or `zones.Schema`
+		field.unsupported = fmt.Sprintf("the type `%s` is not supported", exprString(expr))
+		return
+	}
+
+	field.sdkType = ident.Name
+	if kind, ok := primitiveKinds[ident.Name]; ok {
+		field.kind = kind
+		if field.list && ident.Name != "string" {
+			field.unsupported = fmt.Sprintf("lists of `%s` are not supported", ident.Name)
+		}
+		return
+	}
+	if _, ok := b.pkg.enums[ident.Name]; ok {
+		field.kind = fieldKindEnum
+		return
+	}
+	if _, ok := b.pkg.structs[ident.Name]; ok {
+		if _, ok := parents[ident.Name]; ok {
+			field.unsupported = fmt.Sprintf("the model `%s` is recursive", ident.Name)
+			return
+		}
+		field.kind = fieldKindBlock
+		field.nested = b.buildModel(ident.Name, ident.Name+"Model", parents)
+		if field.list {
+			field.nested.usedAsList = true
+		} else {
+			field.nested.usedAsBlock = true
+		}
+		return
+	}
+
+	// e.g. discriminated types, which are interfaces
+	field.unsupported = fmt.Sprintf("the type `%s` is not supported", ident.Name)
+}
+
+func jsonTag(tag *ast.BasicLit) (name string, omitEmpty bool) {
+	if tag == nil {
+		return "", false
+	}
+	raw, err := strconv.Unquote(tag.Value)
+	if err != nil {
+		return "", false
+	}
+	value := reflect.StructTag(raw).Get("json")
+	name, options, _ := strings.Cut(value, ",")
+	return name, strings.Contains(options, "omitempty")
+}
+
+func exprString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + exprString(t.X)
+	case *ast.SelectorExpr:
+		return exprString(t.X) + "." + t.Sel.Name
+	case *ast.ArrayType:
+		return "[]" + exprString(t.Elt)
+	case *ast.MapType:
+		return "map[" + exprString(t.Key) + "]" + exprString(t.Value)
+	case *ast.InterfaceType:
+		return "interface{}"
+	}
+	return fmt.Sprintf("%T", expr)
+}
+
+// convertToSnakeCase converts the name of a field within the API to the name of the argument, e.g. `supportedOSType`
+// becomes `supported_os_type`
+func convertToSnakeCase(input string) string {
+	runes := []rune(input)
+	var sb strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				sb.WriteRune('_')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return sb.String()
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+)
+
+// sdkPackage contains the models and constants parsed from the source of a go-azure-sdk resource-manager package
+type sdkPackage struct {
+	importPath string
+	name       string
+
+	// structs are the models within the package, keyed by name
+	structs map[string]*ast.StructType
+
+	// enums are the constants within the package which have a `PossibleValuesFor...` function
+	enums map[string]struct{}
+}
+
+// resolvePackageDir returns the directory containing the source for the package, which is found in the same way as
+// the `go` command does - and so uses the vendor directory when `-mod=vendor` is specified
+func resolvePackageDir(importPath string) (string, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("retrieving the working directory: %+v", err)
+	}
+
+	pkg, err := build.Default.Import(importPath, wd, build.FindOnly)
+	if err != nil {
+		return "", fmt.Errorf("finding the package %q: %+v", importPath, err)
+	}
+	return pkg.Dir, nil
+}
+
+func loadSDKPackage(dir, importPath string) (*sdkPackage, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the package within %q: %+v", dir, err)
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected one package within %q but got %d", dir, len(pkgs))
+	}
+
+	out := &sdkPackage{
+		importPath: importPath,
+		structs:    make(map[string]*ast.StructType),
+		enums:      make(map[string]struct{}),
+	}
+
+	stringTypes := make(map[string]struct{})
+	possibleValues := make(map[string]struct{})
+	for name, pkg := range pkgs {
+		out.name = name
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				switch decl := decl.(type) {
+				case *ast.FuncDecl:
+					if decl.Recv == nil && strings.HasPrefix(decl.Name.Name, "PossibleValuesFor") {
+						possibleValues[strings.TrimPrefix(decl.Name.Name, "PossibleValuesFor")] = struct{}{}
+					}
+
+				case *ast.GenDecl:
+					for _, spec := range decl.Specs {
+						typeSpec, ok := spec.(*ast.TypeSpec)
+						if !ok {
+							continue
+						}
+						switch t := typeSpec.Type.(type) {
+						case *ast.StructType:
+							out.structs[typeSpec.Name.Name] = t
+						case *ast.Ident:
+							if t.Name == "string" {
+								stringTypes[typeSpec.Name.Name] = struct{}{}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	for name := range stringTypes {
+		if _, ok := possibleValues[name]; ok {
+			out.enums[name] = struct{}{}
+		}
+	}
+
+	return out, nil
+}
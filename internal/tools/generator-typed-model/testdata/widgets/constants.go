@@ -0,0 +1,32 @@
+package widgets
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type Colour string
+
+const (
+	ColourBlue Colour = "Blue"
+	ColourRed  Colour = "Red"
+)
+
+func PossibleValuesForColour() []string {
+	return []string{
+		string(ColourBlue),
+		string(ColourRed),
+	}
+}
+
+type Protocol string
+
+const (
+	ProtocolHTTP  Protocol = "HTTP"
+	ProtocolHTTPS Protocol = "HTTPS"
+)
+
+func PossibleValuesForProtocol() []string {
+	return []string{
+		string(ProtocolHTTP),
+		string(ProtocolHTTPS),
+	}
+}
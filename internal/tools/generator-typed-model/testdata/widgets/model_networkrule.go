@@ -0,0 +1,9 @@
+package widgets
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type NetworkRule struct {
+	IPAddress string    `json:"ipAddress"`
+	Protocol  *Protocol `json:"protocol,omitempty"`
+}
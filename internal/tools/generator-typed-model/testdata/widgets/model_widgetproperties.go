@@ -0,0 +1,23 @@
+package widgets
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type WidgetProperties struct {
+	Colour       Colour                             `json:"colour"`
+	Description  *string                            `json:"description,omitempty"`
+	Enabled      *bool                              `json:"enabled,omitempty"`
+	Identity     *identity.SystemAndUserAssignedMap `json:"identity,omitempty"`
+	Labels       *map[string]string                 `json:"labels,omitempty"`
+	NetworkRules *[]NetworkRule                     `json:"networkRules,omitempty"`
+	Parent       *WidgetProperties                  `json:"parent,omitempty"`
+	Protocols    *[]Protocol                        `json:"protocols,omitempty"`
+	Ratio        *float64                           `json:"ratio,omitempty"`
+	Settings     *WidgetSettings                    `json:"settings,omitempty"`
+	SizeInGB     *int32                             `json:"sizeInGB,omitempty"`
+	Zones        *[]string                          `json:"zones,omitempty"`
+}
@@ -0,0 +1,9 @@
+package widgets
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type WidgetSettings struct {
+	MaxCount *int64        `json:"maxCount,omitempty"`
+	Rules    []NetworkRule `json:"rules"`
+}
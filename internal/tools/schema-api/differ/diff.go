@@ -5,114 +5,167 @@ package differ
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
 	schema_rules "github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/schema-rules"
 )
 
+const (
+	KindResource   = "resource"
+	KindDataSource = "data-source"
+)
+
+// Violation describes a single breaking change detected between the base (released) and current schema
+type Violation struct {
+	// Rule is the name of the rule which detected this breaking change
+	Rule string `json:"rule"`
+
+	// Kind is either `resource` or `data-source`
+	Kind string `json:"kind"`
+
+	// Name is the name of the Resource or Data Source, e.g. `azurerm_resource_group`
+	Name string `json:"name"`
+
+	// Property is the path to the property within the Resource or Data Source, when the breaking change
+	// applies to a single property, e.g. `identity.type`
+	Property string `json:"property,omitempty"`
+
+	Message string `json:"message"`
+}
+
+func (v Violation) String() string {
+	kind := "resource"
+	if v.Kind == KindDataSource {
+		kind = "data source"
+	}
+	return fmt.Sprintf("%s %q: %s", kind, v.Name, v.Message)
+}
+
 type Differ struct {
 	base    *providerjson.ProviderWrapper
 	current *providerjson.ProviderWrapper
 }
 
-func (d *Differ) Diff(fileName string, providerName string) []string {
+func (d *Differ) Diff(fileName string, providerName string) ([]Violation, error) {
 	if err := d.loadFromProvider(providerjson.LoadData(), providerName); err != nil {
-		return []string{err.Error()}
+		return nil, fmt.Errorf("loading the current provider schema: %+v", err)
 	}
 
 	if err := d.loadFromFile(fileName); err != nil {
-		return []string{err.Error()}
+		return nil, fmt.Errorf("loading the base provider schema from %q: %+v", fileName, err)
 	}
 
 	if d.base.ProviderName != d.current.ProviderName {
-		return []string{fmt.Sprintf("provider name mismatch, expected %q, got %q", d.base.ProviderName, d.current.ProviderName)}
+		return nil, fmt.Errorf("provider name mismatch, expected %q, got %q", d.base.ProviderName, d.current.ProviderName)
 	}
 
-	violations := make([]string, 0)
+	violations := compareResources(KindResource, d.base.ProviderSchema.ResourcesMap, d.current.ProviderSchema.ResourcesMap, schema_rules.BreakingChangeResourceRules, schema_rules.RulesForBase(d.base.ProviderSchema, schema_rules.BreakingChangeRules))
+	violations = append(violations, compareResources(KindDataSource, d.base.ProviderSchema.DataSourcesMap, d.current.ProviderSchema.DataSourcesMap, schema_rules.BreakingChangeResourceRulesDataSource, schema_rules.RulesForBase(d.base.ProviderSchema, schema_rules.BreakingChangeRulesDataSource))...)
 
-	for resource, rs := range d.current.ProviderSchema.ResourcesMap {
-		_, ok := d.base.ProviderSchema.ResourcesMap[resource]
-		if !ok {
-			// New resource, no breaking changes to worry about
-			continue
+	return violations, nil
+}
+
+func compareResources(kind string, base map[string]providerjson.ResourceJSON, current map[string]providerjson.ResourceJSON, resourceRules []schema_rules.BreakingChangeResourceRule, propertyRules []schema_rules.BreakingChangeRule) []Violation {
+	violations := make([]Violation, 0)
+
+	for _, name := range sortedKeys(base) {
+		baseResource := base[name]
+		var currentResource *providerjson.ResourceJSON
+		if v, ok := current[name]; ok {
+			currentResource = &v
 		}
-		for propertyName, propertySchema := range rs.Schema {
-			// Get the same from the base (released) json
-			baseItem, ok := d.base.ProviderSchema.ResourcesMap[resource].Schema[propertyName]
-			if !ok {
-				// New property, could be breaking - Required etc
-				baseItem = providerjson.SchemaJSON{}
-			}
-			if errs := compareNodeResource(baseItem, propertySchema, propertyName); errs != nil {
-				violations = append(violations, errs...)
+
+		for _, rule := range resourceRules {
+			if err := rule.Check(&baseResource, currentResource, name); err != nil {
+				violations = append(violations, Violation{
+					Rule:    rule.Name(),
+					Kind:    kind,
+					Name:    name,
+					Message: *err,
+				})
 			}
 		}
-	}
 
-	for dataSource, ds := range d.current.ProviderSchema.DataSourcesMap {
-		_, ok := d.base.ProviderSchema.DataSourcesMap[dataSource]
-		if !ok {
-			// New data source, no breaking changes to worry about
+		if currentResource == nil {
+			// the removal of the resource has been reported above, so there's no need to report each property
 			continue
 		}
-		for propertyName, propertySchema := range ds.Schema {
-			// Get the same from the base (released) json
-			baseItem, ok := d.base.ProviderSchema.DataSourcesMap[dataSource].Schema[propertyName]
-			if !ok {
-				// New property, could be breaking - Required etc
-				baseItem = providerjson.SchemaJSON{}
-			}
-			if errs := compareNodeDataSource(baseItem, propertySchema, propertyName); errs != nil {
-				violations = append(violations, errs...)
-			}
+
+		for _, v := range compareNode(baseResource.Schema, currentResource.Schema, "", propertyRules) {
+			v.Kind = kind
+			v.Name = name
+			violations = append(violations, v)
 		}
 	}
 
+	// New resources have no breaking changes to worry about
+
 	return violations
 }
 
-func compareNodeResource(base providerjson.SchemaJSON, current providerjson.SchemaJSON, nodeName string) (errs []string) {
-	if nodeIsBlock(base) {
-		newBaseRaw := base.Elem.(providerjson.ResourceJSON).Schema
-		newCurrent := current.Elem.(*providerjson.ResourceJSON).Schema
-		for k, newBase := range newBaseRaw {
-			errs = append(errs, compareNodeResource(newBase, newCurrent[k], k)...)
-		}
+// compareNode checks each property within the base (released) and current schema against the rules, recursing
+// into any blocks which exist in both
+func compareNode(base map[string]providerjson.SchemaJSON, current map[string]providerjson.SchemaJSON, path string, rules []schema_rules.BreakingChangeRule) (violations []Violation) {
+	names := make(map[string]struct{})
+	for name := range base {
+		names[name] = struct{}{}
 	}
+	for name := range current {
+		names[name] = struct{}{}
+	}
+
+	for _, name := range sortedKeys(names) {
+		propertyPath := joinPath(path, name)
 
-	for _, v := range schema_rules.BreakingChangeRules {
-		if err := v.Check(base, current, nodeName); err != nil {
-			errs = append(errs, *err)
+		// either of these can be empty when the property has been added or removed
+		baseItem := base[name]
+		currentItem := current[name]
+
+		baseBlock, baseIsBlock := blockSchema(baseItem)
+		currentBlock, currentIsBlock := blockSchema(currentItem)
+		if baseIsBlock && currentIsBlock {
+			violations = append(violations, compareNode(baseBlock, currentBlock, propertyPath, rules)...)
+		}
+
+		for _, rule := range rules {
+			if err := rule.Check(baseItem, currentItem, propertyPath); err != nil {
+				violations = append(violations, Violation{
+					Rule:     rule.Name(),
+					Property: propertyPath,
+					Message:  *err,
+				})
+			}
 		}
 	}
 
 	return
 }
 
-func compareNodeDataSource(base providerjson.SchemaJSON, current providerjson.SchemaJSON, nodeName string) (errs []string) {
-	if nodeIsBlock(base) {
-		newBaseRaw := base.Elem.(providerjson.ResourceJSON).Schema
-		newCurrent := current.Elem.(*providerjson.ResourceJSON).Schema
-		for k, newBase := range newBaseRaw {
-			errs = append(errs, compareNodeDataSource(newBase, newCurrent[k], k)...)
-		}
+// blockSchema returns the nested schema when the property is a block - which is a value when loaded from
+// a file, but a pointer when loaded from the provider
+func blockSchema(input providerjson.SchemaJSON) (map[string]providerjson.SchemaJSON, bool) {
+	if input.Type != providerjson.SchemaTypeList && input.Type != providerjson.SchemaTypeSet {
+		return nil, false
 	}
 
-	for _, v := range schema_rules.BreakingChangeRulesDataSource {
-		if err := v.Check(base, current, nodeName); err != nil {
-			errs = append(errs, *err)
+	switch elem := input.Elem.(type) {
+	case providerjson.ResourceJSON:
+		return elem.Schema, true
+	case *providerjson.ResourceJSON:
+		if elem != nil {
+			return elem.Schema, true
 		}
 	}
 
-	return
+	return nil, false
 }
 
-func nodeIsBlock(input providerjson.SchemaJSON) bool {
-	if input.Type == providerjson.SchemaTypeList || input.Type == providerjson.SchemaTypeSet {
-		if _, ok := input.Elem.(providerjson.ResourceJSON); ok {
-			return true
-		}
+func sortedKeys[T any](input map[string]T) []string {
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
 	}
-
-	return false
+	sort.Strings(keys)
+	return keys
 }
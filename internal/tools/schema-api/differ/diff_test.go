@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+	schema_rules "github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/schema-rules"
+)
+
+func TestCompareResources(t *testing.T) {
+	base := map[string]providerjson.ResourceJSON{
+		"azurerm_example": {
+			Schema: map[string]providerjson.SchemaJSON{
+				"name": {
+					Type:     providerjson.SchemaTypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"settings": {
+					Type:     providerjson.SchemaTypeList,
+					Optional: true,
+					// loaded from a file the nested schema is a value..
+					Elem: providerjson.ResourceJSON{
+						Schema: map[string]providerjson.SchemaJSON{
+							"enabled": {
+								Type:     providerjson.SchemaTypeBool,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"removed_block": {
+					Type:     providerjson.SchemaTypeList,
+					Optional: true,
+					Elem: providerjson.ResourceJSON{
+						Schema: map[string]providerjson.SchemaJSON{},
+					},
+				},
+			},
+		},
+		"azurerm_removed": {
+			Schema: map[string]providerjson.SchemaJSON{},
+		},
+	}
+	current := map[string]providerjson.ResourceJSON{
+		"azurerm_example": {
+			Schema: map[string]providerjson.SchemaJSON{
+				"name": {
+					Type:     providerjson.SchemaTypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"settings": {
+					Type:     providerjson.SchemaTypeList,
+					Optional: true,
+					// .. whereas from the provider it's a pointer
+					Elem: &providerjson.ResourceJSON{
+						Schema: map[string]providerjson.SchemaJSON{
+							"enabled": {
+								Type:     providerjson.SchemaTypeBool,
+								Optional: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+			},
+		},
+		"azurerm_new": {
+			Schema: map[string]providerjson.SchemaJSON{},
+		},
+	}
+
+	violations := compareResources(KindResource, base, current, schema_rules.BreakingChangeResourceRules, schema_rules.BreakingChangeRules)
+	expected := []Violation{
+		{
+			Rule:    "resource-removed",
+			Kind:    KindResource,
+			Name:    "azurerm_removed",
+			Message: `"azurerm_removed" has been removed`,
+		},
+		{
+			Rule:     "property-removed",
+			Kind:     KindResource,
+			Name:     "azurerm_example",
+			Property: "removed_block",
+			Message:  `property "removed_block" has been removed`,
+		},
+		{
+			Rule:     "become-force-new",
+			Kind:     KindResource,
+			Name:     "azurerm_example",
+			Property: "settings.enabled",
+			Message:  `Cannot change property "settings.enabled" to ForceNew`,
+		},
+	}
+
+	if len(violations) != len(expected) {
+		t.Fatalf("expected %d violations but got %d: %+v", len(expected), len(violations), violations)
+	}
+	for _, v := range expected {
+		found := false
+		for _, actual := range violations {
+			if actual == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("expected the violation %+v but got %+v", v, violations)
+		}
+	}
+}
+
+func TestWriteViolationsSARIF(t *testing.T) {
+	violations := []Violation{
+		{
+			Rule:     "become-force-new",
+			Kind:     KindResource,
+			Name:     "azurerm_example",
+			Property: "settings.enabled",
+			Message:  `Cannot change property "settings.enabled" to ForceNew`,
+		},
+	}
+
+	buf := bytes.Buffer{}
+	if err := WriteViolations(&buf, OutputFormatSARIF, violations); err != nil {
+		t.Fatalf("writing violations: %+v", err)
+	}
+
+	var output sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &output); err != nil {
+		t.Fatalf("decoding output: %+v", err)
+	}
+
+	if output.Version != sarifVersion || len(output.Runs) != 1 {
+		t.Fatalf("expected a single SARIF %s run but got %+v", sarifVersion, output)
+	}
+	results := output.Runs[0].Results
+	if len(results) != 1 || results[0].RuleID != "become-force-new" {
+		t.Fatalf("expected a single result for the rule `become-force-new` but got %+v", results)
+	}
+	if name := results[0].Locations[0].LogicalLocations[0].FullyQualifiedName; name != "azurerm_example.settings.enabled" {
+		t.Fatalf("expected the location to be `azurerm_example.settings.enabled` but got %q", name)
+	}
+
+	rules := make(map[string]struct{})
+	for _, rule := range output.Runs[0].Tool.Driver.Rules {
+		rules[rule.ID] = struct{}{}
+	}
+	if _, ok := rules["become-force-new"]; !ok {
+		t.Fatalf("expected the rule `become-force-new` to be described but got %+v", output.Runs[0].Tool.Driver.Rules)
+	}
+
+	if err := WriteViolations(&buf, "xml", violations); err == nil {
+		t.Fatalf("expected an error for an unsupported output format")
+	}
+}
@@ -10,15 +10,25 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
 )
 
-func (d *Differ) loadFromFile(fileName string) error {
+// LoadSnapshot loads a Provider Schema which has been exported (using `-export`) from the specified file
+func LoadSnapshot(fileName string) (*providerjson.ProviderWrapper, error) {
 	f, err := os.Open(fileName)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer f.Close()
 	buf := &providerjson.ProviderWrapper{}
 	// TODO - Custom marshalling to fix the type assertions later? meh, works for now...
 	if err := json.NewDecoder(f).Decode(buf); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+func (d *Differ) loadFromFile(fileName string) error {
+	buf, err := LoadSnapshot(fileName)
+	if err != nil {
 		return err
 	}
 	d.base = buf
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	schema_rules "github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/schema-rules"
+)
+
+const (
+	OutputFormatText  = "text"
+	OutputFormatJSON  = "json"
+	OutputFormatSARIF = "sarif"
+)
+
+// OutputFormats are the formats supported by WriteViolations
+var OutputFormats = []string{
+	OutputFormatText,
+	OutputFormatJSON,
+	OutputFormatSARIF,
+}
+
+// WriteViolations writes the violations to w in the specified format, so that release tooling can gate on them
+func WriteViolations(w io.Writer, format string, violations []Violation) error {
+	switch format {
+	case OutputFormatText:
+		for _, v := range violations {
+			if _, err := fmt.Fprintln(w, v.String()); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case OutputFormatJSON:
+		return writeJSON(w, jsonOutput{
+			Violations: violations,
+		})
+
+	case OutputFormatSARIF:
+		return writeJSON(w, sarifFromViolations(violations))
+	}
+
+	return fmt.Errorf("unsupported output format %q", format)
+}
+
+type jsonOutput struct {
+	Violations []Violation `json:"violations"`
+}
+
+func writeJSON(w io.Writer, input interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(input); err != nil {
+		return fmt.Errorf("encoding output: %+v", err)
+	}
+	return nil
+}
+
+// the subset of the SARIF 2.1.0 format (https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html)
+// needed to report breaking changes
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+func sarifFromViolations(violations []Violation) sarifLog {
+	results := make([]sarifResult, 0, len(violations))
+	for _, v := range violations {
+		location := sarifLogicalLocation{
+			FullyQualifiedName: v.Name,
+			Kind:               "resource",
+		}
+		if v.Property != "" {
+			location.FullyQualifiedName = v.Name + "." + v.Property
+			location.Kind = "member"
+		}
+
+		results = append(results, sarifResult{
+			RuleID: v.Rule,
+			Level:  "error",
+			Message: sarifMessage{
+				Text: v.String(),
+			},
+			Locations: []sarifLocation{
+				{
+					LogicalLocations: []sarifLogicalLocation{location},
+				},
+			},
+		})
+	}
+
+	return sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:           "schema-api",
+						InformationURI: "https://github.com/hashicorp/terraform-provider-azurerm",
+						Rules:          sarifRules(),
+					},
+				},
+				Results: results,
+			},
+		},
+	}
+}
+
+// sarifRules returns the metadata for each of the Breaking Change rules, in the order they're defined
+func sarifRules() []sarifRule {
+	rules := make([]sarifRule, 0)
+	seen := make(map[string]struct{})
+	add := func(name, description string) {
+		if _, ok := seen[name]; ok {
+			return
+		}
+		seen[name] = struct{}{}
+		rules = append(rules, sarifRule{
+			ID: name,
+			ShortDescription: sarifMessage{
+				Text: description,
+			},
+		})
+	}
+
+	for _, rule := range schema_rules.BreakingChangeResourceRules {
+		add(rule.Name(), rule.Description())
+	}
+	for _, rule := range schema_rules.BreakingChangeResourceRulesDataSource {
+		add(rule.Name(), rule.Description())
+	}
+	for _, rule := range schema_rules.BreakingChangeRules {
+		add(rule.Name(), rule.Description())
+	}
+	for _, rule := range schema_rules.BreakingChangeRulesDataSource {
+		add(rule.Name(), rule.Description())
+	}
+
+	return rules
+}
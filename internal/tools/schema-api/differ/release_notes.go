@@ -0,0 +1,472 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+	schema_rules "github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/schema-rules"
+)
+
+// PropertyChange describes a change to a Resource or Data Source, or to one of its properties
+type PropertyChange struct {
+	// Kind is either `resource` or `data-source`
+	Kind string
+
+	// Name is the name of the Resource or Data Source, e.g. `azurerm_resource_group`
+	Name string
+
+	// Property is the path to the property within the Resource or Data Source (e.g. `identity.type`), or
+	// empty when the change applies to the Resource or Data Source itself
+	Property string
+
+	// IsBlock specifies whether the property is a block, rather than an argument or attribute
+	IsBlock bool
+
+	// Message is the Deprecation Message, for deprecations
+	Message string
+}
+
+// ReleaseNotes describes the changes between two Provider Schemas, which are used to draft the CHANGELOG
+// entries and upgrade guide for a release
+type ReleaseNotes struct {
+	NewResources   []string
+	NewDataSources []string
+
+	// NewProperties are the properties added to existing Resources and Data Sources - properties within
+	// new blocks aren't included, since the block is
+	NewProperties []PropertyChange
+
+	// Deprecations are the Resources, Data Sources and properties which have been deprecated - which are
+	// typically those gated behind `features.FivePointOh()` to be removed in the next major version
+	Deprecations []PropertyChange
+
+	BreakingChanges []Violation
+
+	// baseDeprecations are the Deprecation Messages for the Resources, Data Sources and properties which
+	// were deprecated in the base schema, which are used to describe their removal
+	baseDeprecations map[PropertyChange]string
+}
+
+// BuildReleaseNotes compares the base (released) and current Provider Schemas
+func BuildReleaseNotes(base *providerjson.ProviderWrapper, current *providerjson.ProviderWrapper) (*ReleaseNotes, error) {
+	if base == nil || base.ProviderSchema == nil || current == nil || current.ProviderSchema == nil {
+		return nil, fmt.Errorf("both the base and current provider schemas must be specified")
+	}
+	if base.ProviderName != current.ProviderName {
+		return nil, fmt.Errorf("provider name mismatch, expected %q, got %q", base.ProviderName, current.ProviderName)
+	}
+
+	notes := &ReleaseNotes{
+		NewResources:     newResources(base.ProviderSchema.ResourcesMap, current.ProviderSchema.ResourcesMap),
+		NewDataSources:   newResources(base.ProviderSchema.DataSourcesMap, current.ProviderSchema.DataSourcesMap),
+		NewProperties:    make([]PropertyChange, 0),
+		Deprecations:     make([]PropertyChange, 0),
+		baseDeprecations: make(map[PropertyChange]string),
+	}
+
+	notes.BreakingChanges = compareResources(KindResource, base.ProviderSchema.ResourcesMap, current.ProviderSchema.ResourcesMap, schema_rules.BreakingChangeResourceRules, schema_rules.RulesForBase(base.ProviderSchema, schema_rules.BreakingChangeRules))
+	notes.BreakingChanges = append(notes.BreakingChanges, compareResources(KindDataSource, base.ProviderSchema.DataSourcesMap, current.ProviderSchema.DataSourcesMap, schema_rules.BreakingChangeResourceRulesDataSource, schema_rules.RulesForBase(base.ProviderSchema, schema_rules.BreakingChangeRulesDataSource))...)
+
+	notes.compareResources(KindResource, base.ProviderSchema.ResourcesMap, current.ProviderSchema.ResourcesMap)
+	notes.compareResources(KindDataSource, base.ProviderSchema.DataSourcesMap, current.ProviderSchema.DataSourcesMap)
+
+	return notes, nil
+}
+
+func newResources(base map[string]providerjson.ResourceJSON, current map[string]providerjson.ResourceJSON) []string {
+	result := make([]string, 0)
+	for _, name := range sortedKeys(current) {
+		if _, ok := base[name]; !ok {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+func (r *ReleaseNotes) compareResources(kind string, base map[string]providerjson.ResourceJSON, current map[string]providerjson.ResourceJSON) {
+	for _, name := range sortedKeys(base) {
+		baseResource := base[name]
+		if baseResource.DeprecationMessage != "" {
+			r.baseDeprecations[PropertyChange{Kind: kind, Name: name}] = baseResource.DeprecationMessage
+		}
+		r.recordBaseDeprecations(kind, name, baseResource.Schema, "")
+
+		currentResource, ok := current[name]
+		if !ok {
+			continue
+		}
+
+		if currentResource.DeprecationMessage != "" && baseResource.DeprecationMessage == "" {
+			r.Deprecations = append(r.Deprecations, PropertyChange{
+				Kind:    kind,
+				Name:    name,
+				Message: currentResource.DeprecationMessage,
+			})
+		}
+
+		r.compareProperties(kind, name, baseResource.Schema, currentResource.Schema, "")
+	}
+}
+
+func (r *ReleaseNotes) recordBaseDeprecations(kind string, name string, base map[string]providerjson.SchemaJSON, path string) {
+	for _, propertyName := range sortedKeys(base) {
+		propertyPath := joinPath(path, propertyName)
+		if base[propertyName].Deprecated != "" {
+			r.baseDeprecations[PropertyChange{Kind: kind, Name: name, Property: propertyPath}] = base[propertyName].Deprecated
+		}
+		if nested, ok := blockSchema(base[propertyName]); ok {
+			r.recordBaseDeprecations(kind, name, nested, propertyPath)
+		}
+	}
+}
+
+func (r *ReleaseNotes) compareProperties(kind string, name string, base map[string]providerjson.SchemaJSON, current map[string]providerjson.SchemaJSON, path string) {
+	for _, propertyName := range sortedKeys(current) {
+		propertyPath := joinPath(path, propertyName)
+		currentItem := current[propertyName]
+		currentBlock, currentIsBlock := blockSchema(currentItem)
+
+		baseItem, ok := base[propertyName]
+		if !ok {
+			r.NewProperties = append(r.NewProperties, PropertyChange{
+				Kind:     kind,
+				Name:     name,
+				Property: propertyPath,
+				IsBlock:  currentIsBlock,
+			})
+			continue
+		}
+
+		if currentItem.Deprecated != "" && baseItem.Deprecated == "" {
+			r.Deprecations = append(r.Deprecations, PropertyChange{
+				Kind:     kind,
+				Name:     name,
+				Property: propertyPath,
+				IsBlock:  currentIsBlock,
+				Message:  currentItem.Deprecated,
+			})
+		}
+
+		if baseBlock, ok := blockSchema(baseItem); ok && currentIsBlock {
+			r.compareProperties(kind, name, baseBlock, currentBlock, propertyPath)
+		}
+	}
+}
+
+// Changelog returns the draft CHANGELOG entries for these changes, using the formatting enforced by
+// `internal/tools/changelog-formatter`
+func (r ReleaseNotes) Changelog() string {
+	breaking := make([]string, 0)
+	for _, v := range r.BreakingChanges {
+		for _, line := range r.changelogBreakingChange(v) {
+			breaking = append(breaking, fmt.Sprintf("* %s - %s", changelogSubject(v.Kind, v.Name), line))
+		}
+	}
+
+	features := make([]string, 0)
+	for _, name := range r.NewDataSources {
+		features = append(features, fmt.Sprintf("* **New Data Source**: `%s`", name))
+	}
+	for _, name := range r.NewResources {
+		features = append(features, fmt.Sprintf("* **New Resource**: `%s`", name))
+	}
+
+	// new properties are grouped by Resource, e.g. "`azurerm_example` - add support for the `foo` and `bar` properties"
+	type entry struct {
+		kind  string
+		name  string
+		lines []string
+	}
+	entries := make(map[string]*entry)
+	entryFor := func(kind, name string) *entry {
+		key := kind + "/" + name
+		if _, ok := entries[key]; !ok {
+			entries[key] = &entry{
+				kind: kind,
+				name: name,
+			}
+		}
+		return entries[key]
+	}
+
+	added := make(map[string][]PropertyChange)
+	for _, v := range r.NewProperties {
+		key := v.Kind + "/" + v.Name
+		added[key] = append(added[key], v)
+	}
+	for _, key := range sortedKeys(added) {
+		properties := added[key]
+		e := entryFor(properties[0].Kind, properties[0].Name)
+		e.lines = append(e.lines, fmt.Sprintf("add support for %s", describeProperties(properties)))
+	}
+	for _, v := range r.Deprecations {
+		e := entryFor(v.Kind, v.Name)
+		if v.Property == "" {
+			e.lines = append(e.lines, fmt.Sprintf("this %s has been deprecated", kindDescription(v.Kind)))
+			continue
+		}
+		e.lines = append(e.lines, fmt.Sprintf("%s has been deprecated", describeProperties([]PropertyChange{v})))
+	}
+
+	// as with the changelog-formatter, Data Sources are listed before Resources
+	enhancements := make([]string, 0)
+	for _, kind := range []string{KindDataSource, KindResource} {
+		for _, key := range sortedKeys(entries) {
+			e := entries[key]
+			if e.kind != kind {
+				continue
+			}
+			for _, line := range e.lines {
+				enhancements = append(enhancements, fmt.Sprintf("* %s - %s", changelogSubject(e.kind, e.name), line))
+			}
+		}
+	}
+
+	output := make([]string, 0)
+	output = append(output, changelogSection("BREAKING CHANGES:", breaking)...)
+	output = append(output, changelogSection("FEATURES:", features)...)
+	output = append(output, changelogSection("ENHANCEMENTS:", enhancements)...)
+	return strings.Join(output, "\n")
+}
+
+// changelogBreakingChange describes the breaking change in the style of the existing CHANGELOG entries, e.g. "the
+// `foo` property is now marked as `ForceNew`" - using the details within the message where the rule includes them
+func (r ReleaseNotes) changelogBreakingChange(v Violation) []string {
+	property := fmt.Sprintf("the `%s` property", v.Property)
+	details := messageDetails(v.Message)
+
+	switch v.Rule {
+	case schema_rules.ResourceRemovedRuleName:
+		if _, ok := r.baseDeprecations[PropertyChange{Kind: v.Kind, Name: v.Name}]; ok {
+			return []string{fmt.Sprintf("this deprecated %s has been removed", kindDescription(v.Kind))}
+		}
+		return []string{fmt.Sprintf("this %s has been removed", kindDescription(v.Kind))}
+
+	case schema_rules.PropertyRemovedRuleName:
+		if _, ok := r.baseDeprecations[PropertyChange{Kind: v.Kind, Name: v.Name, Property: v.Property}]; ok {
+			return []string{fmt.Sprintf("the deprecated `%s` property has been removed", v.Property)}
+		}
+		return []string{fmt.Sprintf("%s has been removed", property)}
+
+	case schema_rules.BecomeComputedOnlyRuleName:
+		return []string{fmt.Sprintf("%s can no longer be set", property)}
+
+	case schema_rules.BecomeForceNewRuleName:
+		return []string{fmt.Sprintf("%s is now marked as `ForceNew`", property)}
+
+	case schema_rules.ConflictsWithAddedRuleName:
+		return []string{fmt.Sprintf("%s can no longer be specified alongside %s", property, codeList(strings.Split(details, ", ")))}
+
+	case schema_rules.ExactlyOneOfAddedRuleName:
+		return []string{fmt.Sprintf("%s can no longer be specified alongside %s, and exactly one of them must be specified", property, codeList(strings.Split(details, ", ")))}
+
+	case schema_rules.MaxItemsReducedRuleName:
+		if from, to, ok := strings.Cut(details, " to "); ok {
+			return []string{fmt.Sprintf("the maximum number of items for %s has been reduced from `%s` to `%s`", property, from, to)}
+		}
+		return []string{fmt.Sprintf("the maximum number of items for %s is now `%s`", property, details)}
+
+	case schema_rules.MinItemsIncreasedRuleName:
+		from, to, _ := strings.Cut(details, " to ")
+		return []string{fmt.Sprintf("the minimum number of items for %s has been increased from `%s` to `%s`", property, from, to)}
+
+	case schema_rules.NewRequiredPropertyRuleName:
+		return []string{fmt.Sprintf("the new `%s` property is required", v.Property)}
+
+	case schema_rules.OptionalRemoveComputedRuleName:
+		return []string{fmt.Sprintf("%s is no longer `Computed`, so a value must be specified to avoid a diff", property)}
+
+	case schema_rules.OptionalToRequiredRuleName:
+		return []string{fmt.Sprintf("%s is now required", property)}
+
+	case schema_rules.PropertyTypeRuleName:
+		from, to, _ := strings.Cut(details, " to ")
+		return []string{fmt.Sprintf("the type of %s has changed from `%s` to `%s`", property, from, to)}
+
+	case schema_rules.TimeoutReducedRuleName:
+		// e.g. "Cannot reduce the default timeouts for "azurerm_example": create (60 to 30 minutes), delete (30 to 10 minutes)"
+		_, reduced, _ := strings.Cut(v.Message, ": ")
+		lines := make([]string, 0)
+		for _, timeout := range strings.Split(strings.TrimSuffix(reduced, ")"), "), ") {
+			operation, minutes, _ := strings.Cut(timeout, " (")
+			from, to, _ := strings.Cut(strings.TrimSuffix(minutes, " minutes"), " to ")
+			lines = append(lines, fmt.Sprintf("the default `%s` timeout has been reduced from %s to %s minutes", operation, from, to))
+		}
+		return lines
+	}
+
+	return []string{v.Message}
+}
+
+// UpgradeGuide returns a draft upgrade guide section for these changes, using the structure of the
+// existing upgrade guides within `website/docs/guides`
+func (r ReleaseNotes) UpgradeGuide() string {
+	removed := map[string][]string{}
+	breaking := map[string]map[string][]string{
+		KindResource:   {},
+		KindDataSource: {},
+	}
+
+	for _, v := range r.BreakingChanges {
+		if v.Property == "" && v.Rule == schema_rules.ResourceRemovedRuleName {
+			description := fmt.Sprintf("This %s has been removed from the Azure Provider.", kindDescription(v.Kind))
+			if message, ok := r.baseDeprecations[PropertyChange{Kind: v.Kind, Name: v.Name}]; ok {
+				description = fmt.Sprintf("This deprecated %s has been removed from the Azure Provider. %s", kindDescription(v.Kind), sentence(message))
+			}
+			removed[v.Kind] = append(removed[v.Kind], fmt.Sprintf("### `%s`\n\n%s", v.Name, description))
+			continue
+		}
+
+		line := sentence(v.Message)
+		if v.Property != "" && v.Rule == schema_rules.PropertyRemovedRuleName {
+			line = fmt.Sprintf("The `%s` property has been removed.", v.Property)
+			if message, ok := r.baseDeprecations[PropertyChange{Kind: v.Kind, Name: v.Name, Property: v.Property}]; ok {
+				line = fmt.Sprintf("The deprecated `%s` property has been removed. %s", v.Property, sentence(message))
+			}
+		}
+		breaking[v.Kind][v.Name] = append(breaking[v.Kind][v.Name], fmt.Sprintf("* %s", line))
+	}
+
+	deprecations := map[string][]string{}
+	for _, v := range r.Deprecations {
+		line := fmt.Sprintf("* This %s has been deprecated. %s", kindDescription(v.Kind), sentence(v.Message))
+		if v.Property != "" {
+			line = fmt.Sprintf("* The `%s` property has been deprecated. %s", v.Property, sentence(v.Message))
+		}
+		key := v.Kind + "/" + v.Name
+		deprecations[key] = append(deprecations[key], line)
+	}
+
+	sections := make([]string, 0)
+	addSection := func(heading string, content []string) {
+		if len(content) > 0 {
+			sections = append(sections, fmt.Sprintf("## %s\n\n%s", heading, strings.Join(content, "\n\n")))
+		}
+	}
+	groupByName := func(input map[string][]string) []string {
+		result := make([]string, 0)
+		for _, name := range sortedKeys(input) {
+			result = append(result, fmt.Sprintf("### `%s`\n\n%s", name, strings.Join(input[name], "\n")))
+		}
+		return result
+	}
+
+	addSection("Removed Resources", removed[KindResource])
+	addSection("Removed Data Sources", removed[KindDataSource])
+	addSection("Breaking Changes in Resources", groupByName(breaking[KindResource]))
+	addSection("Breaking Changes in Data Sources", groupByName(breaking[KindDataSource]))
+
+	deprecatedResources := map[string][]string{}
+	deprecatedDataSources := map[string][]string{}
+	for key, lines := range deprecations {
+		kind, name, _ := strings.Cut(key, "/")
+		if kind == KindResource {
+			deprecatedResources[name] = lines
+		} else {
+			deprecatedDataSources[name] = lines
+		}
+	}
+	addSection("Deprecations in Resources", groupByName(deprecatedResources))
+	addSection("Deprecations in Data Sources", groupByName(deprecatedDataSources))
+
+	return strings.Join(sections, "\n\n") + "\n"
+}
+
+// changelogSection formats the section in the same way as the changelog-formatter - with a blank line
+// after the heading and after the last entry
+func changelogSection(heading string, entries []string) []string {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	result := []string{heading, ""}
+	result = append(result, entries...)
+	return append(result, "")
+}
+
+func changelogSubject(kind string, name string) string {
+	if kind == KindDataSource {
+		return fmt.Sprintf("Data Source: `%s`", name)
+	}
+	return fmt.Sprintf("`%s`", name)
+}
+
+func kindDescription(kind string) string {
+	if kind == KindDataSource {
+		return "data source"
+	}
+	return "resource"
+}
+
+// describeProperties returns a description of the properties, e.g. "the `foo` property" or
+// "the `foo`, `bar` and `baz` properties"
+func describeProperties(input []PropertyChange) string {
+	names := make([]string, 0, len(input))
+	allBlocks := true
+	for _, v := range input {
+		names = append(names, fmt.Sprintf("`%s`", v.Property))
+		allBlocks = allBlocks && v.IsBlock
+	}
+	sort.Strings(names)
+
+	noun := "property"
+	if allBlocks {
+		noun = "block"
+	}
+	if len(names) == 1 {
+		return fmt.Sprintf("the %s %s", names[0], noun)
+	}
+
+	if allBlocks {
+		noun = "blocks"
+	} else {
+		noun = "properties"
+	}
+	return fmt.Sprintf("the %s and %s %s", strings.Join(names[:len(names)-1], ", "), names[len(names)-1], noun)
+}
+
+// codeList returns the names formatted as a list, e.g. "`foo`" or "`foo`, `bar` and `baz`"
+func codeList(input []string) string {
+	names := make([]string, 0, len(input))
+	for _, v := range input {
+		names = append(names, fmt.Sprintf("`%s`", v))
+	}
+	if len(names) == 1 {
+		return names[0]
+	}
+	return fmt.Sprintf("%s and %s", strings.Join(names[:len(names)-1], ", "), names[len(names)-1])
+}
+
+// messageDetails returns the details within the parentheses of a breaking change message, e.g. "3 to 1" for
+// "Cannot reduce MaxItems for property "foo" (3 to 1)"
+func messageDetails(message string) string {
+	_, details, ok := strings.Cut(message, "(")
+	if !ok {
+		return ""
+	}
+	if i := strings.LastIndex(details, ")"); i >= 0 {
+		details = details[:i]
+	}
+	return details
+}
+
+// sentence ensures the input ends with a full stop
+func sentence(input string) string {
+	input = strings.TrimSpace(input)
+	if input == "" || strings.HasSuffix(input, ".") {
+		return input
+	}
+	return input + "."
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+	schema_rules "github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/schema-rules"
+)
+
+func TestReleaseNotes(t *testing.T) {
+	base := &providerjson.ProviderWrapper{
+		ProviderName: "azurerm",
+		ProviderSchema: &providerjson.ProviderSchemaJSON{
+			ResourcesMap: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {
+					Schema: map[string]providerjson.SchemaJSON{
+						"name": {
+							Type:     providerjson.SchemaTypeString,
+							Required: true,
+						},
+						"legacy_enabled": {
+							Type:       providerjson.SchemaTypeBool,
+							Optional:   true,
+							Deprecated: "`legacy_enabled` will be removed in v5.0 of the AzureRM Provider",
+						},
+						"sku": {
+							Type:     providerjson.SchemaTypeString,
+							Optional: true,
+						},
+					},
+				},
+				"azurerm_legacy": {
+					Schema:             map[string]providerjson.SchemaJSON{},
+					DeprecationMessage: "`azurerm_legacy` has been superseded by `azurerm_example`",
+				},
+			},
+			DataSourcesMap: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {
+					Schema: map[string]providerjson.SchemaJSON{
+						"name": {
+							Type:     providerjson.SchemaTypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+	current := &providerjson.ProviderWrapper{
+		ProviderName: "azurerm",
+		ProviderSchema: &providerjson.ProviderSchemaJSON{
+			ResourcesMap: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {
+					Schema: map[string]providerjson.SchemaJSON{
+						"name": {
+							Type:     providerjson.SchemaTypeString,
+							Required: true,
+						},
+						"sku": {
+							Type:       providerjson.SchemaTypeString,
+							Optional:   true,
+							Deprecated: "`sku` will be removed in favour of `sku_name` in v5.0 of the AzureRM Provider",
+						},
+						"sku_name": {
+							Type:     providerjson.SchemaTypeString,
+							Optional: true,
+						},
+						"network": {
+							Type:     providerjson.SchemaTypeList,
+							Optional: true,
+							Elem: &providerjson.ResourceJSON{
+								Schema: map[string]providerjson.SchemaJSON{},
+							},
+						},
+					},
+				},
+				"azurerm_new": {
+					Schema: map[string]providerjson.SchemaJSON{},
+				},
+			},
+			DataSourcesMap: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {
+					Schema: map[string]providerjson.SchemaJSON{
+						"name": {
+							Type:     providerjson.SchemaTypeString,
+							Required: true,
+						},
+						"sku_name": {
+							Type:     providerjson.SchemaTypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	notes, err := BuildReleaseNotes(base, current)
+	if err != nil {
+		t.Fatalf("building release notes: %+v", err)
+	}
+
+	expectedChangelog := strings.Join([]string{
+		"BREAKING CHANGES:",
+		"",
+		"* `azurerm_example` - the deprecated `legacy_enabled` property has been removed",
+		"* `azurerm_legacy` - this deprecated resource has been removed",
+		"",
+		"FEATURES:",
+		"",
+		"* **New Resource**: `azurerm_new`",
+		"",
+		"ENHANCEMENTS:",
+		"",
+		"* Data Source: `azurerm_example` - add support for the `sku_name` property",
+		"* `azurerm_example` - add support for the `network` and `sku_name` properties",
+		"* `azurerm_example` - the `sku` property has been deprecated",
+		"",
+	}, "\n")
+	if actual := notes.Changelog(); actual != expectedChangelog {
+		t.Fatalf("expected the changelog:\n\n%s\n\nbut got:\n\n%s", expectedChangelog, actual)
+	}
+
+	expectedUpgradeGuide := strings.Join([]string{
+		"## Removed Resources",
+		"",
+		"### `azurerm_legacy`",
+		"",
+		"This deprecated resource has been removed from the Azure Provider. `azurerm_legacy` has been superseded by `azurerm_example`.",
+		"",
+		"## Breaking Changes in Resources",
+		"",
+		"### `azurerm_example`",
+		"",
+		"* The deprecated `legacy_enabled` property has been removed. `legacy_enabled` will be removed in v5.0 of the AzureRM Provider.",
+		"",
+		"## Deprecations in Resources",
+		"",
+		"### `azurerm_example`",
+		"",
+		"* The `sku` property has been deprecated. `sku` will be removed in favour of `sku_name` in v5.0 of the AzureRM Provider.",
+		"",
+	}, "\n")
+	if actual := notes.UpgradeGuide(); actual != expectedUpgradeGuide {
+		t.Fatalf("expected the upgrade guide:\n\n%s\n\nbut got:\n\n%s", expectedUpgradeGuide, actual)
+	}
+}
+
+func TestReleaseNotesChangelogBreakingChanges(t *testing.T) {
+	testCases := []struct {
+		violation Violation
+		expected  []string
+	}{
+		{
+			violation: Violation{Rule: schema_rules.ResourceRemovedRuleName, Kind: KindDataSource, Name: "azurerm_example", Message: "\"azurerm_example\" has been removed"},
+			expected:  []string{"this data source has been removed"},
+		},
+		{
+			violation: Violation{Rule: schema_rules.PropertyRemovedRuleName, Kind: KindResource, Name: "azurerm_example", Property: "foo", Message: "property \"foo\" has been removed"},
+			expected:  []string{"the `foo` property has been removed"},
+		},
+		{
+			violation: Violation{Rule: schema_rules.BecomeComputedOnlyRuleName, Kind: KindResource, Name: "azurerm_example", Property: "foo", Message: "Cannot change property \"foo\" to Computed only"},
+			expected:  []string{"the `foo` property can no longer be set"},
+		},
+		{
+			violation: Violation{Rule: schema_rules.BecomeForceNewRuleName, Kind: KindResource, Name: "azurerm_example", Property: "foo", Message: "Cannot change property \"foo\" to ForceNew"},
+			expected:  []string{"the `foo` property is now marked as `ForceNew`"},
+		},
+		{
+			violation: Violation{Rule: schema_rules.ConflictsWithAddedRuleName, Kind: KindResource, Name: "azurerm_example", Property: "foo", Message: "Cannot add ConflictsWith to property \"foo\" (bar, block.0.baz)"},
+			expected:  []string{"the `foo` property can no longer be specified alongside `bar` and `block.0.baz`"},
+		},
+		{
+			violation: Violation{Rule: schema_rules.ExactlyOneOfAddedRuleName, Kind: KindResource, Name: "azurerm_example", Property: "foo", Message: "Cannot add ExactlyOneOf to property \"foo\" (bar)"},
+			expected:  []string{"the `foo` property can no longer be specified alongside `bar`, and exactly one of them must be specified"},
+		},
+		{
+			violation: Violation{Rule: schema_rules.MaxItemsReducedRuleName, Kind: KindResource, Name: "azurerm_example", Property: "foo", Message: "Cannot add MaxItems (1) to property \"foo\""},
+			expected:  []string{"the maximum number of items for the `foo` property is now `1`"},
+		},
+		{
+			violation: Violation{Rule: schema_rules.MaxItemsReducedRuleName, Kind: KindResource, Name: "azurerm_example", Property: "foo", Message: "Cannot reduce MaxItems for property \"foo\" (3 to 1)"},
+			expected:  []string{"the maximum number of items for the `foo` property has been reduced from `3` to `1`"},
+		},
+		{
+			violation: Violation{Rule: schema_rules.MinItemsIncreasedRuleName, Kind: KindResource, Name: "azurerm_example", Property: "foo", Message: "Cannot increase MinItems for property \"foo\" (1 to 2)"},
+			expected:  []string{"the minimum number of items for the `foo` property has been increased from `1` to `2`"},
+		},
+		{
+			violation: Violation{Rule: schema_rules.NewRequiredPropertyRuleName, Kind: KindResource, Name: "azurerm_example", Property: "foo", Message: "new property \"foo\" is Required"},
+			expected:  []string{"the new `foo` property is required"},
+		},
+		{
+			violation: Violation{Rule: schema_rules.OptionalRemoveComputedRuleName, Kind: KindResource, Name: "azurerm_example", Property: "foo", Message: "cannot remove Computed from the Optional property \"foo\" as the user config may not supply the value, thus causing a diff"},
+			expected:  []string{"the `foo` property is no longer `Computed`, so a value must be specified to avoid a diff"},
+		},
+		{
+			violation: Violation{Rule: schema_rules.OptionalToRequiredRuleName, Kind: KindResource, Name: "azurerm_example", Property: "foo", Message: "Cannot change property \"foo\" from Optional to Required"},
+			expected:  []string{"the `foo` property is now required"},
+		},
+		{
+			violation: Violation{Rule: schema_rules.PropertyTypeRuleName, Kind: KindResource, Name: "azurerm_example", Property: "foo", Message: "schema type has changed for \"foo\" (TypeString to TypeList)"},
+			expected:  []string{"the type of the `foo` property has changed from `TypeString` to `TypeList`"},
+		},
+		{
+			violation: Violation{Rule: schema_rules.TimeoutReducedRuleName, Kind: KindResource, Name: "azurerm_example", Message: "Cannot reduce the default timeouts for \"azurerm_example\": create (60 to 30 minutes), delete (30 to 10 minutes)"},
+			expected: []string{
+				"the default `create` timeout has been reduced from 60 to 30 minutes",
+				"the default `delete` timeout has been reduced from 30 to 10 minutes",
+			},
+		},
+		{
+			violation: Violation{Rule: "unknown", Kind: KindResource, Name: "azurerm_example", Message: "something has changed"},
+			expected:  []string{"something has changed"},
+		},
+	}
+
+	notes := ReleaseNotes{}
+	for _, tc := range testCases {
+		t.Run(tc.violation.Message, func(t *testing.T) {
+			actual := notes.changelogBreakingChange(tc.violation)
+			if strings.Join(actual, "\n") != strings.Join(tc.expected, "\n") {
+				t.Fatalf("expected %q but got %q", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
+	"strings"
 	"syscall"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -26,6 +28,8 @@ func main() {
 	exportSchema := f.String("export", "", "export the schema to the given path/filename. Intended for use in the release process")
 	detectBreakingChanges := f.String("detect", "", "compare current schema to named dump.")
 	errorOnBreakingChange := f.Bool("error-on-violation", false, "should the detect mode exit with a non-zero error code. Defaults to `false`")
+	outputFormat := f.String("output-format", differ.OutputFormatText, fmt.Sprintf("the format used to output violations in detect mode, one of %s. Defaults to `text`", strings.Join(differ.OutputFormats, ", ")))
+	outputFile := f.String("output-file", "", "write the violations from detect mode to the given path/filename, rather than to stdout")
 
 	if err := f.Parse(os.Args[1:]); err != nil {
 		fmt.Printf("error parsing args: %+v", err)
@@ -52,14 +56,26 @@ func main() {
 
 	case pointer.From(detectBreakingChanges) != "":
 		{
+			if !slices.Contains(differ.OutputFormats, *outputFormat) {
+				log.Fatalf("invalid value for output-format, must be one of %s, got %q", strings.Join(differ.OutputFormats, ", "), *outputFormat)
+			}
+
 			d := differ.Differ{}
-			if violations := d.Diff(*detectBreakingChanges, *providerName); violations != nil {
+			violations, err := d.Diff(*detectBreakingChanges, *providerName)
+			if err != nil {
+				log.Fatalf("error detecting breaking changes: %+v", err)
+			}
+
+			if *outputFormat == differ.OutputFormatText && *outputFile == "" {
 				for _, v := range violations {
 					log.Println(v)
 				}
-				if pointer.From(errorOnBreakingChange) {
-					os.Exit(1)
-				}
+			} else if err := writeViolations(*outputFile, *outputFormat, violations); err != nil {
+				log.Fatalf("error writing violations: %+v", err)
+			}
+
+			if len(violations) > 0 && pointer.From(errorOnBreakingChange) {
+				os.Exit(1)
 			}
 
 			os.Exit(0)
@@ -107,3 +123,17 @@ func main() {
 	log.Printf("starting api service on localhost:%d", *apiPort)
 	log.Println(http.ListenAndServe(fmt.Sprintf(":%d", *apiPort), mux))
 }
+
+func writeViolations(fileName string, format string, violations []differ.Violation) error {
+	if fileName == "" {
+		return differ.WriteViolations(os.Stdout, format, violations)
+	}
+
+	f, err := os.Create(fileName)
+	if err != nil {
+		return fmt.Errorf("creating %q: %+v", fileName, err)
+	}
+	defer f.Close()
+
+	return differ.WriteViolations(f, format, violations)
+}
@@ -22,17 +22,20 @@ const (
 type ProviderJSON schema.Provider
 
 type SchemaJSON struct {
-	Type        string      `json:"type,omitempty"` // TODO - Needs to be interface{}
-	ConfigMode  string      `json:"configMode,omitempty"`
-	Optional    bool        `json:"optional,omitempty"`
-	Required    bool        `json:"required,omitempty"`
-	Default     interface{} `json:"default,omitempty"`
-	Description string      `json:"description,omitempty"`
-	Computed    bool        `json:"computed,omitempty"`
-	ForceNew    bool        `json:"forceNew,omitempty"`
-	Elem        interface{} `json:"elem,omitempty"`
-	MaxItems    int         `json:"maxItems,omitempty"`
-	MinItems    int         `json:"minItems,omitempty"`
+	Type          string      `json:"type,omitempty"` // TODO - Needs to be interface{}
+	ConfigMode    string      `json:"configMode,omitempty"`
+	Optional      bool        `json:"optional,omitempty"`
+	Required      bool        `json:"required,omitempty"`
+	Default       interface{} `json:"default,omitempty"`
+	Description   string      `json:"description,omitempty"`
+	Computed      bool        `json:"computed,omitempty"`
+	ForceNew      bool        `json:"forceNew,omitempty"`
+	Deprecated    string      `json:"deprecated,omitempty"`
+	Elem          interface{} `json:"elem,omitempty"`
+	MaxItems      int         `json:"maxItems,omitempty"`
+	MinItems      int         `json:"minItems,omitempty"`
+	ConflictsWith []string    `json:"conflictsWith,omitempty"`
+	ExactlyOneOf  []string    `json:"exactlyOneOf,omitempty"`
 }
 
 func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
@@ -48,12 +51,15 @@ func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
 	b.Description, _ = m["description"].(string)
 	b.Computed, _ = m["computed"].(bool)
 	b.ForceNew, _ = m["forceNew"].(bool)
+	b.Deprecated, _ = m["deprecated"].(string)
 	if max, ok := m["maxItems"].(float64); ok {
 		b.MaxItems = int(max)
 	}
 	if min, ok := m["minItems"].(float64); ok {
-		b.MaxItems = int(min)
+		b.MinItems = int(min)
 	}
+	b.ConflictsWith = stringSliceFromInterface(m["conflictsWith"])
+	b.ExactlyOneOf = stringSliceFromInterface(m["exactlyOneOf"])
 
 	if def, ok := m["default"]; ok && def != nil {
 		switch def.(type) {
@@ -83,9 +89,25 @@ func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+func stringSliceFromInterface(input interface{}) []string {
+	raw, ok := input.([]interface{})
+	if !ok || len(raw) == 0 {
+		return nil
+	}
+
+	result := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if s, ok := v.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
 type ResourceJSON struct {
-	Schema   map[string]SchemaJSON `json:"schema"`
-	Timeouts *ResourceTimeoutJSON  `json:"timeouts,omitempty"`
+	Schema             map[string]SchemaJSON `json:"schema"`
+	Timeouts           *ResourceTimeoutJSON  `json:"timeouts,omitempty"`
+	DeprecationMessage string                `json:"deprecationMessage,omitempty"`
 }
 
 type ResourceTimeoutJSON struct {
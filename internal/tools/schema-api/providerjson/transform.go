@@ -21,6 +21,7 @@ func resourceFromRaw(input *schema.Resource) (*ResourceJSON, error) {
 		translatedSchema[k] = schemaFromRaw(s)
 	}
 	result.Schema = translatedSchema
+	result.DeprecationMessage = input.DeprecationMessage
 
 	if input.Timeouts != nil {
 		timeouts := &ResourceTimeoutJSON{}
@@ -46,17 +47,20 @@ func resourceFromRaw(input *schema.Resource) (*ResourceJSON, error) {
 
 func schemaFromRaw(input *schema.Schema) SchemaJSON {
 	return SchemaJSON{
-		Type:        input.Type.String(),
-		ConfigMode:  decodeConfigMode(input.ConfigMode),
-		Optional:    input.Optional,
-		Required:    input.Required,
-		Default:     input.Default,
-		Description: input.Description,
-		Computed:    input.Computed,
-		ForceNew:    input.ForceNew,
-		Elem:        decodeElem(input.Elem),
-		MaxItems:    input.MaxItems,
-		MinItems:    input.MinItems,
+		Type:          input.Type.String(),
+		ConfigMode:    decodeConfigMode(input.ConfigMode),
+		Optional:      input.Optional,
+		Required:      input.Required,
+		Default:       input.Default,
+		Description:   input.Description,
+		Computed:      input.Computed,
+		ForceNew:      input.ForceNew,
+		Deprecated:    input.Deprecated,
+		Elem:          decodeElem(input.Elem),
+		MaxItems:      input.MaxItems,
+		MinItems:      input.MinItems,
+		ConflictsWith: input.ConflictsWith,
+		ExactlyOneOf:  input.ExactlyOneOf,
 	}
 }
 
@@ -94,8 +98,8 @@ func SchemaFromMap(input map[string]interface{}) SchemaJSON {
 		result.ForceNew = t.(bool)
 	}
 
-	if t, ok := input["forceNew"]; ok {
-		result.ForceNew = t.(bool)
+	if t, ok := input["deprecated"]; ok {
+		result.Deprecated = t.(string)
 	}
 
 	if t, ok := input["elem"]; ok {
@@ -110,6 +114,9 @@ func SchemaFromMap(input map[string]interface{}) SchemaJSON {
 		result.MaxItems = int(t.(float64))
 	}
 
+	result.ConflictsWith = stringSliceFromInterface(input["conflictsWith"])
+	result.ExactlyOneOf = stringSliceFromInterface(input["exactlyOneOf"])
+
 	return result
 }
 
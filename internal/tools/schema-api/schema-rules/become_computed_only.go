@@ -10,10 +10,21 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
 )
 
+// BecomeComputedOnlyRuleName is the name of the rule which detects existing properties becoming Computed only
+const BecomeComputedOnlyRuleName = "become-computed-only"
+
 type becomeComputedOnly struct{}
 
 var _ BreakingChangeRule = becomeComputedOnly{}
 
+func (becomeComputedOnly) Name() string {
+	return BecomeComputedOnlyRuleName
+}
+
+func (becomeComputedOnly) Description() string {
+	return "An Optional or Required property must not become Computed only"
+}
+
 // Check - Checks that an Optional or Required property is not updated to become Computed only
 func (o becomeComputedOnly) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
 	if (base.Optional || base.Required) && (!current.Optional && !current.Required && current.Computed) {
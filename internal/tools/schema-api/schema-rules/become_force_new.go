@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+// BecomeForceNewRuleName is the name of the rule which detects existing properties becoming ForceNew
+const BecomeForceNewRuleName = "become-force-new"
+
+type becomeForceNew struct{}
+
+var _ BreakingChangeRule = becomeForceNew{}
+
+func (becomeForceNew) Name() string {
+	return BecomeForceNewRuleName
+}
+
+func (becomeForceNew) Description() string {
+	return "An existing property must not become ForceNew"
+}
+
+// Check - Checks that an existing property is not updated to become ForceNew, since changes which could previously be applied in-place would recreate the resource
+func (becomeForceNew) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Type != "" && current.Type != "" && !base.ForceNew && current.ForceNew {
+		return pointer.To(fmt.Sprintf("Cannot change property %q to ForceNew", propertyName))
+	}
+
+	return nil
+}
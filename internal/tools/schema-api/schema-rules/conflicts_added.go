@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+// ConflictsWithAddedRuleName is the name of the rule which detects ConflictsWith entries being added to existing properties
+const ConflictsWithAddedRuleName = "conflicts-with-added"
+
+type conflictsWithAdded struct{}
+
+var (
+	_ BreakingChangeRule = conflictsWithAdded{}
+	_ BaseDataRule       = conflictsWithAdded{}
+)
+
+func (conflictsWithAdded) Name() string {
+	return ConflictsWithAddedRuleName
+}
+
+func (conflictsWithAdded) Description() string {
+	return "An existing property must not gain new ConflictsWith entries"
+}
+
+// HasBaseData - ConflictsWith is only present in schemas exported since it was added to the snapshot
+func (conflictsWithAdded) HasBaseData(base *providerjson.ProviderSchemaJSON) bool {
+	return anyProperty(base, func(v providerjson.SchemaJSON) bool {
+		return len(v.ConflictsWith) > 0
+	})
+}
+
+// Check - Checks that an existing property does not conflict with any properties it didn't previously, since existing configurations may specify both
+func (conflictsWithAdded) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Type == "" || current.Type == "" {
+		return nil
+	}
+
+	if added := addedValues(base.ConflictsWith, current.ConflictsWith); len(added) > 0 {
+		return pointer.To(fmt.Sprintf("Cannot add ConflictsWith to property %q (%s)", propertyName, strings.Join(added, ", ")))
+	}
+
+	return nil
+}
+
+// ExactlyOneOfAddedRuleName is the name of the rule which detects ExactlyOneOf entries being added to existing properties
+const ExactlyOneOfAddedRuleName = "exactly-one-of-added"
+
+type exactlyOneOfAdded struct{}
+
+var (
+	_ BreakingChangeRule = exactlyOneOfAdded{}
+	_ BaseDataRule       = exactlyOneOfAdded{}
+)
+
+func (exactlyOneOfAdded) Name() string {
+	return ExactlyOneOfAddedRuleName
+}
+
+func (exactlyOneOfAdded) Description() string {
+	return "An existing property must not gain new ExactlyOneOf entries"
+}
+
+// HasBaseData - ExactlyOneOf is only present in schemas exported since it was added to the snapshot
+func (exactlyOneOfAdded) HasBaseData(base *providerjson.ProviderSchemaJSON) bool {
+	return anyProperty(base, func(v providerjson.SchemaJSON) bool {
+		return len(v.ExactlyOneOf) > 0
+	})
+}
+
+// Check - Checks that an existing property has not been added to an ExactlyOneOf group, since existing configurations may specify none or several of these
+func (exactlyOneOfAdded) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Type == "" || current.Type == "" {
+		return nil
+	}
+
+	if added := addedValues(base.ExactlyOneOf, current.ExactlyOneOf); len(added) > 0 {
+		return pointer.To(fmt.Sprintf("Cannot add ExactlyOneOf to property %q (%s)", propertyName, strings.Join(added, ", ")))
+	}
+
+	return nil
+}
+
+// addedValues returns the values in current which aren't present in base
+func addedValues(base []string, current []string) []string {
+	added := make([]string, 0)
+	for _, v := range current {
+		if !slices.Contains(base, v) {
+			added = append(added, v)
+		}
+	}
+	return added
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var conflictsAddedBaseNode = providerjson.SchemaJSON{
+	Type:          providerjson.SchemaTypeString,
+	Optional:      true,
+	ConflictsWith: []string{"bar"},
+	ExactlyOneOf:  []string{"foo", "bar"},
+}
+
+var conflictsAddedPasses = providerjson.SchemaJSON{
+	Type:          providerjson.SchemaTypeString,
+	Optional:      true,
+	ConflictsWith: []string{"bar"},
+	ExactlyOneOf:  []string{"bar", "foo"},
+}
+
+var conflictsAddedViolates = providerjson.SchemaJSON{
+	Type:          providerjson.SchemaTypeString,
+	Optional:      true,
+	ConflictsWith: []string{"bar", "baz"},        // violation
+	ExactlyOneOf:  []string{"foo", "bar", "baz"}, // violation
+}
+
+func TestConflictsWithAdded_Check(t *testing.T) {
+	data := conflictsWithAdded{}
+	if res := data.Check(conflictsAddedBaseNode, conflictsAddedPasses, "foo"); res != nil {
+		t.Errorf("expected no violation, got %+v", *res)
+	}
+
+	if res := data.Check(conflictsAddedBaseNode, conflictsAddedViolates, "foo"); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+
+	if res := data.Check(providerjson.SchemaJSON{}, conflictsAddedViolates, "foo"); res != nil {
+		t.Errorf("expected no violation, got %+v", *res)
+	}
+}
+
+func TestExactlyOneOfAdded_Check(t *testing.T) {
+	data := exactlyOneOfAdded{}
+	if res := data.Check(conflictsAddedBaseNode, conflictsAddedPasses, "foo"); res != nil {
+		t.Errorf("expected no violation, got %+v", *res)
+	}
+
+	if res := data.Check(conflictsAddedBaseNode, conflictsAddedViolates, "foo"); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+
+	if res := data.Check(providerjson.SchemaJSON{}, conflictsAddedViolates, "foo"); res != nil {
+		t.Errorf("expected no violation, got %+v", *res)
+	}
+}
+
+func TestConflictsAdded_RulesForBase(t *testing.T) {
+	rules := []BreakingChangeRule{conflictsWithAdded{}, exactlyOneOfAdded{}, propertyRemoved{}}
+
+	// a base schema exported before ConflictsWith and ExactlyOneOf were included in the snapshot
+	base := &providerjson.ProviderSchemaJSON{
+		ResourcesMap: map[string]providerjson.ResourceJSON{
+			"azurerm_example": {
+				Schema: map[string]providerjson.SchemaJSON{
+					"foo": {
+						Type:     providerjson.SchemaTypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+	if actual := RulesForBase(base, rules); len(actual) != 1 || actual[0].Name() != "property-removed" {
+		t.Errorf("expected only the property-removed rule, got %+v", actual)
+	}
+
+	// the data may only be present within a block
+	base.DataSourcesMap = map[string]providerjson.ResourceJSON{
+		"azurerm_example": {
+			Schema: map[string]providerjson.SchemaJSON{
+				"block": {
+					Type:     providerjson.SchemaTypeList,
+					Optional: true,
+					Elem: providerjson.ResourceJSON{
+						Schema: map[string]providerjson.SchemaJSON{
+							"foo": conflictsAddedBaseNode,
+						},
+					},
+				},
+			},
+		},
+	}
+	if actual := RulesForBase(base, rules); len(actual) != len(rules) {
+		t.Errorf("expected all of the rules, got %+v", actual)
+	}
+}
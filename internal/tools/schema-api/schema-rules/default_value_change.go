@@ -14,6 +14,14 @@ type defaultValueChange struct{}
 
 var _ BreakingChangeRule = defaultValueChange{}
 
+func (defaultValueChange) Name() string {
+	return "default-value-change"
+}
+
+func (defaultValueChange) Description() string {
+	return "The default value of a property must not change"
+}
+
 // Check - Checks that an Optional or Required property is not updated to become Computed only
 func (o defaultValueChange) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
 	if base.Default != current.Default {
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+// MaxItemsReducedRuleName is the name of the rule which detects MaxItems being added or reduced
+const MaxItemsReducedRuleName = "max-items-reduced"
+
+type maxItemsReduced struct{}
+
+var _ BreakingChangeRule = maxItemsReduced{}
+
+func (maxItemsReduced) Name() string {
+	return MaxItemsReducedRuleName
+}
+
+func (maxItemsReduced) Description() string {
+	return "The MaxItems of an existing property must not be reduced"
+}
+
+// Check - Checks that the MaxItems of an existing property has not been introduced or reduced, since existing configurations may specify more items
+func (maxItemsReduced) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Type == "" || current.Type == "" || current.MaxItems == 0 {
+		return nil
+	}
+
+	if base.MaxItems == 0 {
+		return pointer.To(fmt.Sprintf("Cannot add MaxItems (%d) to property %q", current.MaxItems, propertyName))
+	}
+
+	if current.MaxItems < base.MaxItems {
+		return pointer.To(fmt.Sprintf("Cannot reduce MaxItems for property %q (%d to %d)", propertyName, base.MaxItems, current.MaxItems))
+	}
+
+	return nil
+}
+
+// MinItemsIncreasedRuleName is the name of the rule which detects MinItems being increased
+const MinItemsIncreasedRuleName = "min-items-increased"
+
+type minItemsIncreased struct{}
+
+var _ BreakingChangeRule = minItemsIncreased{}
+
+func (minItemsIncreased) Name() string {
+	return MinItemsIncreasedRuleName
+}
+
+func (minItemsIncreased) Description() string {
+	return "The MinItems of an existing property must not be increased"
+}
+
+// Check - Checks that the MinItems of an existing property has not been increased, since existing configurations may specify fewer items
+func (minItemsIncreased) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Type == "" || current.Type == "" {
+		return nil
+	}
+
+	if current.MinItems > base.MinItems {
+		return pointer.To(fmt.Sprintf("Cannot increase MinItems for property %q (%d to %d)", propertyName, base.MinItems, current.MinItems))
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var itemsLimitsBaseNode = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeList,
+	Optional: true,
+	MaxItems: 5,
+	MinItems: 1,
+}
+
+var itemsLimitsUnlimitedBaseNode = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeList,
+	Optional: true,
+}
+
+var itemsLimitsPasses = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeList,
+	Optional: true,
+	MaxItems: 10,
+	MinItems: 0,
+}
+
+var itemsLimitsViolates = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeList,
+	Optional: true,
+	MaxItems: 1, // violation
+	MinItems: 2, // violation
+}
+
+func TestMaxItemsReduced_Check(t *testing.T) {
+	data := maxItemsReduced{}
+	if res := data.Check(itemsLimitsBaseNode, itemsLimitsPasses, "foo"); res != nil {
+		t.Errorf("expected no violation, got %+v", *res)
+	}
+
+	if res := data.Check(itemsLimitsBaseNode, itemsLimitsUnlimitedBaseNode, "foo"); res != nil {
+		t.Errorf("expected no violation, got %+v", *res)
+	}
+
+	if res := data.Check(itemsLimitsBaseNode, itemsLimitsViolates, "foo"); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+
+	if res := data.Check(itemsLimitsUnlimitedBaseNode, itemsLimitsPasses, "foo"); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+
+	// new properties can specify MaxItems
+	if res := data.Check(providerjson.SchemaJSON{}, itemsLimitsViolates, "foo"); res != nil {
+		t.Errorf("expected no violation, got %+v", *res)
+	}
+}
+
+func TestMinItemsIncreased_Check(t *testing.T) {
+	data := minItemsIncreased{}
+	if res := data.Check(itemsLimitsBaseNode, itemsLimitsPasses, "foo"); res != nil {
+		t.Errorf("expected no violation, got %+v", *res)
+	}
+
+	if res := data.Check(itemsLimitsBaseNode, itemsLimitsViolates, "foo"); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+
+	// new properties can specify MinItems
+	if res := data.Check(providerjson.SchemaJSON{}, itemsLimitsViolates, "foo"); res != nil {
+		t.Errorf("expected no violation, got %+v", *res)
+	}
+}
@@ -14,8 +14,19 @@ import (
 
 var _ BreakingChangeRule = newRequiredPropertyExistingResource{}
 
+// NewRequiredPropertyRuleName is the name of the rule which detects new Required properties within existing Resources
+const NewRequiredPropertyRuleName = "new-required-property"
+
 type newRequiredPropertyExistingResource struct{}
 
+func (newRequiredPropertyExistingResource) Name() string {
+	return NewRequiredPropertyRuleName
+}
+
+func (newRequiredPropertyExistingResource) Description() string {
+	return "A new property on an existing resource must not be Required"
+}
+
 // Check - Checks that a newly introduced property is not marked as Required since this will not be in users configurations.
 func (newRequiredPropertyExistingResource) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
 	if base.Type == "" && current.Required {
@@ -12,8 +12,19 @@ import (
 
 var _ BreakingChangeRule = optionalRemoveComputed{}
 
+// OptionalRemoveComputedRuleName is the name of the rule which detects Computed being removed from Optional properties
+const OptionalRemoveComputedRuleName = "optional-remove-computed"
+
 type optionalRemoveComputed struct{}
 
+func (optionalRemoveComputed) Name() string {
+	return OptionalRemoveComputedRuleName
+}
+
+func (optionalRemoveComputed) Description() string {
+	return "Computed must not be removed from an Optional property"
+}
+
 // Check - Checks that Computed is not removed from Optional properties as user configs may not supply the value, but the state will contain one, causing a diff./
 func (optionalRemoveComputed) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
 	if (base.Optional && base.Computed) && (current.Optional && !current.Computed) {
@@ -10,10 +10,21 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
 )
 
+// OptionalToRequiredRuleName is the name of the rule which detects Optional properties becoming Required
+const OptionalToRequiredRuleName = "optional-to-required"
+
 type optionalToRequired struct{}
 
 var _ BreakingChangeRule = optionalToRequired{}
 
+func (optionalToRequired) Name() string {
+	return OptionalToRequiredRuleName
+}
+
+func (optionalToRequired) Description() string {
+	return "An Optional property must not become Required"
+}
+
 // Check - Checks that an Optional property is not update to become Required
 func (o optionalToRequired) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
 	if base.Optional && current.Required {
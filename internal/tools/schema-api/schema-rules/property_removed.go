@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+// PropertyRemovedRuleName is the name of the rule which detects removed properties
+const PropertyRemovedRuleName = "property-removed"
+
+type propertyRemoved struct{}
+
+var _ BreakingChangeRule = propertyRemoved{}
+
+func (propertyRemoved) Name() string {
+	return PropertyRemovedRuleName
+}
+
+func (propertyRemoved) Description() string {
+	return "An existing property must not be removed"
+}
+
+// Check - Checks that an existing property has not been removed, since this would break user configurations and references to it
+func (propertyRemoved) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Type != "" && current.Type == "" {
+		return pointer.To(fmt.Sprintf("property %q has been removed", propertyName))
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var propertyRemovedBaseNode = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeString,
+	Optional: true,
+}
+
+var propertyRemovedPasses = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeString,
+	Optional: true,
+	Computed: true,
+}
+
+func TestPropertyRemoved_Check(t *testing.T) {
+	data := propertyRemoved{}
+	if res := data.Check(propertyRemovedBaseNode, propertyRemovedPasses, "foo"); res != nil {
+		t.Errorf("expected no violation, got %+v", *res)
+	}
+
+	if res := data.Check(propertyRemovedBaseNode, providerjson.SchemaJSON{}, "foo"); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+
+	if res := data.Check(providerjson.SchemaJSON{}, propertyRemovedPasses, "foo"); res != nil {
+		t.Errorf("expected no violation, got %+v", *res)
+	}
+}
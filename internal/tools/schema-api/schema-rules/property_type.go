@@ -14,8 +14,19 @@ import (
 
 var _ BreakingChangeRule = propertyType{}
 
+// PropertyTypeRuleName is the name of the rule which detects changes to the type of existing properties
+const PropertyTypeRuleName = "property-type"
+
 type propertyType struct{}
 
+func (propertyType) Name() string {
+	return PropertyTypeRuleName
+}
+
+func (propertyType) Description() string {
+	return "The type of a property must not change, other than from a Set to a List"
+}
+
 // Check - Checks for invalid type changes. At the time of writing the only allowed change is a Set to a List
 func (propertyType) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
 	if (base.Type != "" && current.Type != "" && base.Type != providerjson.SchemaTypeSet) && base.Type != current.Type {
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+// ResourceRemovedRuleName is the name of the rule which detects removed Resources and Data Sources
+const ResourceRemovedRuleName = "resource-removed"
+
+type resourceRemoved struct{}
+
+var _ BreakingChangeResourceRule = resourceRemoved{}
+
+func (resourceRemoved) Name() string {
+	return ResourceRemovedRuleName
+}
+
+func (resourceRemoved) Description() string {
+	return "An existing Resource or Data Source must not be removed"
+}
+
+// Check - Checks that an existing Resource or Data Source has not been removed
+func (resourceRemoved) Check(base *providerjson.ResourceJSON, current *providerjson.ResourceJSON, resourceName string) *string {
+	if base != nil && current == nil {
+		return pointer.To(fmt.Sprintf("%q has been removed", resourceName))
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+func TestResourceRemoved_Check(t *testing.T) {
+	data := resourceRemoved{}
+	resource := &providerjson.ResourceJSON{
+		Schema: map[string]providerjson.SchemaJSON{},
+	}
+
+	if res := data.Check(resource, resource, "azurerm_example"); res != nil {
+		t.Errorf("expected no violation, got %+v", *res)
+	}
+
+	if res := data.Check(nil, resource, "azurerm_example"); res != nil {
+		t.Errorf("expected no violation, got %+v", *res)
+	}
+
+	if res := data.Check(resource, nil, "azurerm_example"); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+}
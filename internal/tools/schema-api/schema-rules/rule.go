@@ -6,17 +6,116 @@ package schema_rules
 import "github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
 
 type BreakingChangeRule interface {
+	// Name returns the unique identifier for this rule, which is used in machine-readable output
+	Name() string
+
+	// Description returns a short human-readable description of what this rule enforces
+	Description() string
+
 	Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string
 }
 
+// BaseDataRule is implemented by rules which compare data that isn't present in a base schema exported by an older
+// version of this tool - these rules are skipped unless the base schema contains this data for at least one property,
+// since otherwise every existing value would be reported as having been added
+type BaseDataRule interface {
+	HasBaseData(base *providerjson.ProviderSchemaJSON) bool
+}
+
+// RulesForBase returns the rules which can be checked against the base schema
+func RulesForBase(base *providerjson.ProviderSchemaJSON, rules []BreakingChangeRule) []BreakingChangeRule {
+	result := make([]BreakingChangeRule, 0, len(rules))
+	for _, rule := range rules {
+		if r, ok := rule.(BaseDataRule); ok && !r.HasBaseData(base) {
+			continue
+		}
+		result = append(result, rule)
+	}
+	return result
+}
+
+// anyProperty returns whether any property (including those nested within blocks) of any Resource or Data Source
+// in the schema matches
+func anyProperty(input *providerjson.ProviderSchemaJSON, matches func(providerjson.SchemaJSON) bool) bool {
+	if input == nil {
+		return false
+	}
+
+	var anyInSchema func(map[string]providerjson.SchemaJSON) bool
+	anyInSchema = func(schema map[string]providerjson.SchemaJSON) bool {
+		for _, v := range schema {
+			if matches(v) {
+				return true
+			}
+
+			switch elem := v.Elem.(type) {
+			case providerjson.ResourceJSON:
+				if anyInSchema(elem.Schema) {
+					return true
+				}
+			case *providerjson.ResourceJSON:
+				if elem != nil && anyInSchema(elem.Schema) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	for _, resources := range []map[string]providerjson.ResourceJSON{input.ResourcesMap, input.DataSourcesMap} {
+		for _, resource := range resources {
+			if anyInSchema(resource.Schema) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// BreakingChangeResourceRule is a rule which applies to a Resource or Data Source as a whole, rather than
+// to an individual property. Either `base` or `current` may be nil when the Resource has been added or removed.
+type BreakingChangeResourceRule interface {
+	// Name returns the unique identifier for this rule, which is used in machine-readable output
+	Name() string
+
+	// Description returns a short human-readable description of what this rule enforces
+	Description() string
+
+	Check(base *providerjson.ResourceJSON, current *providerjson.ResourceJSON, resourceName string) *string
+}
+
 var BreakingChangeRules = []BreakingChangeRule{
 	becomeComputedOnly{},
+	becomeForceNew{},
+	conflictsWithAdded{},
+	exactlyOneOfAdded{},
+	maxItemsReduced{},
+	minItemsIncreased{},
 	newRequiredPropertyExistingResource{},
 	optionalRemoveComputed{},
 	optionalToRequired{},
+	propertyRemoved{},
 	propertyType{},
 }
 
 var BreakingChangeRulesDataSource = []BreakingChangeRule{
+	becomeComputedOnly{},
+	conflictsWithAdded{},
+	exactlyOneOfAdded{},
+	maxItemsReduced{},
+	minItemsIncreased{},
+	newRequiredPropertyExistingResource{},
+	optionalToRequired{},
+	propertyRemoved{},
 	propertyType{},
 }
+
+var BreakingChangeResourceRules = []BreakingChangeResourceRule{
+	resourceRemoved{},
+	timeoutReduced{},
+}
+
+var BreakingChangeResourceRulesDataSource = []BreakingChangeResourceRule{
+	resourceRemoved{},
+	timeoutReduced{},
+}
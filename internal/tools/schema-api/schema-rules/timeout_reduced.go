@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+// TimeoutReducedRuleName is the name of the rule which detects the default timeouts being reduced
+const TimeoutReducedRuleName = "timeout-reduced"
+
+type timeoutReduced struct{}
+
+var _ BreakingChangeResourceRule = timeoutReduced{}
+
+func (timeoutReduced) Name() string {
+	return TimeoutReducedRuleName
+}
+
+func (timeoutReduced) Description() string {
+	return "The default timeouts of an existing Resource or Data Source must not be reduced"
+}
+
+// Check - Checks that the default timeouts have not been reduced, since operations which previously completed may now time out
+func (timeoutReduced) Check(base *providerjson.ResourceJSON, current *providerjson.ResourceJSON, resourceName string) *string {
+	if base == nil || current == nil || base.Timeouts == nil || current.Timeouts == nil {
+		return nil
+	}
+
+	operations := []struct {
+		name    string
+		base    int
+		current int
+	}{
+		{"create", base.Timeouts.Create, current.Timeouts.Create},
+		{"read", base.Timeouts.Read, current.Timeouts.Read},
+		{"update", base.Timeouts.Update, current.Timeouts.Update},
+		{"delete", base.Timeouts.Delete, current.Timeouts.Delete},
+	}
+
+	reduced := make([]string, 0)
+	for _, v := range operations {
+		if v.base > 0 && v.current > 0 && v.current < v.base {
+			reduced = append(reduced, fmt.Sprintf("%s (%d to %d minutes)", v.name, v.base, v.current))
+		}
+	}
+
+	if len(reduced) > 0 {
+		return pointer.To(fmt.Sprintf("Cannot reduce the default timeouts for %q: %s", resourceName, strings.Join(reduced, ", ")))
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var timeoutReducedBase = &providerjson.ResourceJSON{
+	Timeouts: &providerjson.ResourceTimeoutJSON{
+		Create: 30,
+		Read:   5,
+		Update: 30,
+		Delete: 30,
+	},
+}
+
+var timeoutReducedPasses = &providerjson.ResourceJSON{
+	Timeouts: &providerjson.ResourceTimeoutJSON{
+		Create: 60,
+		Read:   5,
+		Update: 30,
+		Delete: 30,
+	},
+}
+
+var timeoutReducedViolates = &providerjson.ResourceJSON{
+	Timeouts: &providerjson.ResourceTimeoutJSON{
+		Create: 30,
+		Read:   5,
+		Update: 30,
+		Delete: 10, // violation
+	},
+}
+
+func TestTimeoutReduced_Check(t *testing.T) {
+	data := timeoutReduced{}
+	if res := data.Check(timeoutReducedBase, timeoutReducedPasses, "azurerm_example"); res != nil {
+		t.Errorf("expected no violation, got %+v", *res)
+	}
+
+	if res := data.Check(timeoutReducedBase, timeoutReducedViolates, "azurerm_example"); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+
+	if res := data.Check(nil, timeoutReducedViolates, "azurerm_example"); res != nil {
+		t.Errorf("expected no violation, got %+v", *res)
+	}
+}
@@ -14,7 +14,11 @@ import (
 )
 
 var allRules = map[string]rules.Rule{
-	rules.TypedSDKBitCheck{}.Name(): rules.TypedSDKBitCheck{},
+	rules.TypedSDKBitCheck{}.Name():               rules.TypedSDKBitCheck{},
+	rules.TypedSDKModelSchemaCheck{}.Name():       rules.TypedSDKModelSchemaCheck{},
+	rules.LongRunningOperationCheck{}.Name():      rules.LongRunningOperationCheck{},
+	rules.ReadNotFoundCheck{}.Name():              rules.ReadNotFoundCheck{},
+	rules.RequiredResourceProvidersCheck{}.Name(): rules.RequiredResourceProvidersCheck{},
 }
 
 func main() {
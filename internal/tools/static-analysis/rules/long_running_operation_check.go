@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+var _ Rule = LongRunningOperationCheck{}
+
+type LongRunningOperationCheck struct{}
+
+func (r LongRunningOperationCheck) Run() []error {
+	return runAnalyzer(LongRunningOperationAnalyzer)
+}
+
+func (r LongRunningOperationCheck) Name() string {
+	return "checkLongRunningOperations"
+}
+
+func (r LongRunningOperationCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check that long-running operations (such as Create, Update and Delete) on the
+go-azure-sdk clients wait for the operation to complete - that is, either the '...ThenPoll' method is used, or the
+Poller returned by the method is used, rather than the returned Poller being ignored.
+`, r.Name())
+}
+
+// waitIdentifiers are the functions and types used to wait for an operation to complete other than using the Poller
+// returned by the SDK, e.g. using a custom poller or a StateChangeConf
+var waitIdentifiers = map[string]struct{}{
+	"PollUntilDone":       {},
+	"StateChangeConf":     {},
+	"WaitForStateContext": {},
+}
+
+// LongRunningOperationAnalyzer reports long-running operations on the SDK clients where the Poller is ignored
+var LongRunningOperationAnalyzer = &analysis.Analyzer{
+	Name:     "longrunningoperations",
+	Doc:      "check that long-running operations on the SDK clients wait for the operation to complete",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      runLongRunningOperations,
+}
+
+func runLongRunningOperations(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	// the functions within the package which wait for an operation to complete (e.g. using a StateChangeConf), which are
+	// used where the Poller can't be - e.g. when the operation has to be retried, or the API doesn't support polling
+	waitFuncs := make(map[types.Object]struct{})
+	inspect.Preorder([]ast.Node{(*ast.FuncDecl)(nil)}, func(n ast.Node) {
+		if fn := n.(*ast.FuncDecl); fn.Body != nil && waits(pass.TypesInfo, fn.Body, nil) {
+			waitFuncs[pass.TypesInfo.Defs[fn.Name]] = struct{}{}
+		}
+	})
+
+	nodeFilter := []ast.Node{
+		(*ast.ExprStmt)(nil),
+		(*ast.AssignStmt)(nil),
+	}
+	inspect.WithStack(nodeFilter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		switch n := n.(type) {
+		case *ast.ExprStmt:
+			method := longRunningOperation(pass.TypesInfo, n.X)
+			if method != "" && !waitsForCompletion(pass.TypesInfo, stack, waitFuncs) {
+				pass.Reportf(n.Pos(), "the result of the long-running operation `%s` is discarded, use `%sThenPoll` instead", method, method)
+			}
+
+		case *ast.AssignStmt:
+			if len(n.Rhs) != 1 || len(n.Lhs) == 0 {
+				return true
+			}
+			method := longRunningOperation(pass.TypesInfo, n.Rhs[0])
+			if method == "" {
+				return true
+			}
+			ident, ok := n.Lhs[0].(*ast.Ident)
+			if !ok {
+				return true
+			}
+
+			// the first node within the stack is always the file containing the statement
+			file := stack[0].(*ast.File)
+			obj := pass.TypesInfo.ObjectOf(ident)
+			if (ident.Name == "_" || obj == nil || !pollerUsed(pass.TypesInfo, file, obj)) && !waitsForCompletion(pass.TypesInfo, stack, waitFuncs) {
+				pass.Reportf(n.Pos(), "the Poller returned by the long-running operation `%s` is ignored, use `%sThenPoll` instead or wait on the Poller", method, method)
+			}
+		}
+		return true
+	})
+
+	return nil, nil
+}
+
+// waitsForCompletion returns whether the function containing the call waits for the operation to complete some other way,
+// or is the `Poll` method of a custom poller - which is itself used to wait for an operation to complete
+func waitsForCompletion(info *types.Info, stack []ast.Node, waitFuncs map[types.Object]struct{}) bool {
+	var body *ast.BlockStmt
+	for i := len(stack) - 1; i >= 0 && body == nil; i-- {
+		switch fn := stack[i].(type) {
+		case *ast.FuncLit:
+			body = fn.Body
+		case *ast.FuncDecl:
+			if fn.Recv != nil && fn.Name.Name == "Poll" {
+				return true
+			}
+			body = fn.Body
+		}
+	}
+	if body == nil {
+		return false
+	}
+
+	return waits(info, body, waitFuncs)
+}
+
+// waits returns whether the body uses one of the wait identifiers, or calls one of the functions which does
+func waits(info *types.Info, body *ast.BlockStmt, waitFuncs map[types.Object]struct{}) (found bool) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && !found {
+			_, found = waitIdentifiers[ident.Name]
+			if obj := info.Uses[ident]; obj != nil && !found {
+				_, found = waitFuncs[obj]
+			}
+		}
+		return !found
+	})
+	return
+}
+
+// longRunningOperation returns the name of the method when the expression calls a long-running operation on an SDK
+// client, which is a method with an equivalent `...ThenPoll` method
+func longRunningOperation(info *types.Info, expr ast.Expr) string {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return ""
+	}
+	method, recv := sdkMethod(info, call)
+	if method == nil || !hasMethod(recv, method.Name()+"ThenPoll") {
+		return ""
+	}
+	return method.Name()
+}
+
+// pollerUsed returns whether the Poller within the result of the long-running operation is used, which is also
+// assumed when the result is used other than by accessing its fields (e.g. it's returned or passed to a function)
+func pollerUsed(info *types.Info, file *ast.File, obj types.Object) (used bool) {
+	var inspect func(n ast.Node) bool
+	inspect = func(n ast.Node) bool {
+		if used {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			// assigning to the result isn't a use of it
+			for _, rhs := range n.Rhs {
+				ast.Inspect(rhs, inspect)
+			}
+			return false
+		case *ast.SelectorExpr:
+			if ident, ok := n.X.(*ast.Ident); ok && info.Uses[ident] == obj {
+				used = n.Sel.Name == "Poller"
+				return false
+			}
+		case *ast.Ident:
+			used = info.Uses[n] == obj
+		}
+		return true
+	}
+	ast.Inspect(file, inspect)
+	return
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+var _ Rule = ReadNotFoundCheck{}
+
+type ReadNotFoundCheck struct{}
+
+func (r ReadNotFoundCheck) Run() []error {
+	return runAnalyzer(ReadNotFoundAnalyzer)
+}
+
+func (r ReadNotFoundCheck) Name() string {
+	return "checkReadNotFound"
+}
+
+func (r ReadNotFoundCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check that the Read function of each Resource handles the resource no longer
+existing - that is, when the Read function retrieves the resource using an SDK client, it checks whether the response
+was a 404 (e.g. using 'response.WasNotFound') so that the resource can be removed from the state, rather than the
+Read failing.
+`, r.Name())
+}
+
+// resourceSchemaType is the type of `pluginsdk.Resource`, which is an alias
+const resourceSchemaType = "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema.Resource"
+
+// notFoundIdentifiers are the functions and constants which are used to check whether the response was a 404, or to
+// remove the resource from the state
+var notFoundIdentifiers = map[string]struct{}{
+	"WasNotFound":         {},
+	"ResponseWasNotFound": {},
+	"StatusNotFound":      {},
+	"MarkAsGone":          {},
+}
+
+// ReadNotFoundAnalyzer reports the Read functions of Resources which don't handle the resource no longer existing
+var ReadNotFoundAnalyzer = &analysis.Analyzer{
+	Name:     "readnotfound",
+	Doc:      "check that the Read function of each Resource handles the resource no longer existing",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      runReadNotFound,
+}
+
+func runReadNotFound(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	funcs := make(map[types.Object]*ast.FuncDecl)
+	inspect.Preorder([]ast.Node{(*ast.FuncDecl)(nil)}, func(n ast.Node) {
+		fn := n.(*ast.FuncDecl)
+		funcs[pass.TypesInfo.Defs[fn.Name]] = fn
+	})
+
+	check := func(name string, fn *ast.FuncDecl) {
+		if fn == nil || fn.Body == nil {
+			return
+		}
+		if callsSDKGet(pass.TypesInfo, fn.Body) && !handlesNotFound(fn.Body) {
+			pass.Reportf(fn.Pos(), "the Read function `%s` doesn't check whether the resource was not found (e.g. using `response.WasNotFound`) so that it can be removed from the state", name)
+		}
+	}
+
+	nodeFilter := []ast.Node{
+		(*ast.FuncDecl)(nil),
+		(*ast.CompositeLit)(nil),
+	}
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			// the Read method of a Typed Resource, which is a Data Source when there's no Create method
+			if n.Recv == nil || n.Name.Name != "Read" || len(n.Recv.List) != 1 {
+				return
+			}
+			recv := pass.TypesInfo.TypeOf(n.Recv.List[0].Type)
+			if ptr, ok := recv.(*types.Pointer); ok {
+				recv = ptr.Elem()
+			}
+			if named, ok := recv.(*types.Named); ok && hasMethod(named, "Create") {
+				check(named.Obj().Name()+".Read", n)
+			}
+
+		case *ast.CompositeLit:
+			// the Read function of an Untyped Resource, which is a Data Source when there's no Create function
+			t := pass.TypesInfo.TypeOf(n)
+			if t == nil || types.TypeString(types.Unalias(t), nil) != resourceSchemaType {
+				return
+			}
+			fields := make(map[string]ast.Expr)
+			for _, elt := range n.Elts {
+				if kv, ok := elt.(*ast.KeyValueExpr); ok {
+					if key, ok := kv.Key.(*ast.Ident); ok {
+						fields[key.Name] = kv.Value
+					}
+				}
+			}
+			if _, ok := fields["Create"]; !ok {
+				return
+			}
+			if ident, ok := fields["Read"].(*ast.Ident); ok {
+				check(ident.Name, funcs[pass.TypesInfo.Uses[ident]])
+			}
+		}
+	})
+
+	return nil, nil
+}
+
+// callsSDKGet returns whether the function retrieves a resource using an SDK client, since otherwise the resource is
+// retrieved elsewhere (e.g. within a shared function) where the 404 is expected to be handled
+func callsSDKGet(info *types.Info, body *ast.BlockStmt) (found bool) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && !found {
+			if method, _ := sdkMethod(info, call); method != nil && strings.HasPrefix(method.Name(), "Get") {
+				found = true
+			}
+		}
+		return !found
+	})
+	return
+}
+
+func handlesNotFound(body *ast.BlockStmt) (found bool) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.Ident:
+			_, found = notFoundIdentifiers[n.Name]
+		case *ast.CallExpr:
+			// `d.SetId("")` removes the resource from the state
+			if sel, ok := n.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "SetId" && len(n.Args) == 1 {
+				lit, ok := n.Args[0].(*ast.BasicLit)
+				found = ok && lit.Value == `""`
+			}
+		}
+		return !found
+	})
+	return
+}
@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestReadNotFoundAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), ReadNotFoundAnalyzer, "readnotfound")
+}
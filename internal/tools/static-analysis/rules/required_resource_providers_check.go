@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"golang.org/x/tools/go/packages"
+)
+
+var _ Rule = RequiredResourceProvidersCheck{}
+
+type RequiredResourceProvidersCheck struct{}
+
+func (r RequiredResourceProvidersCheck) Run() []error {
+	pkgs, err := loadServicePackages()
+	if err != nil {
+		return []error{err}
+	}
+
+	return checkRequiredResourceProviders(pkgs, resourceproviders.All())
+}
+
+func (r RequiredResourceProvidersCheck) Name() string {
+	return "checkRequiredResourceProviders"
+}
+
+func (r RequiredResourceProvidersCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check that the Resource Providers (e.g. 'Microsoft.Compute') used by the
+go-azure-sdk packages imported by each service are included in the set of Resource Providers which can be registered
+automatically - so that resources within new services don't fail with 'MissingSubscriptionRegistration'.
+`, r.Name())
+}
+
+// checkRequiredResourceProviders returns an error for each Resource Provider used by the service packages which isn't
+// included in the set of Resource Providers
+func checkRequiredResourceProviders(pkgs []*packages.Package, resourceProviders resourceproviders.ResourceProviders) (errors []error) {
+	required := make(map[string]struct{})
+	for namespace := range resourceProviders {
+		required[strings.ToLower(namespace)] = struct{}{}
+	}
+
+	// the namespaces used by each SDK package are cached, since most SDK packages are used by several service packages
+	sdkNamespaces := make(map[string][]string)
+
+	// the service packages using each namespace missing from the set of Resource Providers
+	missing := make(map[string]map[string]struct{})
+	for _, pkg := range pkgs {
+		service := serviceName(pkg.PkgPath)
+		for path, imported := range pkg.Imports {
+			if !strings.HasPrefix(path, resourceManagerSDKPrefix) {
+				continue
+			}
+
+			namespaces, ok := sdkNamespaces[path]
+			if !ok {
+				var err error
+				namespaces, err = resourceProviderNamespaces(imported)
+				if err != nil {
+					errors = append(errors, err)
+				}
+				sdkNamespaces[path] = namespaces
+			}
+
+			for _, namespace := range namespaces {
+				if _, ok := required[strings.ToLower(namespace)]; ok {
+					continue
+				}
+				if _, ok := missing[namespace]; !ok {
+					missing[namespace] = make(map[string]struct{})
+				}
+				missing[namespace][service] = struct{}{}
+			}
+		}
+	}
+
+	namespaces := make([]string, 0, len(missing))
+	for namespace := range missing {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	for _, namespace := range namespaces {
+		services := make([]string, 0, len(missing[namespace]))
+		for service := range missing[namespace] {
+			services = append(services, service)
+		}
+		sort.Strings(services)
+		errors = append(errors, fmt.Errorf("the Resource Provider `%s` is used by the service(s) `%s` but isn't included in `resourceproviders.All()`\n", namespace, strings.Join(services, "`, `")))
+	}
+
+	return
+}
+
+// resourceManagerSDKPrefix is the prefix of the import paths for the Resource Manager packages within go-azure-sdk
+const resourceManagerSDKPrefix = "github.com/hashicorp/go-azure-sdk/resource-manager/"
+
+// serviceName returns the name of the service for a service package, e.g. `compute` for
+// `github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/client`
+func serviceName(pkgPath string) string {
+	_, path, _ := strings.Cut(pkgPath, "/internal/services/")
+	service, _, _ := strings.Cut(path, "/")
+	return service
+}
+
+// resourceProviderNamespaces returns the namespaces of the Resource Providers for the Resource IDs defined within the
+// SDK package - which is the last Resource Provider segment within the Resource ID, since the earlier segments are
+// the parent resource for extension resources (e.g. `Microsoft.Compute` for a Role Assignment on a Virtual Machine)
+func resourceProviderNamespaces(pkg *packages.Package) ([]string, error) {
+	namespaces := make(map[string]struct{})
+	fset := token.NewFileSet()
+	for _, filename := range pkg.GoFiles {
+		if !strings.HasPrefix(filepath.Base(filename), "id_") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filename, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %+v", filename, err)
+		}
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || fn.Name.Name != "Segments" || fn.Body == nil {
+				continue
+			}
+
+			namespace := ""
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok || len(call.Args) < 2 {
+					return true
+				}
+				sel, ok := call.Fun.(*ast.SelectorExpr)
+				if !ok || sel.Sel.Name != "ResourceProviderSegment" {
+					return true
+				}
+				if lit, ok := call.Args[1].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+					if value, err := strconv.Unquote(lit.Value); err == nil {
+						namespace = value
+					}
+				}
+				return false
+			})
+			if namespace != "" {
+				namespaces[namespace] = struct{}{}
+			}
+		}
+	}
+
+	out := make([]string, 0, len(namespaces))
+	for namespace := range namespaces {
+		out = append(out, namespace)
+	}
+	sort.Strings(out)
+	return out, nil
+}
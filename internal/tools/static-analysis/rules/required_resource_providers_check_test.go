@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"golang.org/x/tools/go/packages"
+)
+
+func TestRequiredResourceProvidersCheck(t *testing.T) {
+	testData, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatalf("resolving the testdata directory: %+v", err)
+	}
+
+	testCases := []struct {
+		service  string
+		expected []string
+	}{
+		{
+			// uses `Microsoft.Compute`, which is included in `resourceproviders.All()`
+			service:  "compute",
+			expected: []string{},
+		},
+		{
+			// uses `Microsoft.Compute` and `Microsoft.Widgets`, which isn't included in `resourceproviders.All()`
+			service: "widgets",
+			expected: []string{
+				"the Resource Provider `Microsoft.Widgets` is used by the service(s) `widgets` but isn't included in `resourceproviders.All()`\n",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.service, func(t *testing.T) {
+			// the packages within the testdata directory are loaded in GOPATH mode, as `analysistest` does
+			cfg := &packages.Config{
+				Mode: packages.LoadAllSyntax,
+				Dir:  testData,
+				Env:  append(os.Environ(), "GOPATH="+testData, "GO111MODULE=off", "GOWORK=off"),
+			}
+			pkgs, err := packages.Load(cfg, "github.com/hashicorp/terraform-provider-azurerm/internal/services/"+tc.service)
+			if err != nil {
+				t.Fatalf("loading the service package: %+v", err)
+			}
+
+			errors := checkRequiredResourceProviders(pkgs, resourceproviders.All())
+			if len(errors) != len(tc.expected) {
+				t.Fatalf("expected %d errors but got %d: %v", len(tc.expected), len(errors), errors)
+			}
+			for i, err := range errors {
+				if err.Error() != tc.expected[i] {
+					t.Fatalf("expected error %d to be %q but got %q", i, tc.expected[i], err.Error())
+				}
+			}
+		})
+	}
+}
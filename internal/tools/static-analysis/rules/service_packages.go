@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/packages"
+)
+
+// servicePackagesPattern is the pattern used to load the service packages which are inspected by the source-based rules
+var servicePackagesPattern = "github.com/hashicorp/terraform-provider-azurerm/internal/services/..."
+
+// sdkPackagePrefixes are the prefixes of the import paths for the SDKs used to call the Azure APIs
+var sdkPackagePrefixes = []string{
+	"github.com/hashicorp/go-azure-sdk/",
+	"github.com/Azure/azure-sdk-for-go/",
+	"github.com/jackofallops/kermit/",
+	"github.com/tombuildsstuff/kermit/",
+}
+
+// loadServicePackages loads the syntax and type information for the service packages (excluding tests) and their
+// dependencies, which is loaded once and shared between the rules, since type-checking all of the service packages is expensive
+var loadServicePackages = sync.OnceValues(func() ([]*packages.Package, error) {
+	cfg := &packages.Config{
+		// the analyzers require the syntax of the dependencies, which also means the dependencies are type-checked from
+		// source rather than using export data, which can't be read when the version of Go used to build the dependencies
+		// is newer than the version supported by `golang.org/x/tools`
+		Mode: packages.LoadAllSyntax,
+	}
+	pkgs, err := packages.Load(cfg, servicePackagesPattern)
+	if err != nil {
+		return nil, fmt.Errorf("loading the service packages: %+v", err)
+	}
+
+	errs := make([]error, 0)
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		for _, e := range pkg.Errors {
+			errs = append(errs, fmt.Errorf("loading %s: %s", pkg.PkgPath, e))
+		}
+	})
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return pkgs, nil
+})
+
+// runAnalyzer runs the analyzer against the service packages, returning an error for each diagnostic reported
+func runAnalyzer(analyzer *analysis.Analyzer) (errors []error) {
+	pkgs, err := loadServicePackages()
+	if err != nil {
+		return []error{err}
+	}
+
+	graph, err := checker.Analyze([]*analysis.Analyzer{analyzer}, pkgs, nil)
+	if err != nil {
+		return []error{fmt.Errorf("running the analyzer %q: %+v", analyzer.Name, err)}
+	}
+
+	for _, action := range graph.Roots {
+		if action.Err != nil {
+			errors = append(errors, fmt.Errorf("running the analyzer %q against %s: %+v\n", analyzer.Name, action.Package.PkgPath, action.Err))
+			continue
+		}
+		for _, diagnostic := range action.Diagnostics {
+			errors = append(errors, fmt.Errorf("%s: %s\n", position(action.Package.Fset, diagnostic.Pos), diagnostic.Message))
+		}
+	}
+
+	return
+}
+
+// sdkMethod returns the method being called when the call is to a method of a type within one of the SDKs
+func sdkMethod(info *types.Info, call *ast.CallExpr) (*types.Func, *types.Named) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, nil
+	}
+	selection, ok := info.Selections[sel]
+	if !ok || selection.Kind() != types.MethodVal {
+		return nil, nil
+	}
+	method, ok := selection.Obj().(*types.Func)
+	if !ok || method.Pkg() == nil || !isSDKPackage(method.Pkg().Path()) {
+		return nil, nil
+	}
+
+	recv := selection.Recv()
+	if ptr, ok := recv.(*types.Pointer); ok {
+		recv = ptr.Elem()
+	}
+	named, ok := recv.(*types.Named)
+	if !ok {
+		return nil, nil
+	}
+	return method, named
+}
+
+func isSDKPackage(path string) bool {
+	for _, prefix := range sdkPackagePrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasMethod returns whether the type (or a pointer to it) has a method with the given name
+func hasMethod(named *types.Named, name string) bool {
+	obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(named), true, named.Obj().Pkg(), name)
+	_, ok := obj.(*types.Func)
+	return ok
+}
+
+// position returns the position relative to the working directory, so that it can be opened from the output
+func position(fset *token.FileSet, pos token.Pos) string {
+	p := fset.Position(pos)
+	if wd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(wd, p.Filename); err == nil {
+			p.Filename = rel
+		}
+	}
+	return fmt.Sprintf("%s:%d", p.Filename, p.Line)
+}
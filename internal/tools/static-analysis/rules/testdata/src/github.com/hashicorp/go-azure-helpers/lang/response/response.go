@@ -0,0 +1,7 @@
+package response
+
+import "net/http"
+
+func WasNotFound(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusNotFound
+}
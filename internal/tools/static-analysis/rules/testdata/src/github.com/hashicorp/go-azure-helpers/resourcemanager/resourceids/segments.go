@@ -0,0 +1,26 @@
+package resourceids
+
+type Segment struct {
+	Name       string
+	FixedValue *string
+}
+
+func StaticSegment(name, staticValue, exampleValue string) Segment {
+	return Segment{Name: name, FixedValue: &staticValue}
+}
+
+func ResourceProviderSegment(name, resourceProvider, exampleValue string) Segment {
+	return Segment{Name: name, FixedValue: &resourceProvider}
+}
+
+func SubscriptionIdSegment(name, exampleValue string) Segment {
+	return Segment{Name: name}
+}
+
+func ResourceGroupSegment(name, exampleValue string) Segment {
+	return Segment{Name: name}
+}
+
+func UserSpecifiedSegment(name, exampleValue string) Segment {
+	return Segment{Name: name}
+}
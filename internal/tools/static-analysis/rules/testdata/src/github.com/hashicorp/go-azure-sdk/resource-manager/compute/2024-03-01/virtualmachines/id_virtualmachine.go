@@ -0,0 +1,22 @@
+package virtualmachines
+
+import "github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+
+type VirtualMachineId struct {
+	SubscriptionId     string
+	ResourceGroupName  string
+	VirtualMachineName string
+}
+
+func (id VirtualMachineId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCompute", "Microsoft.Compute", "Microsoft.Compute"),
+		resourceids.StaticSegment("staticVirtualMachines", "virtualMachines", "virtualMachines"),
+		resourceids.UserSpecifiedSegment("virtualMachineName", "virtualMachineName"),
+	}
+}
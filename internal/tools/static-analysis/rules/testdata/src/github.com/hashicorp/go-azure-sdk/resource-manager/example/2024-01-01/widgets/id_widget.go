@@ -0,0 +1,16 @@
+package widgets
+
+import "github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+
+func (id WidgetId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftWidgets", "Microsoft.Widgets", "Microsoft.Widgets"),
+		resourceids.StaticSegment("staticWidgets", "widgets", "widgets"),
+		resourceids.UserSpecifiedSegment("widgetName", "widgetName"),
+	}
+}
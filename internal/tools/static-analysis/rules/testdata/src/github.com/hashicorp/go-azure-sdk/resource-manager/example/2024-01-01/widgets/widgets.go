@@ -0,0 +1,57 @@
+package widgets
+
+import (
+	"context"
+	"net/http"
+)
+
+type WidgetId struct {
+	WidgetName string
+}
+
+type Widget struct {
+	Name *string
+}
+
+type Poller struct{}
+
+func (p Poller) PollUntilDone(ctx context.Context) error {
+	return nil
+}
+
+type WidgetsClient struct{}
+
+type CreateOrUpdateOperationResponse struct {
+	Poller       Poller
+	HttpResponse *http.Response
+}
+
+func (c WidgetsClient) CreateOrUpdate(ctx context.Context, id WidgetId, input Widget) (CreateOrUpdateOperationResponse, error) {
+	return CreateOrUpdateOperationResponse{}, nil
+}
+
+func (c WidgetsClient) CreateOrUpdateThenPoll(ctx context.Context, id WidgetId, input Widget) error {
+	return nil
+}
+
+type DeleteOperationResponse struct {
+	Poller       Poller
+	HttpResponse *http.Response
+}
+
+func (c WidgetsClient) Delete(ctx context.Context, id WidgetId) (DeleteOperationResponse, error) {
+	return DeleteOperationResponse{}, nil
+}
+
+func (c WidgetsClient) DeleteThenPoll(ctx context.Context, id WidgetId) error {
+	return nil
+}
+
+type GetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *Widget
+}
+
+func (c WidgetsClient) Get(ctx context.Context, id WidgetId) (GetOperationResponse, error) {
+	return GetOperationResponse{}, nil
+}
@@ -0,0 +1,14 @@
+package schema
+
+type ResourceData struct{}
+
+func (d *ResourceData) SetId(v string) {}
+
+type CreateFunc func(*ResourceData, interface{}) error
+
+type ReadFunc func(*ResourceData, interface{}) error
+
+type Resource struct {
+	Create CreateFunc
+	Read   ReadFunc
+}
@@ -0,0 +1,7 @@
+package compute
+
+import "github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+
+type Client struct {
+	VirtualMachineId virtualmachines.VirtualMachineId
+}
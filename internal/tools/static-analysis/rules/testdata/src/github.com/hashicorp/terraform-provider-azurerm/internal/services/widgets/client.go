@@ -0,0 +1,11 @@
+package widgets
+
+import (
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/example/2024-01-01/widgets"
+)
+
+type Client struct {
+	VirtualMachineId virtualmachines.VirtualMachineId
+	WidgetsClient    widgets.WidgetsClient
+}
@@ -0,0 +1,71 @@
+package longrunningoperations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/example/2024-01-01/widgets"
+)
+
+func createThenPoll(ctx context.Context, client widgets.WidgetsClient, id widgets.WidgetId) error {
+	return client.CreateOrUpdateThenPoll(ctx, id, widgets.Widget{})
+}
+
+func createDiscarded(ctx context.Context, client widgets.WidgetsClient, id widgets.WidgetId) {
+	client.CreateOrUpdate(ctx, id, widgets.Widget{}) // want "the result of the long-running operation `CreateOrUpdate` is discarded, use `CreateOrUpdateThenPoll` instead"
+}
+
+func deletePollerIgnored(ctx context.Context, client widgets.WidgetsClient, id widgets.WidgetId) error {
+	if _, err := client.Delete(ctx, id); err != nil { // want "the Poller returned by the long-running operation `Delete` is ignored, use `DeleteThenPoll` instead or wait on the Poller"
+		return fmt.Errorf("deleting %s: %+v", id.WidgetName, err)
+	}
+	return nil
+}
+
+func deleteResponseUnused(ctx context.Context, client widgets.WidgetsClient, id widgets.WidgetId) error {
+	resp, err := client.Delete(ctx, id) // want "the Poller returned by the long-running operation `Delete` is ignored, use `DeleteThenPoll` instead or wait on the Poller"
+	if err != nil {
+		return fmt.Errorf("deleting %s (status %d): %+v", id.WidgetName, resp.HttpResponse.StatusCode, err)
+	}
+	return nil
+}
+
+func deletePollerUsed(ctx context.Context, client widgets.WidgetsClient, id widgets.WidgetId) error {
+	resp, err := client.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", id.WidgetName, err)
+	}
+	return resp.Poller.PollUntilDone(ctx)
+}
+
+func getIsNotLongRunning(ctx context.Context, client widgets.WidgetsClient, id widgets.WidgetId) {
+	client.Get(ctx, id)
+}
+
+func deleteWaitedOnByHelper(ctx context.Context, client widgets.WidgetsClient, id widgets.WidgetId) error {
+	if _, err := client.Delete(ctx, id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id.WidgetName, err)
+	}
+	return waitForDeletion(ctx)
+}
+
+type StateChangeConf struct{}
+
+func (s *StateChangeConf) WaitForStateContext(ctx context.Context) (interface{}, error) {
+	return nil, nil
+}
+
+func waitForDeletion(ctx context.Context) error {
+	stateConf := &StateChangeConf{}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+type deletePoller struct {
+	client widgets.WidgetsClient
+	id     widgets.WidgetId
+}
+
+func (p deletePoller) Poll(ctx context.Context) {
+	p.client.Delete(ctx, p.id)
+}
@@ -0,0 +1,108 @@
+package readnotfound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/example/2024-01-01/widgets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type WidgetResource struct {
+	client widgets.WidgetsClient
+}
+
+func (r WidgetResource) Create(ctx context.Context, id widgets.WidgetId) error {
+	return r.client.CreateOrUpdateThenPoll(ctx, id, widgets.Widget{})
+}
+
+func (r WidgetResource) Read(ctx context.Context, id widgets.WidgetId) error {
+	resp, err := r.client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id.WidgetName, err)
+	}
+	return nil
+}
+
+type MissingNotFoundResource struct {
+	client widgets.WidgetsClient
+}
+
+func (r MissingNotFoundResource) Create(ctx context.Context, id widgets.WidgetId) error {
+	return r.client.CreateOrUpdateThenPoll(ctx, id, widgets.Widget{})
+}
+
+func (r MissingNotFoundResource) Read(ctx context.Context, id widgets.WidgetId) error { // want "the Read function `MissingNotFoundResource.Read` doesn't check whether the resource was not found"
+	if _, err := r.client.Get(ctx, id); err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id.WidgetName, err)
+	}
+	return nil
+}
+
+type WidgetDataSource struct {
+	client widgets.WidgetsClient
+}
+
+func (r WidgetDataSource) Read(ctx context.Context, id widgets.WidgetId) error {
+	if _, err := r.client.Get(ctx, id); err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id.WidgetName, err)
+	}
+	return nil
+}
+
+func resourceWidget() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWidgetCreate,
+		Read:   resourceWidgetRead,
+	}
+}
+
+func resourceMissingNotFound() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWidgetCreate,
+		Read:   resourceMissingNotFoundRead,
+	}
+}
+
+func dataSourceWidget() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceWidgetRead,
+	}
+}
+
+func resourceWidgetCreate(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceWidgetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(widgets.WidgetsClient)
+	resp, err := client.Get(context.Background(), widgets.WidgetId{})
+	if err != nil {
+		if resp.HttpResponse != nil {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func resourceMissingNotFoundRead(d *schema.ResourceData, meta interface{}) error { // want "the Read function `resourceMissingNotFoundRead` doesn't check whether the resource was not found"
+	client := meta.(widgets.WidgetsClient)
+	if _, err := client.Get(context.Background(), widgets.WidgetId{}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func dataSourceWidgetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(widgets.WidgetsClient)
+	if _, err := client.Get(context.Background(), widgets.WidgetId{}); err != nil {
+		return err
+	}
+	return nil
+}
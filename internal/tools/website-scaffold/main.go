@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/differ"
 	"github.com/magodo/terraform-provider-azurerm-example-gen/examplegen"
 )
 
@@ -38,6 +39,12 @@ func main() {
 	servicePkg := f.String("service-pkg", "", "The service package where the AccTest resides in. Required when `-example` is set.")
 	testCase := f.String("testcase", "", "The name of the AccTest where the Terraform configuration derives from. Required when `-example` is set.")
 
+	// release notes related flags
+	baseSchema := f.String("base-schema", "", "The path to the Provider Schema exported (using `schema-api -export`) for the previous release. When set, drafts the release notes for the changes since")
+	currentSchema := f.String("current-schema", "", "The path to the Provider Schema exported for this release. Required when `-base-schema` is set.")
+	changelogPath := f.String("changelog-path", "", "The path to write the draft CHANGELOG entries to when `-base-schema` is set. Defaults to stdout")
+	upgradeGuidePath := f.String("upgrade-guide-path", "", "The path to write the draft upgrade guide to when `-base-schema` is set. Defaults to stdout")
+
 	_ = f.Parse(os.Args[1:])
 
 	quitWithError := func(message string) {
@@ -45,6 +52,18 @@ func main() {
 		os.Exit(1)
 	}
 
+	if baseSchema != nil && *baseSchema != "" {
+		if currentSchema == nil || *currentSchema == "" {
+			quitWithError("The path to the current Provider Schema must be specified via `-current-schema` when `-base-schema` is set")
+			return
+		}
+
+		if err := runReleaseNotes(*baseSchema, *currentSchema, *changelogPath, *upgradeGuidePath, *websitePath); err != nil {
+			quitWithError(err.Error())
+		}
+		return
+	}
+
 	if resourceName == nil || *resourceName == "" {
 		quitWithError("The name of the Data Source/Resource must be specified via `-name`")
 		return
@@ -99,6 +118,83 @@ func run(resourceName, brandName string, resourceId *string, isResource bool, we
 	return saveContent(resourceName, websitePath, *content, isResource)
 }
 
+// runReleaseNotes drafts the CHANGELOG entries and upgrade guide for the changes between the two Provider Schemas, and
+// when the website path is specified scaffolds the documentation for any new Data Sources/Resources which aren't documented
+func runReleaseNotes(baseSchema, currentSchema, changelogPath, upgradeGuidePath, websitePath string) error {
+	base, err := differ.LoadSnapshot(baseSchema)
+	if err != nil {
+		return fmt.Errorf("loading the base Provider Schema from %q: %+v", baseSchema, err)
+	}
+	current, err := differ.LoadSnapshot(currentSchema)
+	if err != nil {
+		return fmt.Errorf("loading the current Provider Schema from %q: %+v", currentSchema, err)
+	}
+
+	notes, err := differ.BuildReleaseNotes(base, current)
+	if err != nil {
+		return fmt.Errorf("comparing Provider Schemas: %+v", err)
+	}
+
+	if err := writeOutput(changelogPath, notes.Changelog()); err != nil {
+		return fmt.Errorf("writing the CHANGELOG entries: %+v", err)
+	}
+	if err := writeOutput(upgradeGuidePath, notes.UpgradeGuide()); err != nil {
+		return fmt.Errorf("writing the upgrade guide: %+v", err)
+	}
+
+	if websitePath == "" {
+		return nil
+	}
+
+	scaffold := func(resourceName string, isResource bool) error {
+		resourceKind := "r"
+		if !isResource {
+			resourceKind = "d"
+		}
+		existing := fmt.Sprintf("%s/docs/%s/%s.html.markdown", websitePath, resourceKind, strings.TrimPrefix(resourceName, "azurerm_"))
+		if _, err := os.Stat(existing); err == nil {
+			return nil
+		}
+
+		log.Printf("scaffolding the documentation for %q", resourceName)
+		resourceId := "TODO"
+		return run(resourceName, brandNameFromResourceName(resourceName), &resourceId, isResource, websitePath, nil)
+	}
+
+	for _, name := range notes.NewDataSources {
+		if err := scaffold(name, false); err != nil {
+			return fmt.Errorf("scaffolding the documentation for the Data Source %q: %+v", name, err)
+		}
+	}
+	for _, name := range notes.NewResources {
+		if err := scaffold(name, true); err != nil {
+			return fmt.Errorf("scaffolding the documentation for the Resource %q: %+v", name, err)
+		}
+	}
+
+	return nil
+}
+
+// brandNameFromResourceName returns a placeholder brand name for the resource, e.g. `Network Manager` for `azurerm_network_manager`
+func brandNameFromResourceName(resourceName string) string {
+	words := strings.Split(strings.TrimPrefix(resourceName, "azurerm_"), "_")
+	for i, word := range words {
+		if word != "" {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+func writeOutput(path string, content string) error {
+	if path == "" {
+		_, err := fmt.Println(content)
+		return err
+	}
+
+	return os.WriteFile(path, []byte(content), 0o644)
+}
+
 func getContent(resourceName, brandName string, resourceId *string, isResource bool, expsrc *examplegen.ExampleSource) (*string, error) {
 	generator := documentationGenerator{
 		resourceName:  resourceName,
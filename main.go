@@ -9,6 +9,9 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
 )
 
@@ -36,4 +39,11 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+
+	// the Provider is shutting down, so output a summary of the API calls which were made - using the same
+	// logger configuration as Terraform, since the loggers configured for each request are no longer available
+	ctx := tfsdklog.NewRootProviderLogger(context.Background(), tfsdklog.WithStderrFromInit(), tfsdklog.WithLogName("azurerm"), tflog.WithLevelFromEnv("TF_LOG_PROVIDER", "azurerm"))
+	if err := common.WriteApiCallMetricsSummary(ctx); err != nil {
+		log.Printf("[WARN] writing the summary of API calls: %+v", err)
+	}
 }
@@ -3,12 +3,13 @@
 # SPDX-License-Identifier: MPL-2.0
 
 
+# any additional arguments (e.g. `-output-format=sarif -output-file=breaking-changes.sarif`) are passed to the detector
 function runDetect {
-  go run internal/tools/schema-api/main.go -detect .release/provider-schema.json
+  go run internal/tools/schema-api/main.go -detect .release/provider-schema.json "$@"
 }
 
 function main {
-  runDetect
+  runDetect "$@"
 }
 
-main
\ No newline at end of file
+main "$@"
\ No newline at end of file